@@ -23,7 +23,6 @@ var (
 		Example: `podman artifact add quay.io/myimage/myartifact:latest /tmp/foobar.txt
 podman artifact add --file-type text/yaml quay.io/myimage/myartifact:latest /tmp/foobar.yaml
 podman artifact add --append quay.io/myimage/myartifact:latest /tmp/foobar.tar.gz`,
-		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
 	}
 )
 
@@ -9,11 +9,10 @@ import (
 var (
 	// Command: podman _artifact_
 	artifactCmd = &cobra.Command{
-		Use:         "artifact",
-		Short:       "Manage OCI artifacts",
-		Long:        "Manage OCI artifacts",
-		RunE:        validate.SubCommandExists,
-		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:   "artifact",
+		Short: "Manage OCI artifacts",
+		Long:  "Manage OCI artifacts",
+		RunE:  validate.SubCommandExists,
 	}
 )
 
@@ -18,7 +18,6 @@ var (
 		ValidArgsFunction: common.AutocompleteArtifactAdd,
 		Example: `podman artifact Extract quay.io/myimage/myartifact:latest /tmp/foobar.txt
 podman artifact Extract quay.io/myimage/myartifact:latest /home/paul/mydir`,
-		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
 	}
 )
 
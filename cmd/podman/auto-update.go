@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/containers/common/pkg/auth"
 	"github.com/containers/common/pkg/completion"
@@ -18,8 +20,9 @@ import (
 
 type cliAutoUpdateOptions struct {
 	entities.AutoUpdateOptions
-	format    string
-	tlsVerify bool
+	format        string
+	stagedTimeout string
+	tlsVerify     bool
 }
 
 var (
@@ -31,7 +34,6 @@ var (
   or similar units that create new containers in order to run the updated images.
   Please refer to the podman-auto-update(1) man page for details.`
 	autoUpdateCommand = &cobra.Command{
-		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
 		Use:               "auto-update [options]",
 		Short:             "Auto update containers according to their auto-update policy",
 		Long:              autoUpdateDescription,
@@ -55,6 +57,11 @@ func init() {
 
 	flags.BoolVar(&autoUpdateOptions.DryRun, "dry-run", false, "Check for pending updates")
 	flags.BoolVar(&autoUpdateOptions.Rollback, "rollback", true, "Rollback to previous image if update fails")
+	flags.BoolVar(&autoUpdateOptions.Staged, "staged", false, "Update units running the same images one after another, starting with a canary unit")
+
+	stagedTimeoutFlagName := "staged-timeout"
+	flags.StringVar(&autoUpdateOptions.stagedTimeout, stagedTimeoutFlagName, "1m", "Time to wait for the containers of an updated unit to become healthy during a staged update")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(stagedTimeoutFlagName, completion.AutocompleteNone)
 
 	flags.StringVar(&autoUpdateOptions.format, "format", "", "Change the output format to JSON or a Go template")
 	_ = autoUpdateCommand.RegisterFlagCompletionFunc("format", common.AutocompleteFormat(&autoUpdateOutput{}))
@@ -76,6 +83,11 @@ func autoUpdate(cmd *cobra.Command, args []string) error {
 	if cmd.Flags().Changed("tls-verify") {
 		autoUpdateOptions.InsecureSkipTLSVerify = types.NewOptionalBool(!autoUpdateOptions.tlsVerify)
 	}
+	stagedTimeout, err := time.ParseDuration(autoUpdateOptions.stagedTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid --staged-timeout %q: %w", autoUpdateOptions.stagedTimeout, err)
+	}
+	autoUpdateOptions.StagedTimeout = stagedTimeout
 
 	allReports, failures := registry.ContainerEngine().AutoUpdate(registry.Context(), autoUpdateOptions.AutoUpdateOptions)
 	if allReports == nil {
@@ -90,12 +102,14 @@ func autoUpdate(cmd *cobra.Command, args []string) error {
 }
 
 type autoUpdateOutput struct {
+	Artifacts     string
 	Unit          string
 	Container     string
 	ContainerName string
 	ContainerID   string
 	Image         string
 	Policy        string
+	Stage         string
 	Updated       string
 }
 
@@ -103,12 +117,14 @@ func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput
 	output := make([]autoUpdateOutput, len(allReports))
 	for i, r := range allReports {
 		output[i] = autoUpdateOutput{
+			Artifacts:     strings.Join(r.ArtifactNames, ","),
 			Unit:          r.SystemdUnit,
 			Container:     fmt.Sprintf("%s (%s)", r.ContainerID[:12], r.ContainerName),
 			ContainerName: r.ContainerName,
 			ContainerID:   r.ContainerID,
 			Image:         r.ImageName,
 			Policy:        r.Policy,
+			Stage:         r.Stage,
 			Updated:       r.Updated,
 		}
 	}
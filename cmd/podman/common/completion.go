@@ -196,6 +196,28 @@ func getVolumes(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCom
 	return suggestions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func getPlugins(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	plugins, err := engine.PluginList(registry.Context(), entities.PluginListOptions{})
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, p := range plugins {
+		if strings.HasPrefix(p.Name, toComplete) {
+			suggestions = append(suggestions, p.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 func getImages(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
 	suggestions := []string{}
 	listOptions := entities.ImageListOptions{}
@@ -715,6 +737,14 @@ func AutocompleteSecrets(cmd *cobra.Command, args []string, toComplete string) (
 	return getSecrets(cmd, toComplete, completeDefault)
 }
 
+// AutocompletePlugins - Autocomplete volume plugins.
+func AutocompletePlugins(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return getPlugins(cmd, toComplete)
+}
+
 func AutocompleteSecretCreate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if len(args) == 1 {
 		return nil, cobra.ShellCompDirectiveDefault
@@ -1125,10 +1155,10 @@ func AutocompleteImageVolume(cmd *cobra.Command, args []string, toComplete strin
 }
 
 // AutocompleteLogDriver - Autocomplete log-driver options.
-// -> "journald", "none", "k8s-file", "passthrough", "passthrough-tty"
+// -> "journald", "none", "k8s-file", "syslog", "passthrough", "passthrough-tty"
 func AutocompleteLogDriver(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	// don't show json-file
-	logDrivers := []string{define.JournaldLogging, define.NoLogging, define.KubernetesLogging}
+	logDrivers := []string{define.JournaldLogging, define.NoLogging, define.KubernetesLogging, define.SyslogLogging}
 	if !registry.IsRemote() {
 		logDrivers = append(logDrivers, define.PassthroughLogging, define.PassthroughTTYLogging)
 	}
@@ -1136,9 +1166,9 @@ func AutocompleteLogDriver(cmd *cobra.Command, args []string, toComplete string)
 }
 
 // AutocompleteLogOpt - Autocomplete log-opt options.
-// -> "path=", "tag="
+// -> "path=", "tag=", "max-size=", "max-file=", "compress=", "syslog-address=", "syslog-facility=", "syslog-format="
 func AutocompleteLogOpt(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	logOptions := []string{"path=", "tag=", "max-size="}
+	logOptions := []string{"path=", "tag=", "max-size=", "max-file=", "compress=", "syslog-address=", "syslog-facility=", "syslog-format="}
 	if strings.HasPrefix(toComplete, "path=") {
 		return nil, cobra.ShellCompDirectiveDefault
 	}
@@ -1557,14 +1587,14 @@ func getMethodNames(f reflect.Value, prefix string) []formatSuggestion {
 }
 
 // AutocompleteEventFilter - Autocomplete event filter flag options.
-// -> "container=", "event=", "image=", "pod=", "volume=", "type="
+// -> "container=", "event=", "exitCode=", "health=", "image=", "name=", "pod=", "volume=", "type="
 func AutocompleteEventFilter(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	event := func(_ string) ([]string, cobra.ShellCompDirective) {
 		return []string{events.Attach.String(), events.AutoUpdate.String(), events.Checkpoint.String(), events.Cleanup.String(),
 			events.Commit.String(), events.Create.String(), events.Exec.String(), events.ExecDied.String(),
 			events.Exited.String(), events.Export.String(), events.Import.String(), events.Init.String(), events.Kill.String(),
 			events.LoadFromArchive.String(), events.Mount.String(), events.NetworkConnect.String(),
-			events.NetworkDisconnect.String(), events.Pause.String(), events.Prune.String(), events.Pull.String(),
+			events.NetworkDisconnect.String(), events.OOM.String(), events.Pause.String(), events.Prune.String(), events.Pull.String(),
 			events.PullError.String(), events.Push.String(), events.Refresh.String(), events.Remove.String(),
 			events.Rename.String(), events.Renumber.String(), events.Restart.String(), events.Restore.String(),
 			events.Save.String(), events.Start.String(), events.Stop.String(), events.Sync.String(), events.Tag.String(),
@@ -1576,18 +1606,33 @@ func AutocompleteEventFilter(cmd *cobra.Command, args []string, toComplete strin
 			events.Pod.String(), events.System.String(), events.Volume.String(), events.Secret.String(),
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
+	health := func(_ string) ([]string, cobra.ShellCompDirective) {
+		return []string{define.HealthCheckHealthy, define.HealthCheckUnhealthy, define.HealthCheckStarting,
+			define.HealthCheckHealthy + "->" + define.HealthCheckUnhealthy,
+			define.HealthCheckUnhealthy + "->" + define.HealthCheckHealthy,
+		}, cobra.ShellCompDirectiveNoFileComp
+	}
 	kv := keyValueCompletion{
 		"container=": func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeDefault) },
 		"image=":     func(s string) ([]string, cobra.ShellCompDirective) { return getImages(cmd, s) },
 		"pod=":       func(s string) ([]string, cobra.ShellCompDirective) { return getPods(cmd, s, completeDefault) },
 		"volume=":    func(s string) ([]string, cobra.ShellCompDirective) { return getVolumes(cmd, s) },
 		"event=":     event,
+		"exitCode=":  nil,
+		"health=":    health,
 		"label=":     nil,
+		"name=":      nil,
 		"type=":      eventTypes,
 	}
 	return completeKeyValues(toComplete, kv)
 }
 
+// AutocompleteEventAggregate - Autocomplete event aggregate flag options.
+// -> "id", "image", "name", "pod", "status", "type"
+func AutocompleteEventAggregate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return events.AggregateFields(), cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteSystemdRestartOptions - Autocomplete systemd restart options.
 // -> "no", "on-success", "on-failure", "on-abnormal", "on-watchdog", "on-abort", "always"
 func AutocompleteSystemdRestartOptions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -1676,7 +1721,7 @@ func AutocompleteImageSaveFormat(cmd *cobra.Command, args []string, toComplete s
 // -> "unknown", "configured", "created", "running", "stopped", "paused", "exited", "removing"
 func AutocompleteWaitCondition(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	states := []string{"unknown", "configured", "created", "exited",
-		"healthy", "initialized", "paused", "removing", "running",
+		"healthy", "initialized", "paused", "ready", "removing", "running",
 		"stopped", "stopping", "unhealthy"}
 	return states, cobra.ShellCompDirectiveNoFileComp
 }
@@ -1871,6 +1916,16 @@ func AutocompleteSecretFilters(cmd *cobra.Command, args []string, toComplete str
 	return completeKeyValues(toComplete, kv)
 }
 
+// AutocompletePluginFilters - Autocomplete plugin ls --filter options.
+func AutocompletePluginFilters(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	kv := keyValueCompletion{
+		"enabled=": getBoolCompletion,
+		"name=":    func(s string) ([]string, cobra.ShellCompDirective) { return getPlugins(cmd, s) },
+		"running=": getBoolCompletion,
+	}
+	return completeKeyValues(toComplete, kv)
+}
+
 // AutocompleteCheckpointCompressType - Autocomplete checkpoint compress type options.
 // -> "gzip", "none", "zstd"
 func AutocompleteCheckpointCompressType(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
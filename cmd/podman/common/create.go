@@ -567,6 +567,30 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(healthCmdFlagName, completion.AutocompleteNone)
 
+		healthHTTPFlagName := "health-http"
+		createFlags.StringVar(
+			&cf.HealthHTTP,
+			healthHTTPFlagName, "",
+			"set a URL Podman sends HTTP GET requests to as the healthcheck of the container",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(healthHTTPFlagName, completion.AutocompleteNone)
+
+		healthTCPFlagName := "health-tcp"
+		createFlags.StringVar(
+			&cf.HealthTCP,
+			healthTCPFlagName, "",
+			"set a `[host:]port` Podman opens TCP connections to as the healthcheck of the container",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(healthTCPFlagName, completion.AutocompleteNone)
+
+		healthGRPCFlagName := "health-grpc"
+		createFlags.StringVar(
+			&cf.HealthGRPC,
+			healthGRPCFlagName, "",
+			"set a `[host:]port[/service]` Podman calls the gRPC health checking protocol at as the healthcheck of the container",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(healthGRPCFlagName, completion.AutocompleteNone)
+
 		info := ""
 		if mode == entities.UpdateMode {
 			info = "Changing this setting resets timer."
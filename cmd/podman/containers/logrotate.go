@@ -0,0 +1,32 @@
+package containers
+
+import (
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logRotateDescription = `Rotate the log file of a container once it exceeds the maximum log size, until the container stops.
+
+  This command is used internally when running containers with the max-file log option.`
+	logRotateCommand = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "logrotate CONTAINER",
+		Short:       "Rotate the log file of a container",
+		Long:        logRotateDescription,
+		RunE:        logRotate,
+		Args:        cobra.ExactArgs(1),
+		Hidden:      true,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: logRotateCommand,
+		Parent:  containerCmd,
+	})
+}
+
+func logRotate(cmd *cobra.Command, args []string) error {
+	return registry.ContainerEngine().ContainerLogRotate(registry.Context(), args[0])
+}
@@ -381,9 +381,15 @@ func (l psReporter) Status() string {
 		//nolint:staticcheck
 		state = strings.Title(l.ListContainer.State)
 	}
-	hc := l.ListContainer.Status
-	if hc != "" {
-		state += " (" + hc + ")"
+	var conditions []string
+	if hc := l.ListContainer.Status; hc != "" {
+		conditions = append(conditions, hc)
+	}
+	if readiness := l.ListContainer.Readiness; readiness != "" {
+		conditions = append(conditions, readiness)
+	}
+	if len(conditions) > 0 {
+		state += " (" + strings.Join(conditions, ", ") + ")"
 	}
 	return state
 }
@@ -2,6 +2,7 @@ package containers
 
 import (
 	"context"
+	"os"
 	"strings"
 
 	"github.com/containers/common/pkg/auth"
@@ -25,7 +26,6 @@ var (
 	runlabelOptions     = runlabelOptionsWrapper{}
 	runlabelDescription = "Executes a command as described by a container image label."
 	runlabelCommand     = &cobra.Command{
-		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
 		Use:               "runlabel [options] LABEL IMAGE [ARG...]",
 		Short:             "Execute the command described by an image label",
 		Long:              runlabelDescription,
@@ -95,5 +95,20 @@ func runlabel(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
+	if registry.IsRemote() {
+		// The command of the label is executed on the client.  Make sure
+		// nested podman commands connect to the same service.
+		if err := os.Unsetenv("CONTAINER_CONNECTION"); err != nil {
+			return err
+		}
+		if err := os.Setenv("CONTAINER_HOST", registry.PodmanConfig().URI); err != nil {
+			return err
+		}
+		if identity := registry.PodmanConfig().Identity; identity != "" {
+			if err := os.Setenv("CONTAINER_SSHKEY", identity); err != nil {
+				return err
+			}
+		}
+	}
 	return registry.ContainerEngine().ContainerRunlabel(context.Background(), strings.TrimPrefix(args[0], "/"), args[1], args[2:], runlabelOptions.ContainerRunlabelOptions)
 }
@@ -0,0 +1,52 @@
+package containers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syslogDescription = `Send the log of a container to syslog until the container stops.
+
+  This command is used internally when running containers with the syslog log driver.`
+	syslogCommand = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "syslog [options] CONTAINER",
+		Short:       "Send the log of a container to syslog",
+		Long:        syslogDescription,
+		RunE:        syslog,
+		Args:        cobra.ExactArgs(1),
+		Hidden:      true,
+	}
+
+	syslogSince string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: syslogCommand,
+		Parent:  containerCmd,
+	})
+
+	flags := syslogCommand.Flags()
+	sinceFlagName := "since"
+	flags.StringVar(&syslogSince, sinceFlagName, "", "Send log lines written since the RFC 3339 timestamp")
+	_ = syslogCommand.RegisterFlagCompletionFunc(sinceFlagName, completion.AutocompleteNone)
+}
+
+func syslog(cmd *cobra.Command, args []string) error {
+	var options entities.ContainerSyslogOptions
+	if syslogSince != "" {
+		since, err := time.Parse(time.RFC3339Nano, syslogSince)
+		if err != nil {
+			return fmt.Errorf("parsing --since %q: %w", syslogSince, err)
+		}
+		options.Since = since
+	}
+	return registry.ContainerEngine().ContainerSyslog(registry.Context(), args[0], options)
+}
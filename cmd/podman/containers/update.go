@@ -2,6 +2,7 @@ package containers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -61,7 +62,7 @@ func init() {
 	updateFlags(containerUpdateCommand)
 }
 
-func GetChangedHealthCheckConfiguration(cmd *cobra.Command, vals *entities.ContainerCreateOptions) define.UpdateHealthCheckConfig {
+func GetChangedHealthCheckConfiguration(cmd *cobra.Command, vals *entities.ContainerCreateOptions) (define.UpdateHealthCheckConfig, error) {
 	updateHealthCheckConfig := define.UpdateHealthCheckConfig{}
 
 	if cmd.Flags().Changed("health-log-destination") {
@@ -82,6 +83,16 @@ func GetChangedHealthCheckConfiguration(cmd *cobra.Command, vals *entities.Conta
 	if cmd.Flags().Changed("health-cmd") {
 		updateHealthCheckConfig.HealthCmd = &vals.HealthCmd
 	}
+	if cmd.Flags().Changed("health-http") || cmd.Flags().Changed("health-tcp") || cmd.Flags().Changed("health-grpc") {
+		if cmd.Flags().Changed("health-cmd") {
+			return updateHealthCheckConfig, errors.New("cannot specify both --health-cmd and --health-http, --health-tcp or --health-grpc")
+		}
+		probeCmd, err := specgenutil.MakeHealthCheckProbeCmd(vals.HealthHTTP, vals.HealthTCP, vals.HealthGRPC)
+		if err != nil {
+			return updateHealthCheckConfig, err
+		}
+		updateHealthCheckConfig.HealthCmd = &probeCmd
+	}
 	if cmd.Flags().Changed("health-interval") {
 		updateHealthCheckConfig.HealthInterval = &vals.HealthInterval
 	}
@@ -110,7 +121,7 @@ func GetChangedHealthCheckConfiguration(cmd *cobra.Command, vals *entities.Conta
 		updateHealthCheckConfig.HealthStartupSuccess = &vals.StartupHCSuccesses
 	}
 
-	return updateHealthCheckConfig
+	return updateHealthCheckConfig, nil
 }
 
 func GetChangedDeviceLimits(s *specgen.SpecGenerator) *define.UpdateContainerDevicesLimits {
@@ -143,7 +154,10 @@ func update(cmd *cobra.Command, args []string) error {
 		s.ResourceLimits = &specs.LinuxResources{}
 	}
 
-	healthCheckConfig := GetChangedHealthCheckConfiguration(cmd, &updateOpts)
+	healthCheckConfig, err := GetChangedHealthCheckConfiguration(cmd, &updateOpts)
+	if err != nil {
+		return err
+	}
 
 	opts := &entities.ContainerUpdateOptions{
 		NameOrID:                        strings.TrimPrefix(args[0], "/"),
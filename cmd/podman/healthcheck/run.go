@@ -21,6 +21,8 @@ var (
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: common.AutocompleteContainersRunning,
 	}
+
+	runOptions entities.HealthCheckOptions
 )
 
 func init() {
@@ -28,15 +30,18 @@ func init() {
 		Command: runCmd,
 		Parent:  healthCmd,
 	})
+
+	flags := runCmd.Flags()
+	flags.BoolVar(&runOptions.Readiness, "readiness", false, "Run the readiness check of the container instead of its healthcheck")
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], entities.HealthCheckOptions{})
+	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], runOptions)
 	if err != nil {
 		return err
 	}
 	switch response.Status {
-	case define.HealthCheckUnhealthy, define.HealthCheckStarting, define.HealthCheckStopped:
+	case define.HealthCheckUnhealthy, define.HealthCheckStarting, define.HealthCheckStopped, define.ReadinessCheckNotReady:
 		registry.SetExitCode(1)
 		fmt.Println(response.Status)
 	}
@@ -0,0 +1,43 @@
+package healthcheck
+
+import (
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	schedulerDescription = `Run the health checks of a container at the configured intervals until the container stops.
+
+  This command is used internally when the healthcheck_scheduler option in containers.conf is set to "podman".`
+	schedulerCmd = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "scheduler [options] CONTAINER",
+		Short:       "Run the health checks of a container periodically",
+		Long:        schedulerDescription,
+		RunE:        scheduler,
+		Args:        cobra.ExactArgs(1),
+		Hidden:      true,
+	}
+
+	schedulerOptions entities.HealthCheckSchedulerOptions
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: schedulerCmd,
+		Parent:  healthCmd,
+	})
+
+	flags := schedulerCmd.Flags()
+	nameFlagName := "name"
+	flags.StringVar(&schedulerOptions.Name, nameFlagName, "", "Name of the scheduler as recorded in the state of the container")
+	_ = schedulerCmd.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+	_ = schedulerCmd.MarkFlagRequired(nameFlagName)
+	flags.BoolVar(&schedulerOptions.Readiness, "readiness", false, "Run the readiness check rather than the healthchecks")
+}
+
+func scheduler(cmd *cobra.Command, args []string) error {
+	return registry.ContainerEngine().HealthCheckScheduler(registry.Context(), args[0], schedulerOptions)
+}
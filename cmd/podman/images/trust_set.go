@@ -16,7 +16,6 @@ import (
 var (
 	setTrustDescription = "Set default trust policy or add a new trust policy for a registry"
 	setTrustCommand     = &cobra.Command{
-		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
 		Use:               "set [options] REGISTRY",
 		Short:             "Set default trust policy or a new trust policy for a registry",
 		Long:              setTrustDescription,
@@ -15,7 +15,6 @@ var (
 	noHeading            bool
 	showTrustDescription = "Display trust policy for the system"
 	showTrustCommand     = &cobra.Command{
-		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
 		Use:               "show [options] [REGISTRY]",
 		Short:             "Display trust policy for the system",
 		Long:              showTrustDescription,
@@ -0,0 +1,124 @@
+package kube
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/utils"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffOptions     = entities.KubeDiffOptions{}
+	diffFormat      string
+	diffDescription = `Reads in a structured file of Kubernetes YAML.
+
+  Compares the pods, containers, volumes and secrets described in the YAML with the ones created by kube play and prints the changes kube play --replace would make. Exits with 1 when there are changes.`
+
+	diffCmd = &cobra.Command{
+		Use:               "diff [options] KUBEFILE|-",
+		Short:             "Show the changes kube play --replace would make",
+		Long:              diffDescription,
+		RunE:              diff,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteDefaultOneArg,
+		Example: `podman kube diff nginx.yml
+  cat nginx.yml | podman kube diff -
+  podman kube diff --format json nginx.yml`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: diffCmd,
+		Parent:  kubeCmd,
+	})
+	diffFlags(diffCmd)
+}
+
+func diffFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.SetNormalizeFunc(utils.AliasFlags)
+
+	configmapFlagName := "configmap"
+	flags.StringArrayVar(&diffOptions.ConfigMaps, configmapFlagName, []string{}, "`Pathname` of a YAML file containing a kubernetes configmap")
+	_ = cmd.RegisterFlagCompletionFunc(configmapFlagName, completion.AutocompleteDefault)
+
+	formatFlagName := "format"
+	flags.StringVar(&diffFormat, formatFlagName, "", "Change the output format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(nil))
+}
+
+func diff(cmd *cobra.Command, args []string) error {
+	if diffFormat != "" && !report.IsJSON(diffFormat) {
+		return errors.New("only supported value for '--format' is 'json'")
+	}
+	reader, err := readerFromArg(args[0])
+	if err != nil {
+		return err
+	}
+
+	diffReport, err := registry.ContainerEngine().KubeDiff(registry.Context(), reader, diffOptions)
+	if err != nil {
+		return err
+	}
+
+	if report.IsJSON(diffFormat) {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(diffReport); err != nil {
+			return err
+		}
+	} else {
+		printDiffReport(diffReport)
+	}
+
+	// Like diff(1), exit with 1 when there are changes.
+	if len(diffReport.Changes) > 0 {
+		registry.SetExitCode(1)
+	}
+	return nil
+}
+
+// printDiffReport prints the changes, one object per line followed by its
+// changed fields, and then the objects with fields that are not compared.
+func printDiffReport(diffReport *entities.KubeDiffReport) {
+	for _, change := range diffReport.Changes {
+		prefix := "~"
+		switch change.Action {
+		case entitiesTypes.KubeDiffActionAdd:
+			prefix = "+"
+		case entitiesTypes.KubeDiffActionRemove:
+			prefix = "-"
+		}
+		fmt.Printf("%s %s %s\n", prefix, change.Kind, change.Name)
+		for _, field := range change.Fields {
+			fmt.Printf("    %s: %s -> %s\n", field.Field, formatDiffValue(field.Current, field.Redacted), formatDiffValue(field.Desired, field.Redacted))
+		}
+	}
+	for _, unchecked := range diffReport.Unchecked {
+		fmt.Printf("? %s %s\n", unchecked.Kind, unchecked.Name)
+		for _, field := range unchecked.Fields {
+			fmt.Printf("    %s\n", field)
+		}
+	}
+}
+
+func formatDiffValue(value *string, redacted bool) string {
+	switch {
+	case value == nil:
+		return "<none>"
+	case redacted:
+		return "<redacted>"
+	default:
+		return fmt.Sprintf("%q", *value)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	buildahParse "github.com/containers/buildah/pkg/parse"
 	"github.com/containers/common/pkg/auth"
@@ -40,6 +41,8 @@ type playKubeOptionsWrapper struct {
 	BuildCLI       bool
 	annotations    []string
 	macs           []string
+
+	waitReadyTimeout string
 }
 
 var (
@@ -168,6 +171,13 @@ func playFlags(cmd *cobra.Command) {
 	waitFlagName := "wait"
 	flags.BoolVarP(&playOptions.Wait, waitFlagName, "w", false, "Clean up all objects created when a SIGTERM is received or pods exit")
 
+	waitReadyFlagName := "wait-ready"
+	flags.BoolVar(&playOptions.WaitReady, waitReadyFlagName, false, "Wait for all containers of the started pods to become ready")
+
+	waitReadyTimeoutFlagName := "wait-ready-timeout"
+	flags.StringVar(&playOptions.waitReadyTimeout, waitReadyTimeoutFlagName, "5m", "Maximum time to wait for the containers to become ready")
+	_ = cmd.RegisterFlagCompletionFunc(waitReadyTimeoutFlagName, completion.AutocompleteNone)
+
 	configmapFlagName := "configmap"
 	flags.StringArrayVar(&playOptions.ConfigMaps, configmapFlagName, []string{}, "`Pathname` of a YAML file containing a kubernetes configmap")
 	_ = cmd.RegisterFlagCompletionFunc(configmapFlagName, completion.AutocompleteDefault)
@@ -276,6 +286,19 @@ func play(cmd *cobra.Command, args []string) error {
 		return errors.New("--force may be specified only with --down")
 	}
 
+	if playOptions.WaitReady {
+		if !playOptions.StartCLI {
+			return errors.New("--wait-ready cannot be used with --start=false")
+		}
+		timeout, err := time.ParseDuration(playOptions.waitReadyTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid --wait-ready-timeout: %w", err)
+		}
+		playOptions.WaitReadyTimeout = timeout
+	} else if cmd.Flags().Changed("wait-ready-timeout") {
+		return errors.New("--wait-ready-timeout may be specified only with --wait-ready")
+	}
+
 	reader, err := readerFromArg(args[0])
 	if err != nil {
 		return err
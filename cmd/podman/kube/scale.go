@@ -0,0 +1,86 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/utils"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scaleOptions     = entities.KubeScaleOptions{}
+	scaleDescription = `Reads in a structured file of Kubernetes YAML.
+
+  Sets the number of replicas of the Deployments described in the YAML. Missing replicas are created and surplus replicas are removed.`
+
+	scaleCmd = &cobra.Command{
+		Use:               "scale [options] KUBEFILE|-",
+		Short:             "Scale Deployments based on Kubernetes YAML",
+		Long:              scaleDescription,
+		RunE:              scale,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteDefaultOneArg,
+		Example: `podman kube scale --replicas 3 nginx.yml
+  cat nginx.yml | podman kube scale --replicas 3 -`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: scaleCmd,
+		Parent:  kubeCmd,
+	})
+	scaleFlags(scaleCmd)
+}
+
+func scaleFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.SetNormalizeFunc(utils.AliasFlags)
+
+	replicasFlagName := "replicas"
+	flags.Int32VarP(&scaleOptions.Replicas, replicasFlagName, "r", 0, "Number of replicas of each Deployment")
+	_ = cmd.RegisterFlagCompletionFunc(replicasFlagName, completion.AutocompleteNone)
+	_ = cmd.MarkFlagRequired(replicasFlagName)
+
+	configmapFlagName := "configmap"
+	flags.StringArrayVar(&scaleOptions.ConfigMaps, configmapFlagName, []string{}, "`Pathname` of a YAML file containing a kubernetes configmap")
+	_ = cmd.RegisterFlagCompletionFunc(configmapFlagName, completion.AutocompleteDefault)
+}
+
+func scale(cmd *cobra.Command, args []string) error {
+	if scaleOptions.Replicas < 0 {
+		return errors.New("--replicas must not be negative")
+	}
+	reader, err := readerFromArg(args[0])
+	if err != nil {
+		return err
+	}
+
+	report, err := registry.ContainerEngine().KubeScale(registry.Context(), reader, scaleOptions)
+	if err != nil {
+		return err
+	}
+
+	if err := printPlayReport(report); err != nil {
+		return err
+	}
+
+	var podRmErrors utils.OutputErrors
+	if len(report.RmReport) > 0 {
+		fmt.Println("Pods removed:")
+	}
+	for _, removed := range report.RmReport {
+		switch {
+		case removed.Err != nil:
+			podRmErrors = append(podRmErrors, removed.Err)
+		default:
+			fmt.Println(removed.Id)
+		}
+	}
+	return podRmErrors.PrintErrors()
+}
@@ -18,6 +18,7 @@ import (
 	_ "github.com/containers/podman/v5/cmd/podman/machine/os"
 	_ "github.com/containers/podman/v5/cmd/podman/manifest"
 	_ "github.com/containers/podman/v5/cmd/podman/networks"
+	_ "github.com/containers/podman/v5/cmd/podman/plugins"
 	_ "github.com/containers/podman/v5/cmd/podman/pods"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	_ "github.com/containers/podman/v5/cmd/podman/secrets"
@@ -0,0 +1,79 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:               "inspect [options] PLUGIN [PLUGIN...]",
+		Short:             "Inspect a volume plugin",
+		Long:              "Display detailed information on one or more volume plugins",
+		RunE:              inspect,
+		Example:           "podman plugin inspect myplugin",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompletePlugins,
+	}
+)
+
+var inspectFormat string
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: inspectCmd,
+		Parent:  pluginCmd,
+	})
+	flags := inspectCmd.Flags()
+	formatFlagName := "format"
+	flags.StringVarP(&inspectFormat, formatFlagName, "f", "", "Format inspect output using Go template")
+	_ = inspectCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.PluginReport{}))
+}
+
+func inspect(cmd *cobra.Command, args []string) error {
+	inspected, errs, err := registry.ContainerEngine().PluginInspect(context.Background(), args)
+	if err != nil {
+		return err
+	}
+
+	// always print valid list
+	if len(inspected) == 0 {
+		inspected = []*entities.PluginReport{}
+	}
+
+	if cmd.Flags().Changed("format") && !report.IsJSON(inspectFormat) {
+		rpt := report.New(os.Stdout, cmd.Name())
+		defer rpt.Flush()
+
+		rpt, err := rpt.Parse(report.OriginUser, inspectFormat)
+		if err != nil {
+			return err
+		}
+		if err := rpt.Execute(inspected); err != nil {
+			return err
+		}
+	} else {
+		buf, err := json.MarshalIndent(inspected, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+	}
+
+	if len(errs) > 0 {
+		if len(errs) > 1 {
+			for _, err := range errs[1:] {
+				fmt.Fprintf(os.Stderr, "error inspecting plugin: %v\n", err)
+			}
+		}
+		return fmt.Errorf("inspecting plugin: %w", errs[0])
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginLsDescription = `
+podman plugin ls
+
+List the volume plugins configured in containers.conf. The output of the plugins can be filtered
+and the output format can be changed to JSON or a user specified Go template.`
+	lsCommand = &cobra.Command{
+		Use:               "ls [options]",
+		Aliases:           []string{"list"},
+		Args:              validate.NoArgs,
+		Short:             "List volume plugins",
+		Long:              pluginLsDescription,
+		RunE:              list,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman plugin ls
+  podman plugin ls --filter enabled=false
+  podman plugin ls --format "{{.Name}} {{.SocketPath}}"`,
+	}
+)
+
+var (
+	// Temporary struct to hold cli values.
+	lsCliOpts = struct {
+		Filter []string
+		Format string
+		Quiet  bool
+	}{}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: lsCommand,
+		Parent:  pluginCmd,
+	})
+	flags := lsCommand.Flags()
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&lsCliOpts.Filter, filterFlagName, "f", []string{}, "Filter plugin output")
+	_ = lsCommand.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePluginFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(&lsCliOpts.Format, formatFlagName, "{{range .}}{{.Name}}\t{{.Enabled}}\t{{.Running}}\t{{.Volumes}}\t{{.SocketPath}}\n{{end -}}", "Format plugin output using Go template")
+	_ = lsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.PluginReport{}))
+
+	flags.BoolP("noheading", "n", false, "Do not print headers")
+	flags.BoolVarP(&lsCliOpts.Quiet, "quiet", "q", false, "Print plugin names only")
+}
+
+func list(cmd *cobra.Command, _ []string) error {
+	if lsCliOpts.Quiet && cmd.Flag("format").Changed {
+		return errors.New("quiet and format flags cannot be used together")
+	}
+	filters, err := parse.FilterArgumentsIntoFilters(lsCliOpts.Filter)
+	if err != nil {
+		return err
+	}
+
+	responses, err := registry.ContainerEngine().PluginList(context.Background(), entities.PluginListOptions{Filters: filters})
+	if err != nil {
+		return err
+	}
+
+	if report.IsJSON(lsCliOpts.Format) {
+		b, err := json.MarshalIndent(responses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	noHeading, _ := cmd.Flags().GetBool("noheading")
+	headers := report.Headers(entities.PluginReport{}, map[string]string{
+		"SocketPath": "SOCKET",
+	})
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	switch {
+	case cmd.Flag("format").Changed:
+		rpt, err = rpt.Parse(report.OriginUser, lsCliOpts.Format)
+	case lsCliOpts.Quiet:
+		rpt, err = rpt.Parse(report.OriginUser, "{{.Name}}\n")
+	default:
+		rpt, err = rpt.Parse(report.OriginPodman, lsCliOpts.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(responses)
+}
@@ -0,0 +1,26 @@
+package plugins
+
+import (
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Pull in configured json library
+	json = registry.JSONLibrary()
+
+	// Command: podman _plugin_
+	pluginCmd = &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage volume plugins",
+		Long:  "Manage the volume plugins configured in containers.conf",
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginCmd,
+	})
+}
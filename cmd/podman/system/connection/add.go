@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -28,6 +29,7 @@ var (
     [user@]hostname (will default to ssh)
     ssh://[user@]hostname[:port][/path] (will obtain socket path from service, if not given.)
     tcp://hostname:port (not secured)
+    tcp+tls://hostname:port or https://hostname:port (secured with TLS)
     unix://path (absolute path required)
 `,
 		RunE:              add,
@@ -36,6 +38,7 @@ var (
   podman system connection add --identity ~/.ssh/dev_rsa testing ssh://root@server.fubar.com:2222
   podman system connection add --identity ~/.ssh/dev_rsa --port 22 production root@server.fubar.com
   podman system connection add debug tcp://localhost:8080
+  podman system connection add --tls-ca ca.pem --tls-cert cert.pem --tls-key key.pem secure tcp+tls://server.fubar.com:8443
   `,
 	}
 
@@ -56,6 +59,9 @@ var (
 		UDSPath  string
 		Default  bool
 		Farm     string
+		TLSCA    string
+		TLSCert  string
+		TLSKey   string
 	}{}
 )
 
@@ -78,6 +84,18 @@ func init() {
 	flags.StringVar(&cOpts.UDSPath, socketPathFlagName, "", "path to podman socket on remote host. (default '/run/podman/podman.sock' or '/run/user/{uid}/podman/podman.sock)")
 	_ = addCmd.RegisterFlagCompletionFunc(socketPathFlagName, completion.AutocompleteDefault)
 
+	tlsCAFlagName := "tls-ca"
+	flags.StringVar(&cOpts.TLSCA, tlsCAFlagName, "", "path to the CA certificate verifying the TLS service")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsCAFlagName, completion.AutocompleteDefault)
+
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&cOpts.TLSCert, tlsCertFlagName, "", "path to the TLS client certificate")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&cOpts.TLSKey, tlsKeyFlagName, "", "path to the key of the TLS client certificate")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
+
 	farmFlagName := "farm"
 	flags.StringVarP(&cOpts.Farm, farmFlagName, "f", "", "Add the new connection to the given farm")
 	_ = addCmd.RegisterFlagCompletionFunc(farmFlagName, common.AutoCompleteFarms)
@@ -139,6 +157,11 @@ func add(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid ssh mode")
 	}
 
+	tlsSet := cOpts.TLSCA != "" || cOpts.TLSCert != "" || cOpts.TLSKey != ""
+	if tlsSet && uri.Scheme != "tcp+tls" && uri.Scheme != "https" {
+		return fmt.Errorf("--tls-ca, --tls-cert and --tls-key options not supported for %s scheme", uri.Scheme)
+	}
+
 	switch uri.Scheme {
 	case "ssh":
 		return ssh.Create(entities, sshMode)
@@ -172,6 +195,40 @@ func add(cmd *cobra.Command, args []string) error {
 		if uri.Port() == "" {
 			return errors.New("tcp scheme requires a port either via --port or in destination URL")
 		}
+	case "tcp+tls", "https":
+		if cmd.Flags().Changed("socket-path") {
+			return fmt.Errorf("--socket-path option not supported for %s scheme", uri.Scheme)
+		}
+		if cmd.Flags().Changed("identity") {
+			return fmt.Errorf("--identity option not supported for %s scheme", uri.Scheme)
+		}
+		if uri.Port() == "" {
+			return fmt.Errorf("%s scheme requires a port in destination URL", uri.Scheme)
+		}
+		if (cOpts.TLSCert == "") != (cOpts.TLSKey == "") {
+			return errors.New("--tls-cert and --tls-key must be used together")
+		}
+		// The TLS files are stored in the query of the URI, see
+		// bindings.NewConnectionWithOptions.
+		query := uri.Query()
+		for _, param := range []struct {
+			name string
+			path string
+		}{
+			{"tls_ca", cOpts.TLSCA},
+			{"tls_cert", cOpts.TLSCert},
+			{"tls_key", cOpts.TLSKey},
+		} {
+			if param.path == "" {
+				continue
+			}
+			path, err := filepath.Abs(param.path)
+			if err != nil {
+				return err
+			}
+			query.Set(param.name, path)
+		}
+		uri.RawQuery = query.Encode()
 	default:
 		logrus.Warnf("%q unknown scheme, no validation provided", uri.Scheme)
 	}
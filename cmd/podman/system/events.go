@@ -2,8 +2,10 @@ package system
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/common/pkg/report"
@@ -29,6 +31,7 @@ var (
 		ValidArgsFunction: completion.AutocompleteNone,
 		Example: `podman events
   podman events --filter event=create
+  podman events --stream=false --since 24h --filter event=restart --aggregate name
   podman events --format {{.Image}}
   podman events --since 1h30s`,
 	}
@@ -45,9 +48,10 @@ var (
 )
 
 var (
-	eventOptions entities.EventsOptions
-	eventFormat  string
-	noTrunc      bool
+	eventOptions   entities.EventsOptions
+	eventFormat    string
+	eventAggregate []string
+	noTrunc        bool
 )
 
 type Event struct {
@@ -115,6 +119,11 @@ func init() {
 func eventsFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 
+	aggregateFlagName := "aggregate"
+	flags.StringSliceVar(&eventAggregate, aggregateFlagName, nil, "count the events grouped by the specified fields")
+	flags.Lookup(aggregateFlagName).NoOptDefVal = "name,status"
+	_ = cmd.RegisterFlagCompletionFunc(aggregateFlagName, common.AutocompleteEventAggregate)
+
 	filterFlagName := "filter"
 	flags.StringArrayVarP(&eventOptions.Filter, filterFlagName, "f", []string{}, "filter output")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompleteEventFilter)
@@ -161,6 +170,18 @@ func eventsCmd(cmd *cobra.Command, _ []string) error {
 		}
 	}
 
+	var aggregator *events.Aggregator
+	if cmd.Flags().Changed("aggregate") {
+		if eventOptions.Stream {
+			return errors.New("--aggregate requires --stream=false")
+		}
+		var err error
+		aggregator, err = events.NewAggregator(eventAggregate)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := registry.ContainerEngine().Events(context.Background(), eventOptions)
 	if err != nil {
 		return err
@@ -172,6 +193,8 @@ func eventsCmd(cmd *cobra.Command, _ []string) error {
 			continue
 		}
 		switch {
+		case aggregator != nil:
+			aggregator.Add(evt.Event)
 		case doJSON:
 			e := newEventFromLibpodEvent(evt.Event)
 			jsonStr, err := e.ToJSONString()
@@ -187,5 +210,53 @@ func eventsCmd(cmd *cobra.Command, _ []string) error {
 			fmt.Println(evt.Event.ToHumanReadable(!noTrunc))
 		}
 	}
+	if aggregator != nil {
+		return printAggregatedEvents(cmd, aggregator)
+	}
 	return nil
 }
+
+// aggregateColumns are the template fields of the fields events can be
+// aggregated by.
+var aggregateColumns = map[string]string{
+	"id":     "{{.ID}}",
+	"image":  "{{.Image}}",
+	"name":   "{{.Name}}",
+	"pod":    "{{.PodID}}",
+	"status": "{{.Status}}",
+	"type":   "{{.Type}}",
+}
+
+func printAggregatedEvents(cmd *cobra.Command, aggregator *events.Aggregator) error {
+	results := aggregator.Results()
+	if report.IsJSON(eventFormat) {
+		b, err := json.MarshalIndent(results, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	var err error
+	if cmd.Flags().Changed("format") {
+		rpt, err = rpt.Parse(report.OriginUser, eventFormat)
+	} else {
+		columns := make([]string, 0, len(aggregator.Fields())+1)
+		for _, field := range aggregator.Fields() {
+			columns = append(columns, aggregateColumns[field])
+		}
+		columns = append(columns, "{{.Count}}")
+		rpt, err = rpt.Parse(report.OriginPodman, "{{range .}}"+strings.Join(columns, "\t")+"\n{{end -}}")
+	}
+	if err != nil {
+		return err
+	}
+	hdrs := report.Headers(events.AggregateResult{}, map[string]string{
+		"PodID": "POD ID",
+	})
+	return writeTemplate(rpt, hdrs, results)
+}
@@ -3,15 +3,19 @@
 package system
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/containersconf"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/rootless"
 	"github.com/containers/podman/v5/pkg/systemd"
@@ -40,12 +44,24 @@ Enable a listening service for API access to Podman commands.
 	}
 
 	srvArgs = struct {
-		CorsHeaders string
-		PProfAddr   string
-		Timeout     uint
+		AuthorizationPlugins       []string
+		AutoUpdateWebhookAddr      string
+		AutoUpdateWebhookTokenFile string
+		CorsHeaders                string
+		MetricsAddr                string
+		PProfAddr                  string
+		Timeout                    uint
+		TLSCert                    string
+		TLSClientCA                string
+		TLSKey                     string
 	}{}
 )
 
+// autoUpdateWebhookTokenEnv is the environment variable the token of the
+// auto-update webhook is read from unless a token file is specified.  The
+// token is not accepted as a flag to not expose it in the process list.
+const autoUpdateWebhookTokenEnv = "PODMAN_AUTO_UPDATE_WEBHOOK_TOKEN"
+
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
 		Command: srvCmd,
@@ -61,9 +77,41 @@ func init() {
 	_ = srvCmd.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
 	flags.SetNormalizeFunc(aliasTimeoutFlag)
 
+	authorizationPluginFlagName := "authorization-plugin"
+	flags.StringArrayVar(&srvArgs.AuthorizationPlugins, authorizationPluginFlagName, nil,
+		"Authorization plugin socket or name consulted before serving a request (can be specified multiple times)")
+	_ = srvCmd.RegisterFlagCompletionFunc(authorizationPluginFlagName, completion.AutocompleteDefault)
+
 	flags.StringVarP(&srvArgs.CorsHeaders, "cors", "", "", "Set CORS Headers")
 	_ = srvCmd.RegisterFlagCompletionFunc("cors", completion.AutocompleteNone)
 
+	autoUpdateWebhookAddrFlagName := "auto-update-webhook-address"
+	flags.StringVar(&srvArgs.AutoUpdateWebhookAddr, autoUpdateWebhookAddrFlagName, "",
+		"Binding network address for the endpoint receiving registry notifications to trigger auto updates, default: do not expose the endpoint")
+	_ = srvCmd.RegisterFlagCompletionFunc(autoUpdateWebhookAddrFlagName, completion.AutocompleteNone)
+
+	autoUpdateWebhookTokenFileFlagName := "auto-update-webhook-token-file"
+	flags.StringVar(&srvArgs.AutoUpdateWebhookTokenFile, autoUpdateWebhookTokenFileFlagName, "",
+		"Path to a file containing the bearer token registries must send to the auto-update webhook")
+	_ = srvCmd.RegisterFlagCompletionFunc(autoUpdateWebhookTokenFileFlagName, completion.AutocompleteDefault)
+
+	metricsAddrFlagName := "metrics-addr"
+	flags.StringVar(&srvArgs.MetricsAddr, metricsAddrFlagName, "",
+		"Binding network address for the Prometheus metrics endpoint, default: do not expose the endpoint")
+	_ = srvCmd.RegisterFlagCompletionFunc(metricsAddrFlagName, completion.AutocompleteNone)
+
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&srvArgs.TLSCert, tlsCertFlagName, "", "Path to the certificate to serve the API over TLS")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&srvArgs.TLSKey, tlsKeyFlagName, "", "Path to the key of the TLS certificate")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
+
+	tlsClientCAFlagName := "tls-client-ca"
+	flags.StringVar(&srvArgs.TLSClientCA, tlsClientCAFlagName, "", "Path to the CA certificate that client certificates must be signed by")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsClientCAFlagName, completion.AutocompleteDefault)
+
 	flags.StringVarP(&srvArgs.PProfAddr, "pprof-address", "", "",
 		"Binding network address for pprof profile endpoints, default: do not expose endpoints")
 	_ = flags.MarkHidden("pprof-address")
@@ -82,6 +130,13 @@ func service(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if (srvArgs.TLSCert == "") != (srvArgs.TLSKey == "") {
+		return errors.New("--tls-cert and --tls-key must be used together")
+	}
+	if srvArgs.TLSClientCA != "" && srvArgs.TLSCert == "" {
+		return errors.New("--tls-client-ca requires --tls-cert and --tls-key")
+	}
+
 	// Clean up any old existing unix domain socket
 	if len(apiURI) > 0 {
 		uri, err := url.Parse(apiURI)
@@ -90,6 +145,9 @@ func service(cmd *cobra.Command, args []string) error {
 		}
 
 		// socket activation uses a unix:// socket in the shipped unit files but apiURI is coded as "" at this layer.
+		if uri.Scheme == "unix" && srvArgs.TLSCert != "" {
+			return errors.New("TLS is not supported for unix:// URIs")
+		}
 		if uri.Scheme == "unix" && !registry.IsRemote() {
 			if err := syscall.Unlink(uri.Path); err != nil && !os.IsNotExist(err) {
 				return err
@@ -99,14 +157,61 @@ func service(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	autoUpdateWebhookToken, err := readAutoUpdateWebhookToken()
+	if err != nil {
+		return err
+	}
+
+	// The default plugins are read here rather than as flag default so
+	// that containers.conf modules are honored.
+	if !cmd.Flags().Changed("authorization-plugin") {
+		podmanConfig, err := containersconf.New(registry.PodmanConfig().ContainersConf.LoadedModules())
+		if err != nil {
+			return err
+		}
+		srvArgs.AuthorizationPlugins = podmanConfig.Engine.AuthorizationPlugins
+	}
+
 	return restService(cmd.Flags(), registry.PodmanConfig(), entities.ServiceOptions{
-		CorsHeaders: srvArgs.CorsHeaders,
-		PProfAddr:   srvArgs.PProfAddr,
-		Timeout:     time.Duration(srvArgs.Timeout) * time.Second,
-		URI:         apiURI,
+		AuthorizationPlugins:   srvArgs.AuthorizationPlugins,
+		AutoUpdateWebhookAddr:  srvArgs.AutoUpdateWebhookAddr,
+		AutoUpdateWebhookToken: autoUpdateWebhookToken,
+		CorsHeaders:            srvArgs.CorsHeaders,
+		MetricsAddr:            srvArgs.MetricsAddr,
+		PProfAddr:              srvArgs.PProfAddr,
+		Timeout:                time.Duration(srvArgs.Timeout) * time.Second,
+		TLSCertFile:            srvArgs.TLSCert,
+		TLSClientCAFile:        srvArgs.TLSClientCA,
+		TLSKeyFile:             srvArgs.TLSKey,
+		URI:                    apiURI,
 	})
 }
 
+// readAutoUpdateWebhookToken returns the token required by the auto-update
+// webhook from the token file or, if not specified, the environment.
+func readAutoUpdateWebhookToken() (string, error) {
+	if srvArgs.AutoUpdateWebhookAddr == "" {
+		if srvArgs.AutoUpdateWebhookTokenFile != "" {
+			return "", errors.New("--auto-update-webhook-token-file requires --auto-update-webhook-address")
+		}
+		return "", nil
+	}
+
+	token := os.Getenv(autoUpdateWebhookTokenEnv)
+	if srvArgs.AutoUpdateWebhookTokenFile != "" {
+		content, err := os.ReadFile(srvArgs.AutoUpdateWebhookTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("reading auto-update webhook token: %w", err)
+		}
+		token = string(content)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("--auto-update-webhook-address requires a token set via --auto-update-webhook-token-file or $%s", autoUpdateWebhookTokenEnv)
+	}
+	return token, nil
+}
+
 func resolveAPIURI(uri []string) (string, error) {
 	// When determining _*THE*_ listening endpoint --
 	// 1) User input wins always
@@ -77,10 +77,13 @@ func restService(flags *pflag.FlagSet, cfg *entities.PodmanConfig, opts entities
 			}
 		case "tcp":
 			// We want to check if the user is requesting a TCP address.
-			// If so, warn that this is insecure.
+			// If so, warn that this is insecure unless clients are
+			// authenticated with TLS certificates.
 			// Ignore errors here, the actual backend code will handle them
 			// better than we can here.
-			logrus.Warnf("Using the Podman API service with TCP sockets is not recommended, please see `podman system service` manpage for details")
+			if opts.TLSClientCAFile == "" {
+				logrus.Warnf("Using the Podman API service with TCP sockets is not recommended, please see `podman system service` manpage for details")
+			}
 
 			host := uri.Host
 			if host == "" {
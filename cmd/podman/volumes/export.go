@@ -4,13 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/errorhandling"
-	"github.com/containers/podman/v5/utils"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -21,7 +21,6 @@ podman volume export
 
 Allow content of volume to be exported into external tar.`
 	exportCommand = &cobra.Command{
-		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
 		Use:               "export [options] VOLUME",
 		Short:             "Export volumes",
 		Args:              cobra.ExactArgs(1),
@@ -59,6 +58,8 @@ func export(cmd *cobra.Command, args []string) error {
 		return errors.New("expects output path, use --output=[path]")
 	}
 	inspectOpts.Type = common.VolumeType
+	// Inspect the volume first for a consistent error message if it does
+	// not exist.
 	volumeData, errs, err := containerEngine.VolumeInspect(ctx, args, inspectOpts)
 	if err != nil {
 		return err
@@ -69,31 +70,15 @@ func export(cmd *cobra.Command, args []string) error {
 	if len(volumeData) < 1 {
 		return errors.New("no volume data found")
 	}
-	mountPoint := volumeData[0].VolumeConfigResponse.Mountpoint
-	driver := volumeData[0].VolumeConfigResponse.Driver
-	volumeOptions := volumeData[0].VolumeConfigResponse.Options
-	volumeMountStatus, err := containerEngine.VolumeMounted(ctx, args[0])
+
+	logrus.Debugf("Exporting volume data from %s to %s", args[0], cliExportOpts.Output)
+	file, err := os.Create(cliExportOpts.Output)
 	if err != nil {
-		return err
-	}
-	if mountPoint == "" {
-		return errors.New("volume is not mounted anywhere on host")
+		return fmt.Errorf("could not create tarball file '%s': %w", cliExportOpts.Output, err)
 	}
-	// Check if volume is using external plugin and export only if volume is mounted
-	if driver != "" && driver != "local" {
-		if !volumeMountStatus.Value {
-			return fmt.Errorf("volume is using a driver %s and volume is not mounted on %s", driver, mountPoint)
-		}
-	}
-	// Check if volume is using `local` driver and has mount options type other than tmpfs
-	if driver == "local" {
-		if mountOptionType, ok := volumeOptions["type"]; ok {
-			if mountOptionType != "tmpfs" && !volumeMountStatus.Value {
-				return fmt.Errorf("volume is using a driver %s and volume is not mounted on %s", driver, mountPoint)
-			}
-		}
+	defer file.Close()
+	if err := containerEngine.VolumeExport(ctx, args[0], entities.VolumeExportOptions{Output: file}); err != nil {
+		return err
 	}
-	logrus.Debugf("Exporting volume data from %s to %s", mountPoint, cliExportOpts.Output)
-	err = utils.CreateTarFromSrc(mountPoint, cliExportOpts.Output)
-	return err
+	return file.Close()
 }
@@ -3,6 +3,7 @@
 package libpod
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -581,14 +582,17 @@ func (s *BoltState) getVolumeFromDB(name []byte, volume *Volume, volBkt *bolt.Bu
 	// Retrieve volume driver
 	if volume.UsesVolumeDriver() {
 		plugin, err := s.runtime.getVolumePlugin(volume.config)
-		if err != nil {
+		switch {
+		case errors.Is(err, define.ErrPluginDisabled):
+			logrus.Debugf("Volume %s uses disabled volume plugin %s", volume.Name(), volume.config.Driver)
+		case err != nil:
 			// We want to fail gracefully here, to ensure that we
 			// can still remove volumes even if their plugin is
 			// missing. Otherwise, we end up with volumes that
 			// cannot even be retrieved from the database and will
 			// cause things like `volume ls` to fail.
 			logrus.Errorf("Volume %s uses volume plugin %s, but it cannot be accessed - some functionality may not be available: %v", volume.Name(), volume.config.Driver, err)
-		} else {
+		default:
 			volume.plugin = plugin
 		}
 	}
@@ -210,6 +210,18 @@ type ContainerState struct {
 	// HCUnitName records the name of the healthcheck unit.
 	// Automatically generated when the healthcheck is started.
 	HCUnitName string `json:"hcUnitName,omitempty"`
+	// Ready indicates that the readiness check of the container has
+	// succeeded.
+	Ready bool `json:"ready,omitempty"`
+	// ReadinessSuccessCount indicates the number of consecutive successes
+	// of the readiness check.
+	ReadinessSuccessCount int `json:"readinessSuccessCount,omitempty"`
+	// ReadinessFailureCount indicates the number of consecutive failures
+	// of the readiness check.
+	ReadinessFailureCount int `json:"readinessFailureCount,omitempty"`
+	// ReadinessUnitName records the name of the readiness check unit.
+	// Automatically generated when the readiness check is started.
+	ReadinessUnitName string `json:"readinessUnitName,omitempty"`
 
 	// ExtensionStageHooks holds hooks which will be executed by libpod
 	// and not delegated to the OCI runtime.
@@ -1063,6 +1075,34 @@ func (c *Container) StartupHCPassed() (bool, error) {
 	return c.state.StartupHCPassed, nil
 }
 
+// Ready returns whether the container is ready.  A container is ready when it
+// is running, its startup healthcheck passed and its readiness check, if it
+// has one, succeeded.
+func (c *Container) Ready() (bool, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+
+	return c.isReady(), nil
+}
+
+// isReady returns whether the container is ready.
+// NOTE: The caller must lock and sync the container.
+func (c *Container) isReady() bool {
+	if c.state.State != define.ContainerStateRunning {
+		return false
+	}
+	if c.config.StartupHealthCheckConfig != nil && !c.state.StartupHCPassed {
+		return false
+	}
+	return c.config.ReadinessCheckConfig == nil || c.state.Ready
+}
+
 // Misc Accessors
 // Most will require locking
 
@@ -1313,6 +1353,18 @@ func (c *Container) HasHealthCheck() bool {
 	return c.config.HealthCheckConfig != nil
 }
 
+// HasReadinessCheck returns bool as to whether there is a readiness check
+// defined for the container
+func (c *Container) HasReadinessCheck() bool {
+	return c.config.ReadinessCheckConfig != nil
+}
+
+// ReadinessCheckConfig returns the command and timing attributes of the
+// readiness check
+func (c *Container) ReadinessCheckConfig() *define.ReadinessCheck {
+	return c.config.ReadinessCheckConfig
+}
+
 // HealthCheckConfig returns the command and timing attributes of the health check
 func (c *Container) HealthCheckConfig() *manifest.Schema2HealthConfig {
 	return c.config.HealthCheckConfig
@@ -724,9 +724,12 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 	waitForExit := false
 	wantedStates := make(map[define.ContainerStatus]bool, len(conditions))
 	wantedHealthStates := make(map[string]bool)
+	waitForReady := false
 
 	for _, rawCondition := range conditions {
 		switch rawCondition {
+		case define.ReadinessCheckReady:
+			waitForReady = true
 		case define.HealthCheckHealthy, define.HealthCheckUnhealthy:
 			if !c.HasHealthCheck() {
 				return -1, fmt.Errorf("cannot use condition %q: container %s has no healthcheck", rawCondition, c.ID())
@@ -760,7 +763,7 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 		}()
 	}
 
-	if len(wantedStates) > 0 || len(wantedHealthStates) > 0 {
+	if len(wantedStates) > 0 || len(wantedHealthStates) > 0 || waitForReady {
 		go func() {
 			stoppedCount := 0
 			for {
@@ -789,10 +792,10 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 						return
 					}
 				}
-				if len(wantedHealthStates) > 0 {
+				if len(wantedHealthStates) > 0 || waitForReady {
 					// even if we are interested only in the health check
-					// check that the container is still running to avoid
-					// waiting until the timeout expires.
+					// or readiness check that the container is still
+					// running to avoid waiting until the timeout expires.
 					if stoppedCount > 0 {
 						stoppedCount++
 					} else {
@@ -805,14 +808,27 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 							stoppedCount++
 						}
 					}
-					status, err := c.HealthCheckStatus()
-					if err != nil {
-						trySend(-1, err)
-						return
+					if len(wantedHealthStates) > 0 {
+						status, err := c.HealthCheckStatus()
+						if err != nil {
+							trySend(-1, err)
+							return
+						}
+						if _, found := wantedHealthStates[status]; found {
+							trySend(-1, nil)
+							return
+						}
 					}
-					if _, found := wantedHealthStates[status]; found {
-						trySend(-1, nil)
-						return
+					if waitForReady {
+						ready, err := c.Ready()
+						if err != nil {
+							trySend(-1, err)
+							return
+						}
+						if ready {
+							trySend(-1, nil)
+							return
+						}
 					}
 					// wait for another waitTimeout interval to give the health check process some time
 					// to record the healthy status.
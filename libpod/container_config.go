@@ -382,6 +382,8 @@ type ContainerMiscConfig struct {
 	LogSize int64 `json:"logSize"`
 	// LogDriver driver for logs
 	LogDriver string `json:"logDriver"`
+	// LogOptions are options of the log driver, e.g. the syslog address.
+	LogOptions map[string]string `json:"logOptions,omitempty"`
 	// File containing the conmon PID
 	ConmonPidFile string `json:"conmonPidFile,omitempty"`
 	// RestartPolicy indicates what action the container will take upon
@@ -435,6 +437,10 @@ type ContainerMiscConfig struct {
 	// healthcheck for the container. This will run before the regular HC
 	// runs, and when it passes the regular HC will be activated.
 	StartupHealthCheckConfig *define.StartupHealthCheck `json:"startupHealthCheck,omitempty"`
+	// ReadinessCheckConfig is the configuration of the readiness check of
+	// the container. It runs next to the healthchecks and decides whether
+	// the container is ready.
+	ReadinessCheckConfig *define.ReadinessCheck `json:"readinessCheck,omitempty"`
 	// PreserveFDs is a number of additional file descriptors (in addition
 	// to 0, 1, 2) that will be passed to the executed process. The total FDs
 	// passed will be 3 + PreserveFDs.
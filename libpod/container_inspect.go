@@ -206,6 +206,10 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 	} else {
 		data.State.Health = nil
 	}
+	if c.config.ReadinessCheckConfig != nil {
+		ready := c.isReady()
+		data.State.Ready = &ready
+	}
 
 	networkConfig, err := c.getContainerNetworkInfo()
 	if err != nil {
@@ -434,6 +438,8 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 
 	ctrConfig.Healthcheck = c.config.HealthCheckConfig
 
+	ctrConfig.ReadinessCheck = c.config.ReadinessCheckConfig
+
 	ctrConfig.HealthcheckOnFailureAction = c.config.HealthCheckOnFailureAction.String()
 
 	ctrConfig.HealthLogDestination = c.HealthCheckLogDestination()
@@ -500,6 +506,7 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	logConfig.Path = c.config.LogPath
 	logConfig.Size = units.HumanSize(float64(c.config.LogSize))
 	logConfig.Tag = c.config.LogTag
+	logConfig.Config = c.config.LogOptions
 
 	hostConfig.LogConfig = logConfig
 
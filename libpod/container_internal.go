@@ -220,6 +220,9 @@ func (c *Container) handleExitFile(exitFile string, fi os.FileInfo) error {
 	c.state.Exited = true
 
 	// Write an event for the container's death
+	if c.state.OOMKilled {
+		c.newContainerEvent(events.OOM)
+	}
 	c.newContainerExitedEvent(c.state.ExitCode)
 
 	return c.runtime.state.AddContainerExitCode(c.ID(), c.state.ExitCode)
@@ -286,6 +289,9 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 			return false, err
 		}
 	}
+	if err := c.removeReadinessTimer(ctx); err != nil {
+		return false, err
+	}
 
 	// Is the container running again?
 	// If so, we don't have to do anything
@@ -658,6 +664,10 @@ func resetContainerState(state *ContainerState) {
 	state.StartupHCSuccessCount = 0
 	state.StartupHCFailureCount = 0
 	state.HCUnitName = ""
+	state.Ready = false
+	state.ReadinessSuccessCount = 0
+	state.ReadinessFailureCount = 0
+	state.ReadinessUnitName = ""
 	state.NetNS = ""
 	state.NetworkStatus = nil
 }
@@ -1111,6 +1121,9 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	c.state.StartupHCFailureCount = 0
 	c.state.StartupHCSuccessCount = 0
 	c.state.StartupHCPassed = false
+	c.state.Ready = false
+	c.state.ReadinessSuccessCount = 0
+	c.state.ReadinessFailureCount = 0
 
 	if !retainRetries {
 		c.state.RestartCount = 0
@@ -1137,6 +1150,9 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 			return fmt.Errorf("create healthcheck: %w", err)
 		}
 	}
+	if err := c.createReadinessTimer(); err != nil {
+		return fmt.Errorf("create readiness check: %w", err)
+	}
 
 	defer c.newContainerEvent(events.Init)
 	return c.completeNetworkSetup()
@@ -1298,6 +1314,8 @@ func (c *Container) start() error {
 		logrus.Debugf("Starting container %s with command %v", c.ID(), c.config.Spec.Process.Args)
 	}
 
+	// The log is sent to syslog from the time the container starts on.
+	started := time.Now()
 	if err := c.ociRuntime.StartContainer(c); err != nil {
 		return err
 	}
@@ -1305,6 +1323,19 @@ func (c *Container) start() error {
 
 	c.state.State = define.ContainerStateRunning
 
+	if c.config.LogDriver == define.SyslogLogging {
+		// The log remains available locally, so do not fail the
+		// start of the container.
+		if err := c.startSyslogForwarder(started); err != nil {
+			logrus.Errorf("Forwarding log of container %s to syslog: %v", c.ID(), err)
+		}
+	}
+	if rotation, err := c.logRotation(); err == nil && rotation.Enabled() {
+		if err := c.startLogRotator(); err != nil {
+			logrus.Errorf("Rotating log file of container %s: %v", c.ID(), err)
+		}
+	}
+
 	// Unless being ignored, set the MAINPID to conmon.
 	if c.config.SdNotifyMode != define.SdNotifyModeIgnore {
 		payload := fmt.Sprintf("MAINPID=%d", c.state.ConmonPID)
@@ -1331,6 +1362,9 @@ func (c *Container) start() error {
 			return fmt.Errorf("start healthcheck: %w", err)
 		}
 	}
+	if err := c.startReadinessTimer(); err != nil {
+		return fmt.Errorf("start readiness check: %w", err)
+	}
 
 	c.newContainerEvent(events.Start)
 
@@ -1585,6 +1619,9 @@ func (c *Container) pause() error {
 			return fmt.Errorf("failed to remove HealthCheck timer: %v", err)
 		}
 	}
+	if err := c.removeReadinessTimer(context.Background()); err != nil {
+		return fmt.Errorf("failed to remove readiness check timer: %v", err)
+	}
 
 	if err := c.ociRuntime.PauseContainer(c); err != nil {
 		// TODO when using docker-py there is some sort of race/incompatibility here
@@ -1631,6 +1668,12 @@ func (c *Container) unpause() error {
 			return err
 		}
 	}
+	if err := c.createReadinessTimer(); err != nil {
+		return fmt.Errorf("create readiness check: %w", err)
+	}
+	if err := c.startReadinessTimer(); err != nil {
+		return err
+	}
 
 	logrus.Debugf("Unpaused container %s", c.ID())
 
@@ -1660,6 +1703,9 @@ func (c *Container) restartWithTimeout(ctx context.Context, timeout uint) (retEr
 				logrus.Error(err.Error())
 			}
 		}
+		if err := c.removeReadinessTimer(context.Background()); err != nil {
+			logrus.Error(err.Error())
+		}
 		// Ensure we tear down the container network so it will be
 		// recreated - otherwise, behavior of restart differs from stop
 		// and start
@@ -2196,6 +2242,9 @@ func (c *Container) cleanup(ctx context.Context) error {
 			logrus.Errorf("Removing timer for container %s healthcheck: %v", c.ID(), err)
 		}
 	}
+	if err := c.removeReadinessTimer(ctx); err != nil {
+		logrus.Errorf("Removing timer for container %s readiness check: %v", c.ID(), err)
+	}
 
 	// Clean up network namespace, if present
 	if err := c.cleanupNetwork(); err != nil {
@@ -3,10 +3,12 @@
 package libpod
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"text/template"
 	"time"
 
 	"github.com/containers/podman/v5/libpod/define"
@@ -51,7 +53,7 @@ func (c *Container) ReadLog(ctx context.Context, options *logs.LogOptions, logCh
 		// TODO provide a separate implementation of this when Conmon
 		// has support.
 		fallthrough
-	case define.KubernetesLogging, "":
+	case define.KubernetesLogging, define.SyslogLogging, "":
 		return c.readFromLogFile(ctx, options, logChannel, colorID)
 	default:
 		return fmt.Errorf("unrecognized log driver %q, cannot read logs: %w", c.LogDriver(), define.ErrInternal)
@@ -78,7 +80,11 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 	}()
 
 	go func() {
-		for _, nll := range tailLog {
+		for nll, err := range tailLog {
+			if err != nil {
+				logrus.Errorf("Reading rotated log files of container %s: %v", c.ID(), err)
+				break
+			}
 			nll.CID = c.ID()
 			nll.CName = c.Name()
 			nll.ColorID = colorID
@@ -153,3 +159,43 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 	}
 	return nil
 }
+
+// logRotation returns how the log file of the container is rotated.  The
+// log file is rotated at the log size of the container, or at the one
+// configured in containers.conf.
+func (c *Container) logRotation() (*logs.RotationOptions, error) {
+	switch c.LogDriver() {
+	case define.JournaldLogging, define.NoLogging, define.PassthroughLogging, define.PassthroughTTYLogging:
+		// There is no log file.
+		return &logs.RotationOptions{MaxFile: 1}, nil
+	}
+	maxSize := c.config.LogSize
+	if maxSize <= 0 {
+		maxSize = c.runtime.config.Containers.LogSizeMax
+	}
+	return logs.ParseRotationOptions(c.config.LogOptions, maxSize)
+}
+
+// getLogTag returns the log tag of the container with the template expanded.
+// The container must be locked.
+func (c *Container) getLogTag() (string, error) {
+	logTag := c.LogTag()
+	if logTag == "" {
+		return "", nil
+	}
+	data, err := c.inspectLocked(false)
+	if err != nil {
+		// FIXME: this error should probably be returned
+		return "", nil //nolint: nilerr
+	}
+	tmpl, err := template.New("container").Parse(logTag)
+	if err != nil {
+		return "", fmt.Errorf("template parsing error %s: %w", logTag, err)
+	}
+	var b bytes.Buffer
+	err = tmpl.Execute(&b, data)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
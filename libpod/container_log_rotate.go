@@ -0,0 +1,108 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/logs"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// logRotatorInterval is the interval the log rotator checks the size of the
+// log file at.
+const logRotatorInterval = time.Second
+
+// startLogRotator spawns the process rotating the log file of the
+// container.  It exits once the conmon of the container exits.
+func (c *Container) startLogRotator() error {
+	return c.runtime.startDetachedPodman("log rotator for container "+c.ID(), "container", "logrotate", c.ID())
+}
+
+// logRotatorConmonPID returns the PID of the conmon of the running
+// container, or 0 if the container is not running.
+func (c *Container) logRotatorConmonPID() (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return 0, err
+	}
+	if c.state.State != define.ContainerStateRunning {
+		return 0, nil
+	}
+	return c.state.ConmonPID, nil
+}
+
+// reopenLogFile makes conmon reopen the log file of the container, with the
+// REOPEN_LOGS_EVENT message on its control file.
+func (c *Container) reopenLogFile() error {
+	controlFile, err := openControlFile(c, c.bundlePath())
+	if err != nil {
+		return err
+	}
+	defer controlFile.Close()
+
+	if _, err := fmt.Fprintf(controlFile, "%d %d %d\n", 2, 0, 0); err != nil {
+		return fmt.Errorf("failed to write to ctl file to reopen log file: %w", err)
+	}
+	return nil
+}
+
+// LogRotator rotates the log file of the specified container once it
+// exceeds the maximum log size, until the conmon of the container exits.
+// The size of the log file is checked every second without locking the
+// container; should the rotation fall behind, conmon truncates the log file
+// at its own limit.
+func (r *Runtime) LogRotator(ctx context.Context, nameOrID string) error {
+	ctr, err := r.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	opts, err := ctr.logRotation()
+	if err != nil {
+		return err
+	}
+	if !opts.Enabled() {
+		return nil
+	}
+
+	conmonPID, err := ctr.logRotatorConmonPID()
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+			return nil
+		}
+		return err
+	}
+	if conmonPID == 0 {
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(logRotatorInterval):
+		}
+
+		// A new rotator is started along with a new conmon when the
+		// container is restarted.
+		if err := unix.Kill(conmonPID, 0); errors.Is(err, unix.ESRCH) {
+			logrus.Debugf("Log rotator of container %s exiting", ctr.ID())
+			return nil
+		}
+
+		info, err := os.Stat(ctr.LogPath())
+		if err != nil || info.Size() < opts.MaxSize {
+			continue
+		}
+		logrus.Debugf("Rotating log file %s of container %s", ctr.LogPath(), ctr.ID())
+		if err := logs.RotateLogFile(ctr.LogPath(), opts, ctr.reopenLogFile); err != nil {
+			logrus.Warnf("Rotating log file of container %s: %v", ctr.ID(), err)
+		}
+	}
+}
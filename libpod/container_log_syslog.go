@@ -0,0 +1,119 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/logs"
+	"github.com/sirupsen/logrus"
+)
+
+// startSyslogForwarder spawns the process sending the log of the container
+// to syslog.  The forwarder is a `podman container syslog` process in its
+// own session, so it outlives the process starting the container.  It sends
+// the log lines written since the specified time and exits once the
+// container stops.
+func (c *Container) startSyslogForwarder(since time.Time) error {
+	return c.runtime.startDetachedPodman("syslog forwarder for container "+c.ID(), "container", "syslog", "--since", since.Format(time.RFC3339Nano), c.ID())
+}
+
+// syslogTag returns the tag of the syslog messages of the container.  As
+// with Docker, the short container ID is used unless a tag is configured.
+func (c *Container) syslogTag() (string, error) {
+	if c.LogTag() == "" {
+		return c.ID()[:12], nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return "", err
+	}
+	return c.getLogTag()
+}
+
+// SyslogForwarder sends the log lines of the specified container written
+// since the specified time to syslog until the container stops.  Partial
+// lines are joined before being sent.  Messages which cannot be delivered
+// are dropped, but they remain available in the local log of the container.
+func (r *Runtime) SyslogForwarder(ctx context.Context, nameOrID string, since time.Time) error {
+	ctr, err := r.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	opts, err := logs.ParseSyslogOptions(ctr.config.LogOptions)
+	if err != nil {
+		return err
+	}
+	tag, err := ctr.syslogTag()
+	if err != nil {
+		return fmt.Errorf("generating syslog tag of container %s: %w", ctr.ID(), err)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	var conn net.Conn
+	send := func(line *logs.LogLine) error {
+		if conn == nil {
+			newConn, err := opts.Dial()
+			if err != nil {
+				return err
+			}
+			conn = newConn
+		}
+		if _, err := conn.Write(opts.Message(line, hostname, tag)); err != nil {
+			conn.Close()
+			conn = nil
+			return err
+		}
+		return nil
+	}
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+
+	logChannel := make(chan *logs.LogLine)
+	logOpts := &logs.LogOptions{
+		Follow:    true,
+		Since:     since,
+		Tail:      -1,
+		WaitGroup: new(sync.WaitGroup),
+	}
+	if err := ctr.ReadLog(ctx, logOpts, logChannel, 0); err != nil {
+		return err
+	}
+	go func() {
+		logOpts.WaitGroup.Wait()
+		close(logChannel)
+	}()
+
+	var partial strings.Builder
+	for line := range logChannel {
+		partial.WriteString(line.Msg)
+		if line.Partial() {
+			continue
+		}
+		line.Msg = partial.String()
+		partial.Reset()
+		// Retry once with a new connection, e.g. after the syslog
+		// server restarted.
+		if err := send(line); err != nil {
+			if err := send(line); err != nil {
+				logrus.Warnf("Sending log of container %s to syslog: %v", ctr.ID(), err)
+			}
+		}
+	}
+	logrus.Debugf("Syslog forwarder of container %s exiting", ctr.ID())
+	return nil
+}
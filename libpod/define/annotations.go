@@ -169,6 +169,17 @@ const (
 	// KubeImageAutomountAnnotation
 	KubeImageAutomountAnnotation = "io.podman.annotations.kube.image.volumes.mount"
 
+	// KubeReplicaHostPortsAnnotation is used by kube play to select how the
+	// host ports of the replicas of a Deployment are published: by the
+	// first replica only ("first") or incremented by the replica number
+	// ("increment").
+	KubeReplicaHostPortsAnnotation = "io.podman.annotations.kube.replicas.host-ports"
+
+	// KubePlayOptionsAnnotation is used internally by kube play to record
+	// the options a pod was played with on its infra container, so that
+	// kube scale creates new replicas with the same options.
+	KubePlayOptionsAnnotation = "io.podman.annotations.kube.play-options"
+
 	// PIDsLimitAnnotation is used to limit the number of PIDs
 	PIDsLimitAnnotation = "io.podman.annotations.pids-limit"
 
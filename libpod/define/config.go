@@ -85,6 +85,14 @@ const PassthroughLogging = "passthrough"
 // PassthroughTTYLogging is the string conmon expects when specifying to use the passthrough driver even on a tty.
 const PassthroughTTYLogging = "passthrough-tty"
 
+// SyslogLogging is the log driver sending container logs to syslog.  Conmon
+// keeps a size-limited k8s-file log for it, which is read by `podman logs`.
+const SyslogLogging = "syslog"
+
+// DefaultSyslogLocalLogSize is the maximum size of the local log of
+// containers using the syslog log driver unless a log size is configured.
+const DefaultSyslogLocalLogSize = 1024 * 1024
+
 // DefaultRlimitValue is the value set by default for nofile and nproc
 const RLimitDefaultValue = uint64(1048576)
 
@@ -65,6 +65,10 @@ const (
 	K8sKindDaemonSet = "daemonset"
 	// a Job kube yaml spec
 	K8sKindJob = "job"
+	// A StatefulSet kube yaml spec
+	K8sKindStatefulSet = "statefulset"
+	// A CronJob kube yaml spec
+	K8sKindCronJob = "cronjob"
 )
 
 type WeightDevice struct {
@@ -61,6 +61,8 @@ type InspectContainerConfig struct {
 	StartupHealthCheck *StartupHealthCheck `json:"StartupHealthCheck,omitempty"`
 	// Configured healthcheck for the container
 	Healthcheck *manifest.Schema2HealthConfig `json:"Healthcheck,omitempty"`
+	// Configured readiness check for the container
+	ReadinessCheck *ReadinessCheck `json:"ReadinessCheck,omitempty"`
 	// HealthcheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthcheckOnFailureAction string `json:"HealthcheckOnFailureAction,omitempty"`
 	// HealthLogDestination defines the destination where the log is stored
@@ -310,6 +312,7 @@ type InspectContainerState struct {
 	StartedAt      time.Time           `json:"StartedAt"`
 	FinishedAt     time.Time           `json:"FinishedAt"`
 	Health         *HealthCheckResults `json:"Health,omitempty"`
+	Ready          *bool               `json:"Ready,omitempty"` // only set with a readiness check
 	Checkpointed   bool                `json:"Checkpointed,omitempty"`
 	CgroupPath     string              `json:"CgroupPath,omitempty"`
 	CheckpointedAt time.Time           `json:"CheckpointedAt,omitempty"`
@@ -41,6 +41,14 @@ var (
 	// plugin that is not present on the system or in the configuration.
 	ErrMissingPlugin = errors.New("required plugin missing")
 
+	// ErrPluginDisabled indicates that the requested operation requires a
+	// plugin that was disabled.
+	ErrPluginDisabled = errors.New("plugin is disabled")
+
+	// ErrPluginInUse indicates that a plugin cannot be disabled because
+	// volumes are using it.
+	ErrPluginInUse = errors.New("plugin is being used")
+
 	// ErrCtrExists indicates a container with the same name or ID already
 	// exists
 	ErrCtrExists = errors.New("container already exists")
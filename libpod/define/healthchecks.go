@@ -2,6 +2,8 @@ package define
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -23,6 +25,12 @@ const (
 	// HealthCheckStopped describes the time when container was stopped during HealthCheck
 	// and HealthCheck was terminated
 	HealthCheckStopped string = "stopped"
+	// ReadinessCheckReady describes a container that is ready to serve
+	// requests.  It is also the condition to wait for with `podman wait`.
+	ReadinessCheckReady string = "ready"
+	// ReadinessCheckNotReady describes a container whose readiness check
+	// has not passed (yet)
+	ReadinessCheckNotReady string = "not ready"
 )
 
 // HealthCheckStatus represents the current state of a container
@@ -86,6 +94,17 @@ const (
 
 const HealthCheckEventsLoggerDestination string = "events_logger"
 
+// Healthcheck schedulers selectable via the healthcheck_scheduler option in
+// containers.conf.
+const (
+	// HealthCheckSchedulerSystemd runs healthchecks via transient systemd
+	// timers.
+	HealthCheckSchedulerSystemd = "systemd"
+	// HealthCheckSchedulerPodman runs healthchecks in a Podman process
+	// next to the container.
+	HealthCheckSchedulerPodman = "podman"
+)
+
 // HealthConfig.Test options
 const (
 	// HealthConfigTestNone disables healthcheck
@@ -94,8 +113,71 @@ const (
 	HealthConfigTestCmd = "CMD"
 	// HealthConfigTestCmdShell runs commands with the system's default shell
 	HealthConfigTestCmdShell = "CMD-SHELL"
+	// HealthConfigTestHTTP performs an HTTP GET request against the URL in
+	// the second element.  Additional elements are request headers in the
+	// form "Name: value".
+	HealthConfigTestHTTP = "HTTP"
+	// HealthConfigTestTCP opens a TCP connection to the "host:port" in the
+	// second element.
+	HealthConfigTestTCP = "TCP"
+	// HealthConfigTestGRPC calls the gRPC health checking protocol at the
+	// "host:port" in the second element.  An optional third element is the
+	// name of the service to check.
+	HealthConfigTestGRPC = "GRPC"
 )
 
+// IsHealthCheckProbe returns true if the healthcheck test is a probe Podman
+// performs from the network namespace of the container rather than a command
+// executed inside the container.
+func IsHealthCheckProbe(test []string) bool {
+	if len(test) == 0 {
+		return false
+	}
+	switch test[0] {
+	case HealthConfigTestHTTP, HealthConfigTestTCP, HealthConfigTestGRPC:
+		return true
+	}
+	return false
+}
+
+// ValidateHealthCheckProbe validates the arguments of a healthcheck probe.
+func ValidateHealthCheckProbe(test []string) error {
+	if len(test) < 2 || test[1] == "" {
+		return fmt.Errorf("%s healthcheck requires an address", test[0])
+	}
+	switch test[0] {
+	case HealthConfigTestHTTP:
+		u, err := url.Parse(test[1])
+		if err != nil {
+			return fmt.Errorf("invalid HTTP healthcheck URL: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid HTTP healthcheck URL %q: scheme must be http or https", test[1])
+		}
+		if u.Host == "" {
+			return fmt.Errorf("invalid HTTP healthcheck URL %q: missing host", test[1])
+		}
+		for _, header := range test[2:] {
+			if name, _, ok := strings.Cut(header, ":"); !ok || strings.TrimSpace(name) == "" {
+				return fmt.Errorf("invalid HTTP healthcheck header %q: must be in the form \"Name: value\"", header)
+			}
+		}
+	case HealthConfigTestTCP, HealthConfigTestGRPC:
+		if _, _, err := net.SplitHostPort(test[1]); err != nil {
+			return fmt.Errorf("invalid %s healthcheck address: %w", test[0], err)
+		}
+		if test[0] == HealthConfigTestTCP && len(test) > 2 {
+			return fmt.Errorf("too many arguments for %s healthcheck", test[0])
+		}
+		if test[0] == HealthConfigTestGRPC && len(test) > 3 {
+			return fmt.Errorf("too many arguments for %s healthcheck", test[0])
+		}
+	default:
+		return fmt.Errorf("unsupported healthcheck probe %q", test[0])
+	}
+	return nil
+}
+
 // HealthCheckOnFailureAction defines how Podman reacts when a container's health
 // status turns unhealthy.
 type HealthCheckOnFailureAction int
@@ -174,6 +256,19 @@ type StartupHealthCheck struct {
 	Successes int `json:",omitempty"`
 }
 
+// ReadinessCheck is the configuration of a readiness check.  It runs
+// periodically like a healthcheck but only decides whether the container is
+// ready, the container is never restarted because of it.  Retries is the
+// number of consecutive failures after which a ready container is no longer
+// ready.
+type ReadinessCheck struct {
+	manifest.Schema2HealthConfig
+	// Successes are the number of consecutive successes required to mark
+	// the container as ready.
+	// If set to 0, a single success will mark the container as ready.
+	Successes int `json:",omitempty"`
+}
+
 type UpdateHealthCheckConfig struct {
 	// HealthLogDestination set the destination of the HealthCheck log.
 	// Directory path, local or events_logger (local use container state file)
@@ -0,0 +1,24 @@
+package define
+
+// KubeOwnerKindLabel denotes the pod label key kube play records the kind of
+// the object a pod is created for in, for example "Deployment".
+const KubeOwnerKindLabel = "io.podman.kube.owner.kind"
+
+// KubeOwnerNameLabel denotes the pod label key kube play records the name of
+// the object a pod is created for in.
+const KubeOwnerNameLabel = "io.podman.kube.owner.name"
+
+// KubePodVolumeLabel denotes the volume label key kube play records the name
+// of the pod a downwardAPI or projected volume is created for in.
+const KubePodVolumeLabel = "io.podman.kube.pod"
+
+// PodCronJob is the schedule of a pod running a Kubernetes CronJob.  The pod
+// is started on schedule by a systemd timer.
+type PodCronJob struct {
+	// Calendar is the systemd calendar event the pod is started on, see
+	// systemd.time(7).
+	Calendar string `json:"calendar"`
+	// Restart restarts the pod if it is still running when it is due
+	// again.
+	Restart bool `json:"restart,omitempty"`
+}
@@ -70,3 +70,20 @@ type VolumeReload struct {
 	Removed []string
 	Errors  []error
 }
+
+// VolumePluginInfo describes a volume plugin configured in containers.conf.
+type VolumePluginInfo struct {
+	// Name is the name of the plugin, used as volume driver.
+	Name string `json:"Name"`
+	// SocketPath is the path of the unix socket the plugin listens on.
+	SocketPath string `json:"SocketPath"`
+	// Enabled is false if the plugin was disabled.  Volumes of disabled
+	// plugins cannot be created or used.
+	Enabled bool `json:"Enabled"`
+	// Running is true if the plugin responds on its socket.
+	Running bool `json:"Running"`
+	// Error is the reason the plugin is not running.
+	Error string `json:"Error,omitempty"`
+	// Volumes is the number of volumes using the plugin.
+	Volumes int `json:"Volumes"`
+}
@@ -22,6 +22,10 @@ func (r *Runtime) newEventer() (events.Eventer, error) {
 		EventerType:    r.config.Engine.EventsLogger,
 		LogFilePath:    r.config.Engine.EventsLogFilePath,
 		LogFileMaxSize: r.config.Engine.EventsLogMaxSize(),
+		SinkExec:       r.podmanConfig.Engine.EventsSinkExec,
+		SinkFilters:    r.podmanConfig.Engine.EventsSinkFilters,
+		SinkQueueDir:   filepath.Join(r.config.Engine.TmpDir, "events"),
+		SinkWebhook:    r.podmanConfig.Engine.EventsSinkWebhook,
 	}
 	return events.NewEventer(options)
 }
@@ -0,0 +1,129 @@
+package events
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// aggregateFields are the fields events can be grouped by.
+var aggregateFields = map[string]func(e *Event, r *AggregateResult){
+	"id":     func(e *Event, r *AggregateResult) { r.ID = e.ID },
+	"image":  func(e *Event, r *AggregateResult) { r.Image = e.Image },
+	"name":   func(e *Event, r *AggregateResult) { r.Name = e.Name },
+	"pod":    func(e *Event, r *AggregateResult) { r.PodID = e.PodID },
+	"status": func(e *Event, r *AggregateResult) { r.Status = e.Status },
+	"type":   func(e *Event, r *AggregateResult) { r.Type = e.Type },
+}
+
+// AggregateFields returns the fields events can be grouped by.
+func AggregateFields() []string {
+	fields := make([]string, 0, len(aggregateFields))
+	for field := range aggregateFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// AggregateResult is the number of events of a group.  Only the fields the
+// events are grouped by are set.
+type AggregateResult struct {
+	// Type of the events
+	Type Type `json:",omitempty"`
+	// Status of the events
+	Status Status `json:",omitempty"`
+	// Name of the object of the events
+	Name string `json:",omitempty"`
+	// ID of the object of the events
+	ID string `json:",omitempty"`
+	// Image of the object of the events
+	Image string `json:",omitempty"`
+	// PodID of the container of the events
+	PodID string `json:",omitempty"`
+	// Count is the number of events
+	Count int
+}
+
+// Aggregator counts events grouped by a set of fields.
+type Aggregator struct {
+	fields  []string
+	results map[AggregateResult]int
+}
+
+// NewAggregator returns an aggregator grouping events by the specified
+// fields.
+func NewAggregator(fields []string) (*Aggregator, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields to aggregate events by: supported fields are %s", strings.Join(AggregateFields(), ", "))
+	}
+	var groupBy []string
+	for _, field := range fields {
+		field = strings.ToLower(field)
+		if _, ok := aggregateFields[field]; !ok {
+			return nil, fmt.Errorf("cannot aggregate events by %q: supported fields are %s", field, strings.Join(AggregateFields(), ", "))
+		}
+		if !slices.Contains(groupBy, field) {
+			groupBy = append(groupBy, field)
+		}
+	}
+	return &Aggregator{
+		fields:  groupBy,
+		results: make(map[AggregateResult]int),
+	}, nil
+}
+
+// Fields returns the fields the events are grouped by.
+func (a *Aggregator) Fields() []string {
+	return a.fields
+}
+
+// Add counts the event.
+func (a *Aggregator) Add(e *Event) {
+	var key AggregateResult
+	for _, field := range a.fields {
+		aggregateFields[field](e, &key)
+	}
+	a.results[key]++
+}
+
+// Results returns the groups of events, the largest ones first.
+func (a *Aggregator) Results() []AggregateResult {
+	results := make([]AggregateResult, 0, len(a.results))
+	for key, count := range a.results {
+		key.Count = count
+		results = append(results, key)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Count != results[j].Count {
+			return results[i].Count > results[j].Count
+		}
+		for _, field := range a.fields {
+			if vi, vj := results[i].value(field), results[j].value(field); vi != vj {
+				return vi < vj
+			}
+		}
+		return false
+	})
+	return results
+}
+
+// value returns the value of the specified field.
+func (r AggregateResult) value(field string) string {
+	switch field {
+	case "id":
+		return r.ID
+	case "image":
+		return r.Image
+	case "name":
+		return r.Name
+	case "pod":
+		return r.PodID
+	case "status":
+		return string(r.Status)
+	case "type":
+		return string(r.Type)
+	}
+	return ""
+}
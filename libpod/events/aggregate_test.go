@@ -0,0 +1,42 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregator(t *testing.T) {
+	evts := []*Event{
+		{Type: Container, Status: Restart, Name: "web"},
+		{Type: Container, Status: Restart, Name: "db"},
+		{Type: Container, Status: Restart, Name: "web"},
+		{Type: Container, Status: Exited, Name: "web"},
+		{Type: Container, Status: Restart, Name: "web"},
+	}
+
+	aggregator, err := NewAggregator([]string{"name", "Status", "name"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "status"}, aggregator.Fields())
+	for _, e := range evts {
+		aggregator.Add(e)
+	}
+	assert.Equal(t, []AggregateResult{
+		{Name: "web", Status: Restart, Count: 3},
+		{Name: "db", Status: Restart, Count: 1},
+		{Name: "web", Status: Exited, Count: 1},
+	}, aggregator.Results())
+
+	aggregator, err = NewAggregator([]string{"type"})
+	require.NoError(t, err)
+	for _, e := range evts {
+		aggregator.Add(e)
+	}
+	assert.Equal(t, []AggregateResult{{Type: Container, Count: 5}}, aggregator.Results())
+
+	_, err = NewAggregator([]string{"exitCode"})
+	assert.ErrorContains(t, err, `cannot aggregate events by "exitcode"`)
+	_, err = NewAggregator(nil)
+	assert.Error(t, err)
+}
@@ -74,6 +74,15 @@ type EventerOptions struct {
 	LogFilePath string
 	// LogFileMaxSize is the default limit used for rotating the log file
 	LogFileMaxSize uint64
+	// SinkExec is an executable every event is piped to
+	SinkExec string
+	// SinkFilters limit the events delivered to the sinks
+	SinkFilters []string
+	// SinkQueueDir is the directory events that could not be delivered to
+	// the webhook are queued in
+	SinkQueueDir string
+	// SinkWebhook is a URL every event is POSTed to
+	SinkWebhook string
 }
 
 // Eventer is the interface for journald or file event logging
@@ -176,6 +185,8 @@ const (
 	NetworkConnect Status = "connect"
 	// NetworkDisconnect
 	NetworkDisconnect Status = "disconnect"
+	// OOM indicates that a container was killed by the OOM killer.
+	OOM Status = "oom"
 	// Pause ...
 	Pause Status = "pause"
 	// Prune ...
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/containers/storage/pkg/stringid"
@@ -28,6 +29,40 @@ func IsValidEventer(eventer string) bool {
 	}
 }
 
+// filterOperators are the operators of event filters.  Longer operators come
+// first, so that they are matched before their prefixes.
+var filterOperators = []string{"!=", "~=", ">=", "<=", "=", ">", "<"}
+
+// ParseFilter splits an event filter into its key, operator and value, e.g.
+// "exitCode>0" into "exitCode", ">" and "0".  An operator may also prefix the
+// value of a key=value filter, so "exitCode=>0" is equivalent to "exitCode>0".
+// This allows for passing any filter as key=value pair.
+func ParseFilter(filter string) (string, string, string, error) {
+	i := strings.IndexAny(filter, "!~=<>")
+	if i <= 0 {
+		return "", "", "", fmt.Errorf("%s is an invalid filter", filter)
+	}
+	key, op, value := filter[:i], "", filter[i:]
+	for _, o := range filterOperators {
+		if strings.HasPrefix(value, o) {
+			op, value = o, value[len(o):]
+			break
+		}
+	}
+	if op == "" {
+		return "", "", "", fmt.Errorf("%s is an invalid filter", filter)
+	}
+	if op == "=" {
+		for _, o := range filterOperators {
+			if o != "=" && strings.HasPrefix(value, o) {
+				op, value = o, value[len(o):]
+				break
+			}
+		}
+	}
+	return key, op, value, nil
+}
+
 // NewEvent creates an event struct and populates with
 // the given status and time.
 func NewEvent(status Status) Event {
@@ -176,6 +211,8 @@ func StringToStatus(name string) (Status, error) {
 		return NetworkConnect, nil
 	case NetworkDisconnect.String():
 		return NetworkDisconnect, nil
+	case OOM.String():
+		return OOM, nil
 	case Pause.String():
 		return Pause, nil
 	case Prune.String():
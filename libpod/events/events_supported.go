@@ -13,16 +13,24 @@ import (
 // NewEventer creates an eventer based on the eventer type
 func NewEventer(options EventerOptions) (Eventer, error) {
 	logrus.Debugf("Initializing event backend %s", options.EventerType)
+	var (
+		eventer Eventer
+		err     error
+	)
 	switch EventerType(strings.ToLower(options.EventerType)) {
 	case Journald:
-		return newJournalDEventer(options)
+		eventer, err = newJournalDEventer(options)
 	case LogFile:
-		return newLogFileEventer(options)
+		eventer, err = newLogFileEventer(options)
 	case Null:
-		return newNullEventer(), nil
+		eventer = newNullEventer()
 	default:
 		return nil, fmt.Errorf("unknown event logger type: %s", strings.ToLower(options.EventerType))
 	}
+	if err != nil {
+		return nil, err
+	}
+	return newEventerWithSinks(eventer, options)
 }
 
 // newEventFromJSONString takes stringified json and converts
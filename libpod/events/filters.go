@@ -4,19 +4,164 @@ package events
 
 import (
 	"fmt"
+	"path"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/containers/podman/v5/pkg/util"
 )
 
-func generateEventFilter(filter, filterValue string) (func(e *Event) bool, error) {
+// generateEventFilter returns the filter for the specified key, operator and
+// value.
+func generateEventFilter(filter, op, filterValue string) (func(e *Event) bool, error) {
+	switch strings.ToUpper(filter) {
+	case "EXITCODE":
+		return generateExitCodeFilter(op, filterValue)
+	}
+
+	switch op {
+	case "=", "~=":
+	case "!=":
+		matchFunc, err := generateEventMatchFilter(filter, op, filterValue)
+		if err != nil {
+			return nil, err
+		}
+		return func(e *Event) bool {
+			return !matchFunc(e)
+		}, nil
+	default:
+		return nil, fmt.Errorf("the %s filter does not support the %q operator", filter, op)
+	}
+	return generateEventMatchFilter(filter, op, filterValue)
+}
+
+// generateNameMatcher returns a function matching names against a regular
+// expression for the "~=" operator or against a glob if the value contains
+// any wildcard.  Nil is returned if the names must match exactly.
+func generateNameMatcher(filter, op, filterValue string) (func(name string) bool, error) {
+	switch strings.ToUpper(filter) {
+	case "CONTAINER", "IMAGE", "NAME", "POD", "VOLUME":
+	default:
+		if op == "~=" {
+			return nil, fmt.Errorf("the %s filter does not support regular expressions", filter)
+		}
+		return nil, nil
+	}
+
+	if op == "~=" {
+		re, err := regexp.Compile(filterValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression in %s filter: %w", filter, err)
+		}
+		return re.MatchString, nil
+	}
+	if !strings.ContainsAny(filterValue, "*?[") {
+		return nil, nil
+	}
+	if _, err := path.Match(filterValue, ""); err != nil {
+		return nil, fmt.Errorf("invalid pattern in %s filter: %w", filter, err)
+	}
+	return func(name string) bool {
+		matches, _ := path.Match(filterValue, name)
+		return matches
+	}, nil
+}
+
+// generateExitCodeFilter returns a filter comparing the exit codes of events
+// to the filter value.  Events without an exit code never match.
+func generateExitCodeFilter(op, filterValue string) (func(e *Event) bool, error) {
+	value, err := strconv.Atoi(filterValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exitCode filter value %q: %w", filterValue, err)
+	}
+	var compare func(exitCode int) bool
+	switch op {
+	case "=":
+		compare = func(exitCode int) bool { return exitCode == value }
+	case "!=":
+		compare = func(exitCode int) bool { return exitCode != value }
+	case ">":
+		compare = func(exitCode int) bool { return exitCode > value }
+	case ">=":
+		compare = func(exitCode int) bool { return exitCode >= value }
+	case "<":
+		compare = func(exitCode int) bool { return exitCode < value }
+	case "<=":
+		compare = func(exitCode int) bool { return exitCode <= value }
+	default:
+		return nil, fmt.Errorf("the exitCode filter does not support the %q operator", op)
+	}
+	return func(e *Event) bool {
+		return e.ContainerExitCode != nil && compare(*e.ContainerExitCode)
+	}, nil
+}
+
+// healthTracker records the last health status of each container, so that
+// health transitions can be matched.
+type healthTracker struct {
+	statuses map[string]string
+	// The last event and the previous health status of its container,
+	// as multiple filters look at the same event.
+	event    *Event
+	previous string
+	known    bool
+}
+
+// previousStatus returns the health status of the container of the event
+// before the event occurred.
+func (t *healthTracker) previousStatus(e *Event) (string, bool) {
+	if e == t.event {
+		return t.previous, t.known
+	}
+	t.event = e
+	t.previous, t.known = t.statuses[e.ID]
+	t.statuses[e.ID] = e.HealthStatus
+	return t.previous, t.known
+}
+
+// generateHealthFilter returns a filter matching health_status events of the
+// specified status or, in the form "from->to", health transitions.  "*"
+// matches any status in a transition.
+func generateHealthFilter(filterValue string, tracker *healthTracker) (func(e *Event) bool, error) {
+	from, to, isTransition := strings.Cut(filterValue, "->")
+	if !isTransition {
+		return func(e *Event) bool {
+			return e.Status == HealthStatus && e.HealthStatus == filterValue
+		}, nil
+	}
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("invalid health transition %q: must be in the form from->to", filterValue)
+	}
+	return func(e *Event) bool {
+		if e.Status != HealthStatus {
+			return false
+		}
+		previous, known := tracker.previousStatus(e)
+		if !known || previous == e.HealthStatus {
+			return false
+		}
+		return (from == "*" || from == previous) && (to == "*" || to == e.HealthStatus)
+	}, nil
+}
+
+// generateEventMatchFilter returns a filter matching the events the filter
+// value applies to.
+func generateEventMatchFilter(filter, op, filterValue string) (func(e *Event) bool, error) {
+	matchName, err := generateNameMatcher(filter, op, filterValue)
+	if err != nil {
+		return nil, err
+	}
 	switch strings.ToUpper(filter) {
 	case "CONTAINER":
 		return func(e *Event) bool {
 			if e.Type != Container {
 				return false
 			}
+			if matchName != nil {
+				return matchName(e.Name)
+			}
 			if e.Name == filterValue {
 				return true
 			}
@@ -34,6 +179,9 @@ func generateEventFilter(filter, filterValue string) (func(e *Event) bool, error
 			if e.Type != Image {
 				return false
 			}
+			if matchName != nil {
+				return matchName(e.Name)
+			}
 			if e.Name == filterValue {
 				return true
 			}
@@ -44,6 +192,9 @@ func generateEventFilter(filter, filterValue string) (func(e *Event) bool, error
 			if e.Type != Pod {
 				return false
 			}
+			if matchName != nil {
+				return matchName(e.Name)
+			}
 			if e.Name == filterValue {
 				return true
 			}
@@ -54,9 +205,19 @@ func generateEventFilter(filter, filterValue string) (func(e *Event) bool, error
 			if e.Type != Volume {
 				return false
 			}
+			if matchName != nil {
+				return matchName(e.Name)
+			}
 			// Prefix match with name for consistency with docker
 			return strings.HasPrefix(e.Name, filterValue)
 		}, nil
+	case "NAME":
+		return func(e *Event) bool {
+			if matchName != nil {
+				return matchName(e.Name)
+			}
+			return e.Name == filterValue
+		}, nil
 	case "TYPE":
 		return func(e *Event) bool {
 			return string(e.Type) == filterValue
@@ -95,17 +256,12 @@ func generateEventUntilOption(timeUntil time.Time) func(e *Event) bool {
 	}
 }
 
-func parseFilter(filter string) (string, string, error) {
-	filterSplit := strings.SplitN(filter, "=", 2)
-	if len(filterSplit) != 2 {
-		return "", "", fmt.Errorf("%s is an invalid filter", filter)
-	}
-	return filterSplit[0], filterSplit[1], nil
-}
-
 // applyFilters applies the EventFilter slices in sequence.  Filters under the
-// same key are disjunctive while each key must match (conjuctive).
+// same key are disjunctive while each key must match (conjuctive).  All keys
+// are applied to every event, as filters matching health transitions need to
+// see every event.
 func applyFilters(event *Event, filterMap map[string][]EventFilter) bool {
+	matches := true
 	for _, filters := range filterMap {
 		success := false
 		for _, filter := range filters {
@@ -115,29 +271,44 @@ func applyFilters(event *Event, filterMap map[string][]EventFilter) bool {
 			}
 		}
 		if !success {
-			return false
+			matches = false
 		}
 	}
-	return true
+	return matches
 }
 
 // generateEventFilter parses the specified filters into a filter map that can
 // later on be used to filter events.  Keys are conjunctive, values are
-// disjunctive.
+// disjunctive.  Negated filters and comparisons are conjunctive as well, so
+// "event!=exec" and "event!=exec_died" exclude both statuses.
 func generateEventFilters(filters []string, since, until string) (map[string][]EventFilter, error) {
 	filterMap := make(map[string][]EventFilter)
+	tracker := &healthTracker{statuses: make(map[string]string)}
 	for _, filter := range filters {
-		key, val, err := parseFilter(filter)
+		key, op, val, err := ParseFilter(filter)
 		if err != nil {
 			return nil, err
 		}
-		filterFunc, err := generateEventFilter(key, val)
+		var filterFunc EventFilter
+		if strings.EqualFold(key, "health") && (op == "=" || op == "!=") {
+			filterFunc, err = generateHealthFilter(val, tracker)
+			if err == nil && op == "!=" {
+				matchFunc := filterFunc
+				filterFunc = func(e *Event) bool {
+					return !matchFunc(e)
+				}
+			}
+		} else {
+			filterFunc, err = generateEventFilter(key, op, val)
+		}
 		if err != nil {
 			return nil, err
 		}
-		filterSlice := filterMap[key]
-		filterSlice = append(filterSlice, filterFunc)
-		filterMap[key] = filterSlice
+		groupKey := key
+		if op != "=" && op != "~=" {
+			groupKey = filter
+		}
+		filterMap[groupKey] = append(filterMap[groupKey], filterFunc)
 	}
 
 	if len(since) > 0 {
@@ -0,0 +1,143 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		key    string
+		op     string
+		value  string
+	}{
+		{"event=died", "event", "=", "died"},
+		{"label=app=web", "label", "=", "app=web"},
+		{"event!=exec", "event", "!=", "exec"},
+		{"container~=^web-[0-9]+$", "container", "~=", "^web-[0-9]+$"},
+		{"exitCode>0", "exitCode", ">", "0"},
+		{"exitCode>=1", "exitCode", ">=", "1"},
+		{"exitCode<128", "exitCode", "<", "128"},
+		{"exitCode<=127", "exitCode", "<=", "127"},
+		// Operators passed as prefix of the value
+		{"exitCode=>0", "exitCode", ">", "0"},
+		{"event=!=exec", "event", "!=", "exec"},
+		{"health=healthy->unhealthy", "health", "=", "healthy->unhealthy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			key, op, value, err := ParseFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.key, key)
+			assert.Equal(t, tt.op, op)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+
+	for _, filter := range []string{"event", "=died", "event!died"} {
+		_, _, _, err := ParseFilter(filter)
+		assert.Error(t, err, filter)
+	}
+}
+
+func TestGenerateEventFilters(t *testing.T) {
+	exitCode := func(code int) *int {
+		return &code
+	}
+	evts := []*Event{
+		{Type: Container, Status: Start, Name: "web-1", ID: "aaaa"},
+		{Type: Container, Status: Exited, Name: "web-1", ID: "aaaa", ContainerExitCode: exitCode(0)},
+		{Type: Container, Status: Exited, Name: "web-2", ID: "bbbb", ContainerExitCode: exitCode(137)},
+		{Type: Container, Status: Exec, Name: "db", ID: "cccc"},
+		{Type: Container, Status: OOM, Name: "db", ID: "cccc"},
+		{Type: Image, Status: Pull, Name: "quay.io/web:latest", ID: "dddd"},
+	}
+
+	tests := []struct {
+		filters  []string
+		expected []int
+	}{
+		{[]string{"event=died"}, []int{1, 2}},
+		{[]string{"event=died", "event=oom"}, []int{1, 2, 4}},
+		{[]string{"event=died", "container=web-2"}, []int{2}},
+		{[]string{"event!=exec", "event!=oom", "type=container"}, []int{0, 1, 2}},
+		{[]string{"exitCode>0"}, []int{2}},
+		{[]string{"exitCode=0"}, []int{1}},
+		{[]string{"exitCode!=0"}, []int{2}},
+		{[]string{"exitCode>=0", "exitCode<128"}, []int{1}},
+		{[]string{"container=web-*"}, []int{0, 1, 2}},
+		{[]string{"container~=^web-[2-9]$"}, []int{2}},
+		{[]string{"container!=web-*"}, []int{3, 4, 5}},
+		{[]string{"name~=web"}, []int{0, 1, 2, 5}},
+		{[]string{"image=quay.io/*"}, []int{5}},
+		{[]string{"container=aa"}, []int{0, 1}},
+	}
+	for _, tt := range tests {
+		filterMap, err := generateEventFilters(tt.filters, "", "")
+		require.NoError(t, err, tt.filters)
+		var matched []int
+		for i, e := range evts {
+			if applyFilters(e, filterMap) {
+				matched = append(matched, i)
+			}
+		}
+		assert.Equal(t, tt.expected, matched, tt.filters)
+	}
+
+	for _, filters := range [][]string{
+		{"exitCode>zero"},
+		{"event>died"},
+		{"event~=died"},
+		{"container~=[web"},
+		{"health=healthy->"},
+		{"unknown=value"},
+	} {
+		_, err := generateEventFilters(filters, "", "")
+		assert.Error(t, err, filters)
+	}
+}
+
+func TestHealthFilter(t *testing.T) {
+	health := func(id, status string) *Event {
+		return &Event{Type: Container, Status: HealthStatus, ID: id, HealthStatus: status}
+	}
+	evts := []*Event{
+		health("a", "starting"),
+		health("a", "healthy"),
+		health("b", "healthy"),
+		health("a", "healthy"),
+		health("b", "unhealthy"),
+		{Type: Container, Status: Exited, ID: "b"},
+		health("a", "unhealthy"),
+		health("a", "healthy"),
+	}
+
+	tests := []struct {
+		filters  []string
+		expected []int
+	}{
+		{[]string{"health=unhealthy"}, []int{4, 6}},
+		{[]string{"health=healthy->unhealthy"}, []int{4, 6}},
+		{[]string{"health=*->healthy"}, []int{1, 7}},
+		{[]string{"health=starting->healthy", "health=unhealthy->healthy"}, []int{1, 7}},
+		{[]string{"health=*->*"}, []int{1, 4, 6, 7}},
+		{[]string{"health=healthy->unhealthy", "container=a"}, []int{6}},
+		{[]string{"health!=unhealthy", "type=container", "event=health_status"}, []int{0, 1, 2, 3, 7}},
+	}
+	for _, tt := range tests {
+		filterMap, err := generateEventFilters(tt.filters, "", "")
+		require.NoError(t, err, tt.filters)
+		matched := []int{}
+		for i, e := range evts {
+			if applyFilters(e, filterMap) {
+				matched = append(matched, i)
+			}
+		}
+		assert.Equal(t, tt.expected, matched, tt.filters)
+	}
+}
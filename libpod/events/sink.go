@@ -0,0 +1,446 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/storage/pkg/ioutils"
+	"github.com/containers/storage/pkg/lockfile"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// sinkExecTimeout is the time the exec sink may take for an event.
+	sinkExecTimeout = 10 * time.Second
+	// sinkExecQueueSize is the maximum number of events waiting for the
+	// exec sink.  Further events are dropped.
+	sinkExecQueueSize = 100
+	// sinkWebhookTimeout is the time the webhook may take for an event.
+	sinkWebhookTimeout = 5 * time.Second
+	// sinkWebhookMaxBackoff is the maximum delay between two attempts to
+	// deliver the queued events to the webhook.
+	sinkWebhookMaxBackoff = 5 * time.Minute
+	// sinkWebhookQueueSize is the maximum number of events queued for the
+	// webhook.  The oldest events are dropped once it is exceeded.
+	sinkWebhookQueueSize = 1000
+	// sinkCloseTimeout is the time Close waits for the pending events to
+	// be delivered.
+	sinkCloseTimeout = 10 * time.Second
+)
+
+// EventerWithSinks is an eventer that delivers every event written to the
+// underlying eventer to the configured sinks as well.  The events are
+// delivered in the background, so slow or unreachable sinks do not delay
+// writing events, and failing to deliver an event does not fail writing it.
+type EventerWithSinks struct {
+	Eventer
+	filters map[string][]EventFilter
+	exec    string
+	webhook *webhookSink
+
+	// lock protects closed and sending to execEvents.
+	lock   sync.Mutex
+	closed bool
+	// execEvents are the events waiting for the exec sink.
+	execEvents chan string
+	// workers are the running delivery goroutines.
+	workers sync.WaitGroup
+}
+
+// newEventerWithSinks wraps the eventer if any sink is configured.
+func newEventerWithSinks(eventer Eventer, options EventerOptions) (Eventer, error) {
+	if options.SinkExec == "" && options.SinkWebhook == "" {
+		return eventer, nil
+	}
+	if err := validateSinkFilters(options.SinkFilters); err != nil {
+		return nil, err
+	}
+	filters, err := generateEventFilters(options.SinkFilters, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("parsing events sink filters: %w", err)
+	}
+	e := &EventerWithSinks{
+		Eventer: eventer,
+		filters: filters,
+		exec:    options.SinkExec,
+	}
+	if options.SinkWebhook != "" {
+		if err := os.MkdirAll(options.SinkQueueDir, 0700); err != nil {
+			return nil, fmt.Errorf("creating events sink queue dir: %w", err)
+		}
+		e.webhook = &webhookSink{
+			url:          options.SinkWebhook,
+			queuePath:    filepath.Join(options.SinkQueueDir, "webhook-queue.jsonl"),
+			incomingPath: filepath.Join(options.SinkQueueDir, "webhook-incoming.jsonl"),
+			client:       &http.Client{Timeout: sinkWebhookTimeout},
+			kick:         make(chan struct{}, 1),
+			stop:         make(chan struct{}),
+		}
+		e.workers.Add(1)
+		go func() {
+			defer e.workers.Done()
+			e.webhook.run()
+		}()
+	}
+	if e.exec != "" {
+		e.execEvents = make(chan string, sinkExecQueueSize)
+		e.workers.Add(1)
+		go func() {
+			defer e.workers.Done()
+			for eventJSON := range e.execEvents {
+				if err := execSink(e.exec, eventJSON); err != nil {
+					logrus.Warnf("Delivering event to %s: %v", e.exec, err)
+				}
+			}
+		}()
+	}
+	return e, nil
+}
+
+// validateSinkFilters rejects the filters that cannot be applied to the
+// events delivered to the sinks.  Health transitions are detected between
+// the events seen by a filter, but each Podman process only sees the events
+// it writes itself.
+func validateSinkFilters(filters []string) error {
+	for _, filter := range filters {
+		key, _, val, err := ParseFilter(filter)
+		if err != nil {
+			return fmt.Errorf("parsing events sink filters: %w", err)
+		}
+		if strings.EqualFold(key, "health") && strings.Contains(val, "->") {
+			return fmt.Errorf("events sink filter %q: health transitions are not supported by the sinks", filter)
+		}
+	}
+	return nil
+}
+
+// Write writes the event to the underlying eventer and hands it to the
+// sinks.
+func (e *EventerWithSinks) Write(ee Event) error {
+	if err := e.Eventer.Write(ee); err != nil {
+		return err
+	}
+	if !applyFilters(&ee, e.filters) {
+		return nil
+	}
+
+	eventJSON, err := ee.ToJSONString()
+	if err != nil {
+		return err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.closed {
+		return nil
+	}
+	if e.execEvents != nil {
+		select {
+		case e.execEvents <- eventJSON:
+		default:
+			logrus.Warnf("Too many events waiting for %s, dropping event", e.exec)
+		}
+	}
+	if e.webhook != nil {
+		if err := e.webhook.enqueue(eventJSON); err != nil {
+			logrus.Warnf("Queuing event for webhook %s: %v", e.webhook.url, err)
+		}
+	}
+	return nil
+}
+
+// Close stops accepting events and waits for the pending events to be
+// delivered, for at most sinkCloseTimeout.  Events queued for the webhook
+// that could not be delivered by then are delivered by the next Podman
+// process writing events.
+func (e *EventerWithSinks) Close() {
+	e.lock.Lock()
+	if e.closed {
+		e.lock.Unlock()
+		return
+	}
+	e.closed = true
+	if e.execEvents != nil {
+		close(e.execEvents)
+	}
+	if e.webhook != nil {
+		close(e.webhook.stop)
+	}
+	e.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		e.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(sinkCloseTimeout):
+		logrus.Warnf("Timed out waiting for events to be delivered to the sinks")
+	}
+}
+
+// execSink runs the executable with the event on its stdin.
+func execSink(path, eventJSON string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sinkExecTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = strings.NewReader(eventJSON + "\n")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if len(output) > 0 {
+			return fmt.Errorf("%w: %s", err, bytes.TrimSpace(output))
+		}
+		return err
+	}
+	return nil
+}
+
+// webhookSink POSTs events to a URL.  Written events are appended to the
+// incoming file, which is only locked for the append.  A worker moves them
+// to the queue and delivers the queue in order, retrying with an
+// exponential back-off until they are delivered.  Only one process at a
+// time delivers the queue.
+type webhookSink struct {
+	url          string
+	queuePath    string
+	incomingPath string
+	client       *http.Client
+	// kick signals the worker that events were written.
+	kick chan struct{}
+	// stop tells the worker to deliver the events written so far and exit.
+	stop chan struct{}
+}
+
+// webhookState records the failed attempts to deliver the queued events.
+type webhookState struct {
+	// Failures is the number of consecutive failed attempts.
+	Failures int `json:"failures"`
+	// Next is the time of the next attempt.
+	Next time.Time `json:"next"`
+}
+
+// webhookBackoff returns the delay after the specified number of consecutive
+// failed attempts.
+func webhookBackoff(failures int) time.Duration {
+	if failures > 16 {
+		return sinkWebhookMaxBackoff
+	}
+	return min(time.Second<<(failures-1), sinkWebhookMaxBackoff)
+}
+
+// enqueue appends the event to the incoming file and wakes up the worker.
+func (w *webhookSink) enqueue(eventJSON string) error {
+	lock, err := lockfile.GetLockFile(w.queuePath + ".lock")
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	f, err := os.OpenFile(w.incomingPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(eventJSON + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	select {
+	case w.kick <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// run delivers the queue whenever events are written and once the back-off
+// after a failed attempt expired.  It starts with an attempt, to deliver the
+// events left by earlier processes.
+func (w *webhookSink) run() {
+	retry := time.NewTimer(0)
+	defer retry.Stop()
+	for {
+		select {
+		case <-w.kick:
+		case <-retry.C:
+		case <-w.stop:
+			select {
+			case <-w.kick:
+				w.deliver()
+			default:
+			}
+			return
+		}
+		retry.Stop()
+		if delay, err := w.deliver(); err != nil {
+			logrus.Warnf("Delivering events to webhook %s: %v", w.url, err)
+			if delay > 0 {
+				retry.Reset(delay)
+			}
+		}
+	}
+}
+
+// deliver moves the incoming events to the queue and delivers the queue
+// unless the webhook is backing off.  On failure, it returns the delay until
+// the next attempt.
+func (w *webhookSink) deliver() (time.Duration, error) {
+	deliverLock, err := lockfile.GetLockFile(w.queuePath + ".deliver.lock")
+	if err != nil {
+		return 0, err
+	}
+	deliverLock.Lock()
+	defer deliverLock.Unlock()
+
+	queue, err := w.takeIncoming()
+	if err != nil {
+		return 0, err
+	}
+	if len(queue) == 0 {
+		return 0, nil
+	}
+
+	var state webhookState
+	statePath := w.queuePath + ".state"
+	if content, err := os.ReadFile(statePath); err == nil {
+		if err := json.Unmarshal(content, &state); err != nil {
+			logrus.Debugf("Ignoring invalid events queue state: %v", err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return 0, fmt.Errorf("reading events queue state: %w", err)
+	}
+	if wait := time.Until(state.Next); wait > 0 {
+		return wait, fmt.Errorf("backing off until %s, %d event(s) queued", state.Next.Format(time.RFC3339), len(queue))
+	}
+
+	var sendErr error
+	delivered := 0
+	for _, event := range queue {
+		if sendErr = w.post(event); sendErr != nil {
+			break
+		}
+		delivered++
+	}
+	queue = queue[delivered:]
+	if sendErr != nil {
+		state.Failures++
+		state.Next = time.Now().Add(webhookBackoff(state.Failures))
+		sendErr = fmt.Errorf("%w, %d event(s) queued", sendErr, len(queue))
+	} else {
+		state = webhookState{}
+	}
+
+	if err := writeLines(w.queuePath, queue); err != nil {
+		return 0, fmt.Errorf("writing events queue: %w", err)
+	}
+	content, err := json.Marshal(state)
+	if err != nil {
+		return 0, err
+	}
+	if err := ioutils.AtomicWriteFile(statePath, content, 0600); err != nil {
+		return 0, fmt.Errorf("writing events queue state: %w", err)
+	}
+	if sendErr != nil {
+		return time.Until(state.Next), sendErr
+	}
+	return 0, nil
+}
+
+// takeIncoming moves the incoming events to the end of the queue, dropping
+// the oldest events if the queue is full, and returns the queue.  It must be
+// called with the deliver lock held, which protects the queue.
+func (w *webhookSink) takeIncoming() ([]string, error) {
+	lock, err := lockfile.GetLockFile(w.queuePath + ".lock")
+	if err != nil {
+		return nil, err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	incoming, err := readLines(w.incomingPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading incoming events: %w", err)
+	}
+	queue, err := readLines(w.queuePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading events queue: %w", err)
+	}
+	if len(incoming) == 0 {
+		return queue, nil
+	}
+
+	queue = append(queue, incoming...)
+	if dropped := len(queue) - sinkWebhookQueueSize; dropped > 0 {
+		logrus.Warnf("Events queue of webhook %s is full, dropping %d event(s)", w.url, dropped)
+		queue = queue[dropped:]
+	}
+	if err := writeLines(w.queuePath, queue); err != nil {
+		return nil, fmt.Errorf("writing events queue: %w", err)
+	}
+	if err := os.Remove(w.incomingPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// post delivers a single event.
+func (w *webhookSink) post(eventJSON string) error {
+	resp, err := w.client.Post(w.url, "application/json", strings.NewReader(eventJSON))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func readLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	// Events with inspect data can be larger than the default limit.
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+func writeLines(path string, lines []string) error {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	return ioutils.AtomicWriteFile(path, buf.Bytes(), 0600)
+}
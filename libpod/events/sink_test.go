@@ -0,0 +1,147 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventerWithSinksWebhook(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []Event
+		failing  = true
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var e Event
+		assert.NoError(t, json.Unmarshal(body, &e))
+		received = append(received, e)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	options := EventerOptions{
+		EventerType:  Null.String(),
+		SinkFilters:  []string{"event=died", "event=oom"},
+		SinkQueueDir: dir,
+		SinkWebhook:  server.URL,
+	}
+	eventer, err := NewEventer(options)
+	require.NoError(t, err)
+
+	write := func(eventer Eventer, status Status, name string) {
+		e := NewEvent(status)
+		e.Type = Container
+		e.Name = name
+		require.NoError(t, eventer.Write(e))
+	}
+	receivedNames := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		var names []string
+		for _, e := range received {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	// Undeliverable events are queued and the webhook is backed off.
+	write(eventer, Exited, "first")
+	write(eventer, Start, "filtered")
+	write(eventer, OOM, "second")
+	eventer.(*EventerWithSinks).Close()
+	queue, err := readLines(filepath.Join(dir, "webhook-queue.jsonl"))
+	require.NoError(t, err)
+	assert.Len(t, queue, 2)
+
+	// The next eventer delivers the queued events in order once the
+	// back-off expired, without waiting for further events.
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+	eventer, err = NewEventer(options)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return len(receivedNames()) == 2 }, 5*time.Second, 50*time.Millisecond)
+
+	write(eventer, Exited, "third")
+	eventer.(*EventerWithSinks).Close()
+	assert.Equal(t, []string{"first", "second", "third"}, receivedNames())
+	queue, err = readLines(filepath.Join(dir, "webhook-queue.jsonl"))
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+
+	// Events are not delivered once the eventer is closed.
+	write(eventer, Exited, "closed")
+	assert.Len(t, receivedNames(), 3)
+}
+
+func TestEventerWithSinksExec(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "events")
+	script := filepath.Join(dir, "sink")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\ncat >>"+output+"\n"), 0755))
+
+	eventer, err := NewEventer(EventerOptions{
+		EventerType: Null.String(),
+		SinkExec:    script,
+	})
+	require.NoError(t, err)
+
+	e := NewEvent(HealthStatus)
+	e.Type = Container
+	e.HealthStatus = "unhealthy"
+	require.NoError(t, eventer.Write(e))
+	eventer.(*EventerWithSinks).Close()
+
+	lines, err := readLines(output)
+	require.NoError(t, err)
+	require.Len(t, lines, 1)
+	var received Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &received))
+	assert.Equal(t, HealthStatus, received.Status)
+	assert.Equal(t, "unhealthy", received.HealthStatus)
+}
+
+func TestEventerWithSinksFilters(t *testing.T) {
+	_, err := NewEventer(EventerOptions{
+		EventerType: Null.String(),
+		SinkExec:    "/bin/true",
+		SinkFilters: []string{"health=healthy->unhealthy"},
+	})
+	assert.ErrorContains(t, err, "health transitions are not supported by the sinks")
+
+	eventer, err := NewEventer(EventerOptions{
+		EventerType: Null.String(),
+		SinkExec:    "/bin/true",
+		SinkFilters: []string{"health=unhealthy"},
+	})
+	require.NoError(t, err)
+	eventer.(*EventerWithSinks).Close()
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, webhookBackoff(1))
+	assert.Equal(t, 8*time.Second, webhookBackoff(4))
+	assert.Equal(t, sinkWebhookMaxBackoff, webhookBackoff(10))
+	assert.Equal(t, sinkWebhookMaxBackoff, webhookBackoff(100))
+}
@@ -51,40 +51,43 @@ func (r *Runtime) HealthCheck(ctx context.Context, name string) (define.HealthCh
 	return hcStatus, err
 }
 
-func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.HealthCheckStatus, string, error) {
-	var (
-		newCommand    []string
-		returnCode    int
-		inStartPeriod bool
-	)
-
-	hcCommand := c.HealthCheckConfig().Test
-	if isStartup {
-		logrus.Debugf("Running startup healthcheck for container %s", c.ID())
-		hcCommand = c.config.StartupHealthCheckConfig.Test
-	}
-	if len(hcCommand) < 1 {
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
-	}
-	switch hcCommand[0] {
+// healthCheckTestCommand splits the test of a healthcheck into the command
+// to execute in the container or the probe to perform.  False is returned if
+// the test does not define a healthcheck.
+func healthCheckTestCommand(test []string) (command []string, probe []string, ok bool) {
+	if len(test) < 1 {
+		return nil, nil, false
+	}
+	switch test[0] {
 	case "", define.HealthConfigTestNone:
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
+		return nil, nil, false
 	case define.HealthConfigTestCmd:
-		newCommand = hcCommand[1:]
+		command = test[1:]
 	case define.HealthConfigTestCmdShell:
 		// TODO: SHELL command from image not available in Container - use Docker default
-		newCommand = []string{"/bin/sh", "-c", strings.Join(hcCommand[1:], " ")}
+		command = []string{"/bin/sh", "-c", strings.Join(test[1:], " ")}
+	case define.HealthConfigTestHTTP, define.HealthConfigTestTCP, define.HealthConfigTestGRPC:
+		// probes are performed by Podman rather than inside the container
+		return nil, test, true
 	default:
 		// command supplied on command line - pass as-is
-		newCommand = hcCommand
+		command = test
 	}
-	if len(newCommand) < 1 || newCommand[0] == "" {
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
+	if len(command) < 1 || command[0] == "" {
+		return nil, nil, false
 	}
+	return command, nil, true
+}
 
-	streams := new(define.AttachStreams)
-	output := &bytes.Buffer{}
+// execHealthCheckTest executes the command in the container or performs the
+// probe of a healthcheck and writes its output to output.
+func (c *Container) execHealthCheckTest(command, probe []string, timeout time.Duration, output *bytes.Buffer) (int, error) {
+	if probe != nil {
+		logrus.Debugf("performing health check probe %s for %s", strings.Join(probe, " "), c.ID())
+		return c.healthCheckProbe(probe, timeout, output)
+	}
 
+	streams := new(define.AttachStreams)
 	streams.InputStream = bufio.NewReader(os.Stdin)
 	streams.OutputStream = output
 	streams.ErrorStream = output
@@ -92,12 +95,32 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 	streams.AttachError = true
 	streams.AttachInput = true
 
-	logrus.Debugf("executing health check command %s for %s", strings.Join(newCommand, " "), c.ID())
-	hcResult := define.HealthCheckSuccess
+	logrus.Debugf("executing health check command %s for %s", strings.Join(command, " "), c.ID())
 	config := new(ExecConfig)
-	config.Command = newCommand
+	config.Command = command
+	return c.healthCheckExec(config, timeout, streams)
+}
+
+func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.HealthCheckStatus, string, error) {
+	var (
+		returnCode    int
+		inStartPeriod bool
+	)
+
+	hcCommand := c.HealthCheckConfig().Test
+	if isStartup {
+		logrus.Debugf("Running startup healthcheck for container %s", c.ID())
+		hcCommand = c.config.StartupHealthCheckConfig.Test
+	}
+	newCommand, probe, ok := healthCheckTestCommand(hcCommand)
+	if !ok {
+		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
+	}
+
+	output := &bytes.Buffer{}
+	hcResult := define.HealthCheckSuccess
 	timeStart := time.Now()
-	exitCode, hcErr := c.healthCheckExec(config, c.HealthCheckConfig().Timeout, streams)
+	exitCode, hcErr := c.execHealthCheckTest(newCommand, probe, c.HealthCheckConfig().Timeout, output)
 	timeEnd := time.Now()
 	if hcErr != nil {
 		hcResult = define.HealthCheckFailure
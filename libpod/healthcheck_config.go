@@ -71,8 +71,20 @@ func (h *StartupHealthCheckConfig) GetInterval() time.Duration {
 	return h.Interval
 }
 
+// healthCheckTestToCmd returns the healthcheck command of the test in the
+// form accepted by specgenutil.MakeHealthCheckFromCli.  Probes are returned
+// as JSON array to preserve arguments containing spaces, such as headers.
+func healthCheckTestToCmd(test []string) string {
+	if define.IsHealthCheckProbe(test) {
+		if cmd, err := json.Marshal(test); err == nil {
+			return string(cmd)
+		}
+	}
+	return strings.Join(test, " ")
+}
+
 func (h *HealthCheckConfig) SetCurrentConfigTo(healthCheckOptions *define.HealthCheckOptions) {
-	healthCheckOptions.Cmd = strings.Join(h.Test, " ")
+	healthCheckOptions.Cmd = healthCheckTestToCmd(h.Test)
 	healthCheckOptions.Interval = h.Interval.String()
 	healthCheckOptions.Retries = h.Retries
 	healthCheckOptions.Timeout = h.Timeout.String()
@@ -80,7 +92,7 @@ func (h *HealthCheckConfig) SetCurrentConfigTo(healthCheckOptions *define.Health
 }
 
 func (h *StartupHealthCheckConfig) SetCurrentConfigTo(healthCheckOptions *define.HealthCheckOptions) {
-	healthCheckOptions.Cmd = strings.Join(h.Test, " ")
+	healthCheckOptions.Cmd = healthCheckTestToCmd(h.Test)
 	healthCheckOptions.Interval = h.Interval.String()
 	healthCheckOptions.Retries = h.Retries
 	healthCheckOptions.Timeout = h.Timeout.String()
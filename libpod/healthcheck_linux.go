@@ -18,14 +18,48 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// createTimer systemd timers for healthchecks of a container
-func (c *Container) createTimer(interval string, isStartup bool) error {
+// createSystemdTimer creates systemd timers for healthchecks of a container
+func (c *Container) createSystemdTimer(interval string, isStartup bool) error {
 	if c.disableHealthCheckSystemd(isStartup) {
 		return nil
 	}
 
 	hcUnitName := c.hcUnitName(isStartup, false)
+	if err := runSystemdTimer(hcUnitName, interval, "healthcheck", "run", c.ID()); err != nil {
+		return err
+	}
+
+	c.state.HCUnitName = hcUnitName
+	if err := c.save(); err != nil {
+		return fmt.Errorf("saving container %s healthcheck unit name: %w", c.ID(), err)
+	}
+
+	return nil
+}
+
+// createSystemdReadinessTimer creates systemd timers for the readiness check
+// of a container
+func (c *Container) createSystemdReadinessTimer(interval string) error {
+	if !systemdCommon.RunsOnSystemd() || os.Getenv("DISABLE_HC_SYSTEMD") == "true" {
+		return nil
+	}
+
+	unitName := fmt.Sprintf("%s-readiness-%x", c.ID(), rand.Int())
+	if err := runSystemdTimer(unitName, interval, "healthcheck", "run", "--readiness", c.ID()); err != nil {
+		return err
+	}
+
+	c.state.ReadinessUnitName = unitName
+	if err := c.save(); err != nil {
+		return fmt.Errorf("saving container %s readiness check unit name: %w", c.ID(), err)
+	}
+
+	return nil
+}
 
+// runSystemdTimer creates a transient systemd timer with the specified unit
+// name running podman with the specified arguments at the interval.
+func runSystemdTimer(unitName, interval string, args ...string) error {
 	podman, err := os.Executable()
 	if err != nil {
 		return fmt.Errorf("failed to get path for podman for a health check timer: %w", err)
@@ -40,13 +74,13 @@ func (c *Container) createTimer(interval string, isStartup bool) error {
 		cmd = append(cmd, "--setenv=PATH="+path)
 	}
 
-	cmd = append(cmd, "--unit", hcUnitName, fmt.Sprintf("--on-unit-inactive=%s", interval), "--timer-property=AccuracySec=1s", podman)
+	cmd = append(cmd, "--unit", unitName, fmt.Sprintf("--on-unit-inactive=%s", interval), "--timer-property=AccuracySec=1s", podman)
 
 	if logrus.IsLevelEnabled(logrus.DebugLevel) {
 		cmd = append(cmd, "--log-level=debug", "--syslog")
 	}
 
-	cmd = append(cmd, "healthcheck", "run", c.ID())
+	cmd = append(cmd, args...)
 
 	conn, err := systemd.ConnectToDBUS()
 	if err != nil {
@@ -63,11 +97,6 @@ func (c *Container) createTimer(interval string, isStartup bool) error {
 		return fmt.Errorf("failed to execute systemd-run: %w", err)
 	}
 
-	c.state.HCUnitName = hcUnitName
-	if err := c.save(); err != nil {
-		return fmt.Errorf("saving container %s healthcheck unit name: %w", c.ID(), err)
-	}
-
 	return nil
 }
 
@@ -82,8 +111,8 @@ func systemdOpSuccessful(c chan string) error {
 	}
 }
 
-// startTimer starts a systemd timer for the healthchecks
-func (c *Container) startTimer(isStartup bool) error {
+// startSystemdTimer starts a systemd timer for the healthchecks
+func (c *Container) startSystemdTimer(isStartup bool) error {
 	if c.disableHealthCheckSystemd(isStartup) {
 		return nil
 	}
@@ -92,14 +121,19 @@ func (c *Container) startTimer(isStartup bool) error {
 	if hcUnitName == "" {
 		hcUnitName = c.hcUnitName(isStartup, true)
 	}
+	return startSystemdUnit(hcUnitName)
+}
 
+// startSystemdUnit starts the service of the systemd timer with the specified
+// unit name
+func startSystemdUnit(unitName string) error {
 	conn, err := systemd.ConnectToDBUS()
 	if err != nil {
 		return fmt.Errorf("unable to get systemd connection to start healthchecks: %w", err)
 	}
 	defer conn.Close()
 
-	startFile := fmt.Sprintf("%s.service", hcUnitName)
+	startFile := fmt.Sprintf("%s.service", unitName)
 	startChan := make(chan string)
 	if _, err := conn.RestartUnitContext(context.Background(), startFile, "fail", startChan); err != nil {
 		return err
@@ -111,12 +145,30 @@ func (c *Container) startTimer(isStartup bool) error {
 	return nil
 }
 
-// removeTransientFiles removes the systemd timer and unit files
+// removeSystemdTransientFiles removes the systemd timer and unit files
 // for the container
-func (c *Container) removeTransientFiles(ctx context.Context, isStartup bool, unitName string) error {
+func (c *Container) removeSystemdTransientFiles(ctx context.Context, isStartup bool, unitName string) error {
 	if c.disableHealthCheckSystemd(isStartup) {
 		return nil
 	}
+	if unitName == "" {
+		unitName = c.hcUnitName(isStartup, true)
+	}
+	return removeSystemdUnits(ctx, unitName)
+}
+
+// removeSystemdReadinessFiles removes the systemd timer and unit files of the
+// readiness check of the container
+func (c *Container) removeSystemdReadinessFiles(ctx context.Context, unitName string) error {
+	if !systemdCommon.RunsOnSystemd() || os.Getenv("DISABLE_HC_SYSTEMD") == "true" {
+		return nil
+	}
+	return removeSystemdUnits(ctx, unitName)
+}
+
+// removeSystemdUnits stops and removes the systemd timer and service with the
+// specified unit name
+func removeSystemdUnits(ctx context.Context, unitName string) error {
 	conn, err := systemd.ConnectToDBUS()
 	if err != nil {
 		return fmt.Errorf("unable to get systemd connection to remove healthchecks: %w", err)
@@ -127,9 +179,6 @@ func (c *Container) removeTransientFiles(ctx context.Context, isStartup bool, un
 	// clean up as much as possible.
 	stopErrors := []error{}
 
-	if unitName == "" {
-		unitName = c.hcUnitName(isStartup, true)
-	}
 	// Stop the timer before the service to make sure the timer does not
 	// fire after the service is stopped.
 	timerChan := make(chan string)
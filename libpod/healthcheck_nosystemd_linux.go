@@ -6,18 +6,36 @@ import (
 	"context"
 )
 
-// createTimer systemd timers for healthchecks of a container
-func (c *Container) createTimer(interval string, isStartup bool) error {
+// createSystemdTimer creates systemd timers for healthchecks of a container
+func (c *Container) createSystemdTimer(interval string, isStartup bool) error {
 	return nil
 }
 
-// startTimer starts a systemd timer for the healthchecks
-func (c *Container) startTimer(isStartup bool) error {
+// startSystemdTimer starts a systemd timer for the healthchecks
+func (c *Container) startSystemdTimer(isStartup bool) error {
 	return nil
 }
 
-// removeTransientFiles removes the systemd timer and unit files
+// removeSystemdTransientFiles removes the systemd timer and unit files
 // for the container
-func (c *Container) removeTransientFiles(ctx context.Context, isStartup bool, unitName string) error {
+func (c *Container) removeSystemdTransientFiles(ctx context.Context, isStartup bool, unitName string) error {
+	return nil
+}
+
+// createSystemdReadinessTimer creates systemd timers for the readiness check
+// of a container
+func (c *Container) createSystemdReadinessTimer(interval string) error {
+	return nil
+}
+
+// startSystemdUnit starts the service of the systemd timer with the specified
+// unit name
+func startSystemdUnit(unitName string) error {
+	return nil
+}
+
+// removeSystemdReadinessFiles removes the systemd timer and unit files of the
+// readiness check of the container
+func (c *Container) removeSystemdReadinessFiles(ctx context.Context, unitName string) error {
 	return nil
 }
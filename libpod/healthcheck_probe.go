@@ -0,0 +1,221 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// probeUserAgent is sent by HTTP and gRPC probes.
+const probeUserAgent = "podman-healthcheck"
+
+// probeDialer opens connections from the network namespace of a container.
+type probeDialer func(ctx context.Context, network, address string) (net.Conn, error)
+
+// healthCheckProbe performs the healthcheck probe described by test.  Like
+// a healthcheck command, a failed probe yields an exit code of 1 and the
+// reason for the failure is written to output.
+func (c *Container) healthCheckProbe(test []string, timeout time.Duration, output io.Writer) (int, error) {
+	if err := define.ValidateHealthCheckProbe(test); err != nil {
+		return 125, err
+	}
+
+	dial, err := c.healthCheckProbeDialer()
+	if err != nil {
+		return 125, fmt.Errorf("preparing %s healthcheck of container %s: %w", test[0], c.ID(), err)
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	switch test[0] {
+	case define.HealthConfigTestHTTP:
+		err = httpProbe(ctx, dial, test[1], test[2:], output)
+	case define.HealthConfigTestTCP:
+		err = tcpProbe(ctx, dial, test[1], output)
+	case define.HealthConfigTestGRPC:
+		service := ""
+		if len(test) > 2 {
+			service = test[2]
+		}
+		err = grpcProbe(ctx, dial, test[1], service, output)
+	}
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return -1, define.ErrHealthCheckTimeout
+		}
+		fmt.Fprintln(output, err)
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// httpProbe performs an HTTP GET request.  As with Kubernetes probes, status
+// codes from 200 to 399 are considered a success, redirects are not followed
+// and certificates are not verified.
+func httpProbe(ctx context.Context, dial probeDialer, rawURL string, headers []string, output io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", probeUserAgent)
+	for _, header := range headers {
+		name, value, _ := strings.Cut(header, ":")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if strings.EqualFold(name, "Host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Set(name, value)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext:       dial,
+			DisableKeepAlives: true,
+			//nolint:gosec // Probes check for liveness, not for authenticity.
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("GET %s: unexpected status %s", rawURL, resp.Status)
+	}
+	fmt.Fprintf(output, "GET %s: %s\n", rawURL, resp.Status)
+	return nil
+}
+
+// tcpProbe succeeds if a TCP connection can be established.
+func tcpProbe(ctx context.Context, dial probeDialer, address string, output io.Writer) error {
+	conn, err := dial(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	conn.Close()
+	fmt.Fprintf(output, "connected to %s\n", address)
+	return nil
+}
+
+// grpcHealthServing is the SERVING status of the gRPC health checking
+// protocol.
+const grpcHealthServing = 1
+
+// grpcHealthStatuses are the names of the statuses of the gRPC health
+// checking protocol.
+var grpcHealthStatuses = map[uint64]string{
+	0: "UNKNOWN",
+	1: "SERVING",
+	2: "NOT_SERVING",
+	3: "SERVICE_UNKNOWN",
+}
+
+// grpcRawCodec passes pre-encoded protobuf messages through, which allows for
+// calling the gRPC health checking protocol without generated code.
+type grpcRawCodec struct{}
+
+func (grpcRawCodec) Marshal(v any) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return b, nil
+}
+
+func (grpcRawCodec) Unmarshal(data []byte, v any) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (grpcRawCodec) Name() string {
+	return "proto"
+}
+
+// grpcProbe calls the Check method of the gRPC health checking protocol
+// (grpc.health.v1.Health) and succeeds if the service is serving.
+func grpcProbe(ctx context.Context, dial probeDialer, address, service string, output io.Writer) error {
+	conn, err := grpc.NewClient("passthrough:///"+address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUserAgent(probeUserAgent),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return dial(ctx, "tcp", addr)
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// HealthCheckRequest{service = 1}
+	var request []byte
+	if service != "" {
+		request = protowire.AppendTag(request, 1, protowire.BytesType)
+		request = protowire.AppendString(request, service)
+	}
+	var response []byte
+	if err := conn.Invoke(ctx, "/grpc.health.v1.Health/Check", request, &response, grpc.ForceCodec(grpcRawCodec{})); err != nil {
+		return err
+	}
+
+	// HealthCheckResponse{status = 1}
+	status := uint64(0)
+	for len(response) > 0 {
+		num, typ, n := protowire.ConsumeTag(response)
+		if n < 0 {
+			return fmt.Errorf("decoding gRPC health response: %w", protowire.ParseError(n))
+		}
+		response = response[n:]
+		if num == 1 && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(response)
+			if n < 0 {
+				return fmt.Errorf("decoding gRPC health response: %w", protowire.ParseError(n))
+			}
+			status = v
+			response = response[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, response)
+		if n < 0 {
+			return fmt.Errorf("decoding gRPC health response: %w", protowire.ParseError(n))
+		}
+		response = response[n:]
+	}
+
+	name, ok := grpcHealthStatuses[status]
+	if !ok {
+		name = fmt.Sprintf("status %d", status)
+	}
+	if status != grpcHealthServing {
+		return fmt.Errorf("gRPC health check of %s: %s", address, name)
+	}
+	fmt.Fprintf(output, "gRPC health check of %s: %s\n", address, name)
+	return nil
+}
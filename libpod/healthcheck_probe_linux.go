@@ -0,0 +1,40 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"net"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+)
+
+// healthCheckProbeDialer returns a dialer opening connections from the
+// network namespace of the container.  Sockets are bound to the network
+// namespace they are created in, so only the dialing must happen inside of
+// it.  Containers using the host network are probed from the host.
+func (c *Container) healthCheckProbeDialer() (probeDialer, error) {
+	netNSPath, _, err := getContainerNetNS(c)
+	if err != nil {
+		return nil, err
+	}
+	if netNSPath == "" {
+		netNSPath, _ = c.joinedNetworkNSPath()
+	}
+
+	// Disable dialing IPv4 and IPv6 addresses in parallel, which would
+	// happen in other goroutines and hence outside of the namespace.
+	dialer := &net.Dialer{FallbackDelay: -1}
+	if netNSPath == "" {
+		return dialer.DialContext, nil
+	}
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		var conn net.Conn
+		err := ns.WithNetNSPath(netNSPath, func(_ ns.NetNS) error {
+			var err error
+			conn, err = dialer.DialContext(ctx, network, address)
+			return err
+		})
+		return conn, err
+	}, nil
+}
@@ -0,0 +1,179 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/containers/image/v5/manifest"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/util/intstr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func hostDialer() probeDialer {
+	dialer := &net.Dialer{FallbackDelay: -1}
+	return dialer.DialContext
+}
+
+func TestHTTPProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, probeUserAgent, r.UserAgent())
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		case "/header":
+			if r.Header.Get("X-Probe") != "yes" || r.Host != "example.com" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		path    string
+		headers []string
+		success bool
+	}{
+		{"/healthz", nil, true},
+		{"/redirect", nil, true},
+		{"/header", []string{"X-Probe: yes", "Host: example.com"}, true},
+		{"/header", nil, false},
+		{"/unavailable", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			err := httpProbe(context.Background(), hostDialer(), server.URL+tt.path, tt.headers, output)
+			if tt.success {
+				assert.NoError(t, err)
+				assert.Contains(t, output.String(), server.URL+tt.path)
+			} else {
+				assert.ErrorContains(t, err, "unexpected status")
+			}
+		})
+	}
+}
+
+func TestTCPProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+
+	output := &bytes.Buffer{}
+	assert.NoError(t, tcpProbe(context.Background(), hostDialer(), address, output))
+	assert.Equal(t, "connected to "+address+"\n", output.String())
+
+	listener.Close()
+	assert.Error(t, tcpProbe(context.Background(), hostDialer(), address, output))
+}
+
+func TestGRPCProbe(t *testing.T) {
+	statuses := map[string]uint64{
+		"":        grpcHealthServing,
+		"serving": grpcHealthServing,
+		"failing": 2,
+	}
+	server := grpc.NewServer(grpc.ForceServerCodec(grpcRawCodec{}))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "grpc.health.v1.Health",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Check",
+			Handler: func(_ any, _ context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				var request []byte
+				if err := dec(&request); err != nil {
+					return nil, err
+				}
+				service := ""
+				if len(request) > 0 {
+					_, _, n := protowire.ConsumeTag(request)
+					service, _ = protowire.ConsumeString(request[n:])
+				}
+				response := protowire.AppendTag(nil, 1, protowire.VarintType)
+				return protowire.AppendVarint(response, statuses[service]), nil
+			},
+		}},
+	}, struct{}{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+	address := listener.Addr().String()
+
+	output := &bytes.Buffer{}
+	assert.NoError(t, grpcProbe(context.Background(), hostDialer(), address, "", output))
+	assert.NoError(t, grpcProbe(context.Background(), hostDialer(), address, "serving", output))
+	assert.ErrorContains(t, grpcProbe(context.Background(), hostDialer(), address, "failing", output), "NOT_SERVING")
+}
+
+func TestHealthCheckToKubeProbe(t *testing.T) {
+	service := "my.Service"
+	tests := []struct {
+		test     []string
+		expected v1.Handler
+	}{
+		{
+			[]string{"HTTP", "https://localhost:8443/healthz?full=1", "X-Probe: yes"},
+			v1.Handler{HTTPGet: &v1.HTTPGetAction{
+				Path:        "/healthz?full=1",
+				Port:        intstr.FromInt(8443),
+				Scheme:      v1.URISchemeHTTPS,
+				HTTPHeaders: []v1.HTTPHeader{{Name: "X-Probe", Value: "yes"}},
+			}},
+		},
+		{
+			[]string{"HTTP", "http://10.0.0.1/"},
+			v1.Handler{HTTPGet: &v1.HTTPGetAction{
+				Path:   "/",
+				Port:   intstr.FromInt(80),
+				Host:   "10.0.0.1",
+				Scheme: v1.URISchemeHTTP,
+			}},
+		},
+		{
+			[]string{"TCP", "localhost:5432"},
+			v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(5432)}},
+		},
+		{
+			[]string{"GRPC", "localhost:9000", service},
+			v1.Handler{GRPC: &v1.GRPCAction{Port: 9000, Service: &service}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.test[0], func(t *testing.T) {
+			probe := healthCheckToKubeProbe(&manifest.Schema2HealthConfig{
+				Test:        tt.test,
+				StartPeriod: 5 * time.Second,
+				Interval:    30 * time.Second,
+				Timeout:     2 * time.Second,
+				Retries:     4,
+			})
+			require.NotNil(t, probe)
+			assert.Equal(t, tt.expected, probe.Handler)
+			assert.Equal(t, int32(5), probe.InitialDelaySeconds)
+			assert.Equal(t, int32(30), probe.PeriodSeconds)
+			assert.Equal(t, int32(2), probe.TimeoutSeconds)
+			assert.Equal(t, int32(4), probe.FailureThreshold)
+		})
+	}
+
+	assert.Nil(t, healthCheckToKubeProbe(&manifest.Schema2HealthConfig{Test: []string{"CMD-SHELL", "true"}}))
+	assert.Nil(t, healthCheckToKubeProbe(nil))
+}
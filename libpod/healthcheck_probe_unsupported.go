@@ -0,0 +1,13 @@
+//go:build !remote && !linux
+
+package libpod
+
+import (
+	"github.com/containers/podman/v5/libpod/define"
+)
+
+// healthCheckProbeDialer returns a dialer opening connections from the
+// network namespace of the container.
+func (c *Container) healthCheckProbeDialer() (probeDialer, error) {
+	return nil, define.ErrOSNotSupported
+}
@@ -0,0 +1,335 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/storage/pkg/stringid"
+	"github.com/sirupsen/logrus"
+)
+
+// createTimer prepares the periodic execution of the healthchecks of a
+// container with the scheduler configured in containers.conf.
+func (c *Container) createTimer(interval string, isStartup bool) error {
+	useScheduler, err := c.useHealthCheckScheduler()
+	if err != nil {
+		return err
+	}
+	if !useScheduler {
+		return c.createSystemdTimer(interval, isStartup)
+	}
+	return c.createSchedulerTimer(isStartup)
+}
+
+// startTimer starts the periodic execution of the healthchecks.
+func (c *Container) startTimer(isStartup bool) error {
+	useScheduler, err := c.useHealthCheckScheduler()
+	if err != nil {
+		return err
+	}
+	if !useScheduler {
+		return c.startSystemdTimer(isStartup)
+	}
+	return c.startSchedulerTimer(isStartup)
+}
+
+// removeTransientFiles stops the periodic execution of the healthchecks and
+// removes the files of the specified timer.
+func (c *Container) removeTransientFiles(ctx context.Context, isStartup bool, unitName string) error {
+	if strings.HasPrefix(unitName, c.hcSchedulerPrefix()) {
+		return c.removeSchedulerFiles(unitName)
+	}
+	// Units created by systemd before switching schedulers must still be
+	// removed, so only skip systemd if there is no unit at all.
+	if useScheduler, _ := c.useHealthCheckScheduler(); useScheduler && unitName == "" {
+		return nil
+	}
+	return c.removeSystemdTransientFiles(ctx, isStartup, unitName)
+}
+
+// createReadinessTimer prepares the periodic execution of the readiness check
+// of a container with the scheduler configured in containers.conf.
+func (c *Container) createReadinessTimer() error {
+	if c.config.ReadinessCheckConfig == nil || c.config.ReadinessCheckConfig.Interval == 0 {
+		return nil
+	}
+	useScheduler, err := c.useHealthCheckScheduler()
+	if err != nil {
+		return err
+	}
+	if !useScheduler {
+		return c.createSystemdReadinessTimer(c.config.ReadinessCheckConfig.Interval.String())
+	}
+
+	c.state.ReadinessUnitName = c.hcSchedulerPrefix() + "-readiness-" + stringid.GenerateRandomID()[:12]
+	if err := c.save(); err != nil {
+		return fmt.Errorf("saving container %s readiness check scheduler name: %w", c.ID(), err)
+	}
+	return nil
+}
+
+// startReadinessTimer starts the periodic execution of the readiness check.
+func (c *Container) startReadinessTimer() error {
+	unitName := c.state.ReadinessUnitName
+	if unitName == "" {
+		return nil
+	}
+	if strings.HasPrefix(unitName, c.hcSchedulerPrefix()) {
+		return c.spawnScheduler(unitName, true)
+	}
+	return startSystemdUnit(unitName)
+}
+
+// removeReadinessTimer stops the periodic execution of the readiness check
+// and marks the container as not ready.  The caller must save the state.
+func (c *Container) removeReadinessTimer(ctx context.Context) error {
+	unitName := c.state.ReadinessUnitName
+	c.state.ReadinessUnitName = ""
+	c.state.Ready = false
+	c.state.ReadinessSuccessCount = 0
+	c.state.ReadinessFailureCount = 0
+	if unitName == "" {
+		return nil
+	}
+	if strings.HasPrefix(unitName, c.hcSchedulerPrefix()) {
+		return c.removeSchedulerFiles(unitName)
+	}
+	return c.removeSystemdReadinessFiles(ctx, unitName)
+}
+
+// useHealthCheckScheduler returns true if the healthchecks of the container
+// are run by the Podman scheduler rather than by systemd.
+func (c *Container) useHealthCheckScheduler() (bool, error) {
+	switch scheduler := c.runtime.podmanConfig.Engine.HealthcheckScheduler; scheduler {
+	case "", define.HealthCheckSchedulerSystemd:
+		return false, nil
+	case define.HealthCheckSchedulerPodman:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported healthcheck scheduler %q: supported schedulers are %s and %s", scheduler, define.HealthCheckSchedulerSystemd, define.HealthCheckSchedulerPodman)
+	}
+}
+
+// disableHealthCheckScheduler returns true if there is no interval to run the
+// healthchecks with.
+func (c *Container) disableHealthCheckScheduler(isStartup bool) bool {
+	if isStartup && c.config.StartupHealthCheckConfig.Interval == 0 {
+		return true
+	}
+	return c.config.HealthCheckConfig.Interval == 0
+}
+
+// hcSchedulerPrefix is the prefix of the names of the schedulers of the
+// container.  It allows for telling them apart from systemd units.
+func (c *Container) hcSchedulerPrefix() string {
+	return c.ID() + "-scheduler"
+}
+
+// hcSchedulerPidFile is the file the scheduler with the specified name
+// records its PID in.
+func (c *Container) hcSchedulerPidFile(name string) string {
+	return filepath.Join(c.state.RunDir, name+".pid")
+}
+
+// createSchedulerTimer records a new scheduler in the state of the container.
+// Any other scheduler of the container exits once it notices it has been
+// replaced.
+func (c *Container) createSchedulerTimer(isStartup bool) error {
+	if c.disableHealthCheckScheduler(isStartup) {
+		return nil
+	}
+
+	name := c.hcSchedulerPrefix()
+	if isStartup {
+		name += "-startup"
+	}
+	// Ensure that names are unique from run to run.
+	name += "-" + stringid.GenerateRandomID()[:12]
+
+	c.state.HCUnitName = name
+	if err := c.save(); err != nil {
+		return fmt.Errorf("saving container %s healthcheck scheduler name: %w", c.ID(), err)
+	}
+
+	return nil
+}
+
+// startSchedulerTimer spawns the scheduler recorded in the state of the
+// container.  The scheduler is a `podman healthcheck scheduler` process in
+// its own session, so it outlives the process starting the container.
+func (c *Container) startSchedulerTimer(isStartup bool) error {
+	if c.disableHealthCheckScheduler(isStartup) || c.state.HCUnitName == "" {
+		return nil
+	}
+	return c.spawnScheduler(c.state.HCUnitName, false)
+}
+
+// spawnScheduler spawns the scheduler with the specified name, running either
+// the healthchecks or the readiness check of the container.
+func (c *Container) spawnScheduler(name string, readiness bool) error {
+	args := []string{"healthcheck", "scheduler", "--name", name}
+	if readiness {
+		args = append(args, "--readiness")
+	}
+	args = append(args, c.ID())
+	// The scheduler is not waited for; it exits on its own once the
+	// container stops.
+	return c.runtime.startDetachedPodman("healthcheck scheduler for container "+c.ID(), args...)
+}
+
+// removeSchedulerFiles stops the scheduler with the specified name.
+func (c *Container) removeSchedulerFiles(name string) error {
+	pidFile := c.hcSchedulerPidFile(name)
+	content, err := os.ReadFile(pidFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("reading healthcheck scheduler PID file: %w", err)
+	}
+	if err := os.Remove(pidFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing healthcheck scheduler PID file: %w", err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return fmt.Errorf("parsing healthcheck scheduler PID file %s: %w", pidFile, err)
+	}
+	// The scheduler itself may replace its timer when the startup
+	// healthcheck passed.  It notices on its own and exits.
+	if pid == os.Getpid() {
+		return nil
+	}
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("stopping healthcheck scheduler %s: %w", name, err)
+	}
+	return nil
+}
+
+// hcSchedulerInterval returns the interval to run the next healthcheck with.
+// If the scheduler with the specified name should no longer run the
+// healthchecks of the container, false is returned.
+func (c *Container) hcSchedulerInterval(name string) (time.Duration, bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return 0, false, err
+	}
+
+	if c.state.HCUnitName != name || c.state.State != define.ContainerStateRunning || !c.HasHealthCheck() {
+		return 0, false, nil
+	}
+	if c.config.StartupHealthCheckConfig != nil && !c.state.StartupHCPassed {
+		return c.config.StartupHealthCheckConfig.Interval, true, nil
+	}
+	return c.config.HealthCheckConfig.Interval, true, nil
+}
+
+// readinessSchedulerInterval returns the interval to run the next readiness
+// check with.  If the scheduler with the specified name should no longer run
+// the readiness check of the container, false is returned.
+func (c *Container) readinessSchedulerInterval(name string) (time.Duration, bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return 0, false, err
+	}
+
+	if c.state.ReadinessUnitName != name || c.state.State != define.ContainerStateRunning || !c.HasReadinessCheck() {
+		return 0, false, nil
+	}
+	return c.config.ReadinessCheckConfig.Interval, true, nil
+}
+
+// hcSchedulerJitter returns a random delay of up to a tenth of the interval
+// to avoid the healthchecks of many containers running in lockstep.
+func hcSchedulerJitter(interval time.Duration) time.Duration {
+	if interval/10 <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(interval / 10)))
+}
+
+// HealthCheckScheduler runs the healthchecks of the specified container at
+// the configured intervals until the container stops or the scheduler with
+// the specified name is replaced by another one.  The results are recorded
+// as with `podman healthcheck run`, so on-failure actions and the transition
+// from startup to regular healthchecks are handled the same way.  If
+// readiness is set, the readiness check is run instead.
+func (r *Runtime) HealthCheckScheduler(ctx context.Context, nameOrID, name string, readiness bool) error {
+	ctr, err := r.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	schedulerInterval := ctr.hcSchedulerInterval
+	check := func() (string, error) {
+		status, err := r.HealthCheck(ctx, ctr.ID())
+		return status.String(), err
+	}
+	if readiness {
+		schedulerInterval = ctr.readinessSchedulerInterval
+		check = func() (string, error) {
+			ready, _, err := r.ReadinessCheck(ctx, ctr.ID())
+			return readinessStatus(ready), err
+		}
+	}
+
+	pidFile := ctr.hcSchedulerPidFile(name)
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), 0o644); err != nil {
+		return fmt.Errorf("writing healthcheck scheduler PID file: %w", err)
+	}
+	defer func() {
+		if err := os.Remove(pidFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logrus.Errorf("Removing healthcheck scheduler PID file: %v", err)
+		}
+	}()
+
+	// The first healthcheck runs right away, as it does with systemd
+	// timers, but jitter is applied to it as well.
+	var delay time.Duration
+	for {
+		interval, active, err := schedulerInterval(name)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				return nil
+			}
+			return err
+		}
+		if !active {
+			logrus.Debugf("Healthcheck scheduler %s of container %s exiting", name, ctr.ID())
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay + hcSchedulerJitter(interval)):
+		}
+		delay = interval
+
+		// Make sure the scheduler has not been replaced while
+		// sleeping.
+		if _, active, err := schedulerInterval(name); err != nil || !active {
+			continue
+		}
+		status, err := check()
+		if err != nil {
+			logrus.Debugf("Scheduler %s of container %s: %v", name, ctr.ID(), err)
+			continue
+		}
+		logrus.Debugf("Scheduler %s of container %s: %s", name, ctr.ID(), status)
+	}
+}
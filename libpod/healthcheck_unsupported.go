@@ -4,6 +4,8 @@ package libpod
 
 import (
 	"context"
+
+	"github.com/containers/podman/v5/libpod/define"
 )
 
 // createTimer systemd timers for healthchecks of a container
@@ -21,3 +23,28 @@ func (c *Container) startTimer(isStartup bool) error {
 func (c *Container) removeTransientFiles(ctx context.Context, isStartup bool, unitName string) error {
 	return nil
 }
+
+// createReadinessTimer prepares the periodic execution of the readiness check
+func (c *Container) createReadinessTimer() error {
+	return nil
+}
+
+// startReadinessTimer starts the periodic execution of the readiness check
+func (c *Container) startReadinessTimer() error {
+	return nil
+}
+
+// removeReadinessTimer stops the periodic execution of the readiness check
+// and marks the container as not ready
+func (c *Container) removeReadinessTimer(ctx context.Context) error {
+	c.state.ReadinessUnitName = ""
+	c.state.Ready = false
+	c.state.ReadinessSuccessCount = 0
+	c.state.ReadinessFailureCount = 0
+	return nil
+}
+
+// HealthCheckScheduler runs the healthchecks of the specified container
+func (r *Runtime) HealthCheckScheduler(ctx context.Context, nameOrID, name string, readiness bool) error {
+	return define.ErrOSNotSupported
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net"
+	"net/url"
 	"os"
 	"reflect"
 	"slices"
@@ -17,9 +19,11 @@ import (
 
 	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/config"
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/env"
+	v1apps "github.com/containers/podman/v5/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/api/resource"
 	v12 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -237,7 +241,7 @@ func GenerateForKubeDeployment(ctx context.Context, pod *YAMLPod, options entiti
 // kind YAML.
 func GenerateForKubeJob(ctx context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLJob, error) {
 	// Restart policy for Job cannot be set to Always
-	if options.Type == define.K8sKindJob && pod.Spec.RestartPolicy == v1.RestartPolicyAlways {
+	if (options.Type == define.K8sKindJob || options.Type == define.K8sKindCronJob) && pod.Spec.RestartPolicy == v1.RestartPolicyAlways {
 		return nil, fmt.Errorf("k8s Jobs can not have restartPolicy set to Always; only Never and OnFailure policies allowed")
 	}
 
@@ -288,6 +292,138 @@ func GenerateForKubeJob(ctx context.Context, pod *YAMLPod, options entities.Gene
 	return &job, nil
 }
 
+// GenerateForKubeStatefulSet returns a YAMLStatefulSet from a YAMLPod that is then used to create a kubernetes StatefulSet
+// kind YAML.
+func GenerateForKubeStatefulSet(ctx context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLStatefulSet, error) {
+	// Restart policy for StatefulSets can only be set to Always
+	if pod.Spec.RestartPolicy != "" && pod.Spec.RestartPolicy != v1.RestartPolicyAlways {
+		return nil, fmt.Errorf("k8s StatefulSets can only have restartPolicy set to Always")
+	}
+
+	// Create label map that will be added to podSpec and StatefulSet metadata
+	// The matching label lets the statefulset know which pods to manage
+	appKey := "app"
+	matchLabels := map[string]string{appKey: pod.Name}
+	// Add the key:value (app:pod-name) to the podSpec labels
+	if pod.Labels == nil {
+		pod.Labels = matchLabels
+	} else {
+		pod.Labels[appKey] = pod.Name
+	}
+
+	// The named volumes of the pod are claimed per ordinal, the ordinals
+	// must not share them.
+	podSpec := *pod.Spec
+	podSpec.Volumes = nil
+	var claimTemplates []v1.PersistentVolumeClaim
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			podSpec.Volumes = append(podSpec.Volumes, volume)
+			continue
+		}
+		claimTemplates = append(claimTemplates, v1.PersistentVolumeClaim{
+			ObjectMeta: v12.ObjectMeta{
+				Name: volume.Name,
+			},
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.ResourceRequirements{
+					Requests: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+				AccessModes: []v1.PersistentVolumeAccessMode{
+					v1.ReadWriteOnce,
+				},
+			},
+		})
+	}
+
+	setSpec := YAMLStatefulSetSpec{
+		StatefulSetSpec: v1apps.StatefulSetSpec{
+			Selector: &v12.LabelSelector{
+				MatchLabels: matchLabels,
+			},
+			// The service generated with --service is named after the pod.
+			ServiceName:          pod.Name,
+			VolumeClaimTemplates: claimTemplates,
+		},
+		Template: &YAMLPodTemplateSpec{
+			PodTemplateSpec: v1.PodTemplateSpec{
+				ObjectMeta: pod.ObjectMeta,
+			},
+			Spec: &podSpec,
+		},
+	}
+
+	// Add replicas count if user adds replica number with --replicas flag and is greater than 1
+	if options.Replicas > 1 {
+		setSpec.Replicas = &options.Replicas
+	}
+
+	// Create the StatefulSet object
+	set := YAMLStatefulSet{
+		StatefulSet: v1apps.StatefulSet{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-statefulset",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "StatefulSet",
+				APIVersion: "apps/v1",
+			},
+		},
+		Spec: &setSpec,
+	}
+
+	return &set, nil
+}
+
+// GenerateForKubeCronJob returns a YAMLCronJob from a YAMLPod that is then used to create a kubernetes CronJob
+// kind YAML.
+func GenerateForKubeCronJob(ctx context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLCronJob, error) {
+	if options.Schedule == "" {
+		return nil, fmt.Errorf("k8s CronJobs require a schedule, use --schedule to set it")
+	}
+
+	job, err := GenerateForKubeJob(ctx, pod, options)
+	if err != nil {
+		return nil, err
+	}
+
+	cronJobSpec := YAMLCronJobSpec{
+		CronJobSpec: v1.CronJobSpec{
+			Schedule: options.Schedule,
+		},
+		JobTemplate: &YAMLJobTemplateSpec{
+			JobTemplateSpec: v1.JobTemplateSpec{
+				ObjectMeta: v12.ObjectMeta{
+					Labels: pod.Labels,
+				},
+			},
+			Spec: job.Spec,
+		},
+	}
+
+	// Create the CronJob object
+	cronJob := YAMLCronJob{
+		CronJob: v1.CronJob{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-cronjob",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "CronJob",
+				APIVersion: "batch/v1",
+			},
+		},
+		Spec: &cronJobSpec,
+	}
+
+	return &cronJob, nil
+}
+
 // GenerateForKube generates a v1.PersistentVolumeClaim from a libpod volume.
 func (v *Volume) GenerateForKube() *v1.PersistentVolumeClaim {
 	annotations := make(map[string]string)
@@ -392,6 +528,31 @@ type YAMLJobSpec struct {
 	Template *YAMLPodTemplateSpec `json:"template,omitempty"`
 }
 
+// YAMLStatefulSetSpec represents the same k8s API apps StatefulSetSpec with a small
+// change and that is having Template as a pointer to YAMLPodTemplateSpec and UpdateStrategy
+// as a pointer to k8s API apps StatefulSetUpdateStrategy.
+// Because Go doesn't omit empty struct and we want to omit UpdateStrategy and any fields in the Pod YAML
+// if it's empty.
+type YAMLStatefulSetSpec struct {
+	v1apps.StatefulSetSpec
+	Template       *YAMLPodTemplateSpec              `json:"template,omitempty"`
+	UpdateStrategy *v1apps.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// YAMLJobTemplateSpec represents the same k8s API core JobTemplateSpec with a small
+// change and that is having Spec as a pointer to YAMLJobSpec.
+type YAMLJobTemplateSpec struct {
+	v1.JobTemplateSpec
+	Spec *YAMLJobSpec `json:"spec,omitempty"`
+}
+
+// YAMLCronJobSpec represents the same k8s API core CronJobSpec with a small
+// change and that is having JobTemplate as a pointer to YAMLJobTemplateSpec.
+type YAMLCronJobSpec struct {
+	v1.CronJobSpec
+	JobTemplate *YAMLJobTemplateSpec `json:"jobTemplate,omitempty"`
+}
+
 // YAMLDaemonSet represents the same k8s API core DaemonSet with a small change
 // and that is having Spec as a pointer to YAMLDaemonSetSpec and Status as a pointer to
 // k8s API core DaemonSetStatus.
@@ -420,6 +581,26 @@ type YAMLJob struct {
 	Status *v1.JobStatus `json:"status,omitempty"`
 }
 
+// YAMLStatefulSet represents the same k8s API apps StatefulSet with a small change
+// and that is having Spec as a pointer to YAMLStatefulSetSpec and Status as a pointer to
+// k8s API apps StatefulSetStatus.
+// Because Go doesn't omit empty struct and we want to omit Status and any fields in the StatefulSetSpec
+// if it's empty.
+type YAMLStatefulSet struct {
+	v1apps.StatefulSet
+	Spec   *YAMLStatefulSetSpec      `json:"spec,omitempty"`
+	Status *v1apps.StatefulSetStatus `json:"status,omitempty"`
+}
+
+// YAMLCronJob represents the same k8s API core CronJob with a small change
+// and that is having Spec as a pointer to YAMLCronJobSpec and Status as a pointer to
+// k8s API core CronJobStatus.
+type YAMLCronJob struct {
+	v1.CronJob
+	Spec   *YAMLCronJobSpec  `json:"spec,omitempty"`
+	Status *v1.CronJobStatus `json:"status,omitempty"`
+}
+
 // YAMLService represents the same k8s API core Service struct with a small
 // change and that is having Status as a pointer to k8s API core ServiceStatus.
 // Because Go doesn't omit empty struct and we want to omit Status in YAML
@@ -1009,6 +1190,24 @@ func containerToV1Container(ctx context.Context, c *Container, getService bool)
 	kubeContainer.StdinOnce = false
 	kubeContainer.TTY = c.Terminal()
 
+	// Healthchecks that are probes translate to Kubernetes probes without
+	// loss, so they are preserved by `podman kube play`.
+	if probe := healthCheckToKubeProbe(c.config.HealthCheckConfig); probe != nil {
+		kubeContainer.LivenessProbe = probe
+	}
+	if startup := c.config.StartupHealthCheckConfig; startup != nil {
+		if probe := healthCheckToKubeProbe(&startup.Schema2HealthConfig); probe != nil {
+			probe.SuccessThreshold = int32(startup.Successes)
+			kubeContainer.StartupProbe = probe
+		}
+	}
+	if readiness := c.config.ReadinessCheckConfig; readiness != nil {
+		if probe := healthCheckToKubeProbe(&readiness.Schema2HealthConfig); probe != nil {
+			probe.SuccessThreshold = int32(readiness.Successes)
+			kubeContainer.ReadinessProbe = probe
+		}
+	}
+
 	resources := c.LinuxResources()
 	if resources != nil {
 		if resources.Memory != nil &&
@@ -1475,3 +1674,75 @@ func getAutoUpdateAnnotations(ctrName string, ctrLabels map[string]string) map[s
 
 	return annotations
 }
+
+// healthCheckToKubeProbe converts an HTTP, TCP or gRPC healthcheck to the
+// equivalent Kubernetes probe.  Nil is returned for any other healthcheck.
+func healthCheckToKubeProbe(hc *manifest.Schema2HealthConfig) *v1.Probe {
+	if hc == nil || !define.IsHealthCheckProbe(hc.Test) || define.ValidateHealthCheckProbe(hc.Test) != nil {
+		return nil
+	}
+
+	probe := v1.Probe{
+		InitialDelaySeconds: int32(hc.StartPeriod.Seconds()),
+		TimeoutSeconds:      int32(hc.Timeout.Seconds()),
+		PeriodSeconds:       int32(hc.Interval.Seconds()),
+		FailureThreshold:    int32(hc.Retries),
+	}
+	switch hc.Test[0] {
+	case define.HealthConfigTestHTTP:
+		// The URL has been validated already.
+		u, _ := url.Parse(hc.Test[1])
+		port := u.Port()
+		if port == "" {
+			port = "80"
+			if u.Scheme == "https" {
+				port = "443"
+			}
+		}
+		portNum, err := strconv.Atoi(port)
+		if err != nil {
+			return nil
+		}
+		action := v1.HTTPGetAction{
+			Path:   u.RequestURI(),
+			Port:   intstr.FromInt(portNum),
+			Scheme: v1.URIScheme(u.Scheme),
+		}
+		if host := u.Hostname(); host != "localhost" {
+			action.Host = host
+		}
+		for _, header := range hc.Test[2:] {
+			name, value, _ := strings.Cut(header, ":")
+			action.HTTPHeaders = append(action.HTTPHeaders, v1.HTTPHeader{
+				Name:  strings.TrimSpace(name),
+				Value: strings.TrimSpace(value),
+			})
+		}
+		probe.HTTPGet = &action
+	case define.HealthConfigTestTCP:
+		host, port, _ := net.SplitHostPort(hc.Test[1])
+		portNum, err := strconv.Atoi(port)
+		if err != nil {
+			return nil
+		}
+		action := v1.TCPSocketAction{Port: intstr.FromInt(portNum)}
+		if host != "localhost" {
+			action.Host = host
+		}
+		probe.TCPSocket = &action
+	case define.HealthConfigTestGRPC:
+		// Kubernetes always probes gRPC services on the pod IP, so the
+		// host cannot be preserved.
+		_, port, _ := net.SplitHostPort(hc.Test[1])
+		portNum, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return nil
+		}
+		action := v1.GRPCAction{Port: int32(portNum)}
+		if len(hc.Test) > 2 {
+			action.Service = &hc.Test[2]
+		}
+		probe.GRPC = &action
+	}
+	return &probe
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"iter"
 	"os"
 	"strings"
 	"sync"
@@ -57,22 +59,37 @@ type LogLine struct {
 	ColorID      int64
 }
 
-// GetLogFile returns an hp tail for a container given options
-func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error) {
+// GetLogFile returns an hp tail for a container given options, along with
+// the lines of the log to send before the ones of the tail, the oldest
+// first.  The lines of rotated log files are only read while iterating over
+// them, so the whole log is never held in memory.
+func GetLogFile(path string, options *LogOptions) (*tail.Tail, iter.Seq2[*LogLine, error], error) {
 	var (
 		whence  int
 		err     error
-		logTail []*LogLine
+		logTail iter.Seq2[*LogLine, error]
 	)
 	// whence 0=origin, 2=end
 	if options.Tail >= 0 {
 		whence = 2
 	}
-	if options.Tail > 0 {
-		logTail, err = getTailLog(path, int(options.Tail))
+	switch {
+	case options.Tail > 0:
+		lines, err := getTailLog(path, int(options.Tail))
 		if err != nil {
 			return nil, nil, err
 		}
+		lines, err = prependRotatedTailLog(path, int(options.Tail), lines)
+		if err != nil {
+			return nil, nil, err
+		}
+		logTail = logLineSeq(lines)
+	case options.Tail < 0:
+		// The whole log is read, starting with the oldest rotated
+		// file.
+		logTail = rotatedLogLines(path)
+	default:
+		logTail = logLineSeq(nil)
 	}
 	seek := tail.SeekInfo{
 		Offset: 0,
@@ -83,6 +100,67 @@ func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error
 	return t, logTail, err
 }
 
+// logLineSeq returns a sequence of the specified lines.
+func logLineSeq(lines []*LogLine) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		for _, line := range lines {
+			if !yield(line, nil) {
+				return
+			}
+		}
+	}
+}
+
+// rotatedLogLines returns a sequence of the lines of the rotated files of
+// the log file, the oldest ones first.  The files are read one line at a
+// time.
+func rotatedLogLines(path string) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		files := rotatedLogFiles(path)
+		for i := len(files) - 1; i >= 0; i-- {
+			stopped := false
+			err := readRotatedLogFile(files[i], func(line *LogLine) bool {
+				stopped = !yield(line, nil)
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				yield(nil, err)
+				return
+			}
+		}
+	}
+}
+
+// prependRotatedTailLog prepends lines of the rotated files of the log file
+// to the last lines of the log file until there are tail lines.
+// Uncompressed files are read in reverse, compressed ones from the start,
+// keeping only the lines needed.
+func prependRotatedTailLog(path string, tail int, logTail []*LogLine) ([]*LogLine, error) {
+	for _, file := range rotatedLogFiles(path) {
+		missing := tail - countLogLines(logTail)
+		if missing <= 0 {
+			break
+		}
+		var (
+			lines []*LogLine
+			err   error
+		)
+		if !isCompressedLogFile(file) {
+			lines, err = getTailLog(file, missing)
+		} else {
+			lines, err = tailRotatedLogFile(file, missing)
+		}
+		if err != nil {
+			return nil, err
+		}
+		logTail = append(lines, logTail...)
+	}
+	return logTail, nil
+}
+
 func getTailLog(path string, tail int) ([]*LogLine, error) {
 	var (
 		nllCounter int
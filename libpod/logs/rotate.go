@@ -0,0 +1,320 @@
+package logs
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// MaxFileOption is the log option setting the number of log files
+	// to keep, including the current one.
+	MaxFileOption = "max-file"
+	// CompressOption is the log option enabling the compression of
+	// rotated log files.
+	CompressOption = "compress"
+
+	// CompressionGzip compresses rotated log files with gzip.
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses rotated log files with zstd.
+	CompressionZstd = "zstd"
+
+	// reopenTimeout is the time to wait for the log file to be reopened
+	// after it has been rotated.
+	reopenTimeout = 5 * time.Second
+)
+
+// rotatedLogExtensions are the extensions of rotated log files.
+// Uncompressed files come first, so they are preferred while being
+// compressed.
+var rotatedLogExtensions = []string{"", ".gz", ".zst"}
+
+// RotationOptions describes how a log file is rotated.
+type RotationOptions struct {
+	// MaxFile is the number of log files to keep, including the current
+	// one.  The log file is truncated rather than rotated when it is 1.
+	MaxFile int
+	// MaxSize is the size at which the log file is rotated.
+	MaxSize int64
+	// Compress is the compression of rotated log files, empty if they
+	// are not compressed.
+	Compress string
+}
+
+// ParseRotationOptions parses the rotation options out of the log options of
+// a container with the specified maximum log size.  Options unrelated to
+// rotation are ignored.
+func ParseRotationOptions(options map[string]string, maxSize int64) (*RotationOptions, error) {
+	opts := &RotationOptions{MaxFile: 1, MaxSize: maxSize}
+	if value, ok := options[MaxFileOption]; ok {
+		maxFile, err := strconv.Atoi(value)
+		if err != nil || maxFile < 1 {
+			return nil, fmt.Errorf("invalid %s %q: must be a positive integer", MaxFileOption, value)
+		}
+		opts.MaxFile = maxFile
+	}
+	if value, ok := options[CompressOption]; ok {
+		switch value {
+		case "true", CompressionGzip:
+			opts.Compress = CompressionGzip
+		case CompressionZstd:
+			opts.Compress = CompressionZstd
+		case "false":
+		default:
+			return nil, fmt.Errorf("invalid %s %q: must be true, false, %s or %s", CompressOption, value, CompressionGzip, CompressionZstd)
+		}
+	}
+	if opts.MaxFile > 1 && opts.MaxSize <= 0 {
+		return nil, fmt.Errorf("%s requires a maximum log size", MaxFileOption)
+	}
+	if opts.Compress != "" && opts.MaxFile < 2 {
+		return nil, fmt.Errorf("%s requires %s to be greater than 1", CompressOption, MaxFileOption)
+	}
+	return opts, nil
+}
+
+// Enabled returns true if the log file is rotated rather than truncated.
+func (o *RotationOptions) Enabled() bool {
+	return o.MaxFile > 1
+}
+
+// rotatedLogFile returns the rotated log file with the specified index, or
+// an empty string if there is none.
+func rotatedLogFile(path string, index int) string {
+	for _, extension := range rotatedLogExtensions {
+		file := fmt.Sprintf("%s.%d%s", path, index, extension)
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+	}
+	return ""
+}
+
+// rotatedLogFiles returns the rotated files of the log file, the most recent
+// one first.
+func rotatedLogFiles(path string) []string {
+	var files []string
+	for i := 1; ; i++ {
+		file := rotatedLogFile(path, i)
+		if file == "" {
+			return files
+		}
+		files = append(files, file)
+	}
+}
+
+// RotateLogFile moves the log file to the first rotated file and shifts the
+// other rotated files, dropping the oldest one.  reopen is called to make
+// the writer of the log file reopen it.  The new rotated file is compressed
+// afterwards if configured.
+func RotateLogFile(path string, opts *RotationOptions, reopen func() error) error {
+	for _, extension := range rotatedLogExtensions {
+		oldest := fmt.Sprintf("%s.%d%s", path, opts.MaxFile-1, extension)
+		if err := os.Remove(oldest); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("removing rotated log file: %w", err)
+		}
+	}
+	for i := opts.MaxFile - 2; i >= 1; i-- {
+		for _, extension := range rotatedLogExtensions {
+			from := fmt.Sprintf("%s.%d%s", path, i, extension)
+			to := fmt.Sprintf("%s.%d%s", path, i+1, extension)
+			if err := os.Rename(from, to); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("rotating log file: %w", err)
+			}
+		}
+	}
+
+	rotated := path + ".1"
+	if err := os.Rename(path, rotated); err != nil {
+		return fmt.Errorf("rotating log file: %w", err)
+	}
+	// Create the new log file right away, so readers never miss it.
+	// The writer replaces it when reopening the log file.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return fmt.Errorf("creating log file: %w", err)
+	}
+	info, err := f.Stat()
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if err := reopen(); err != nil {
+		return fmt.Errorf("reopening log file: %w", err)
+	}
+
+	if opts.Compress == "" {
+		return nil
+	}
+	// Lines are written to the rotated file until the writer reopened
+	// the log file, so wait for it before compressing.
+	for deadline := time.Now().Add(reopenTimeout); time.Now().Before(deadline); {
+		if current, err := os.Stat(path); err != nil || !os.SameFile(info, current) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return compressLogFile(rotated, opts.Compress)
+}
+
+// compressLogFile replaces the log file by a compressed copy.
+func compressLogFile(path, compression string) (retErr error) {
+	extension := ".gz"
+	if compression == CompressionZstd {
+		extension = ".zst"
+	}
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmpPath := path + extension + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		dst.Close()
+		if retErr != nil {
+			os.Remove(tmpPath)
+		}
+	}()
+
+	var w io.WriteCloser
+	if compression == CompressionZstd {
+		if w, err = zstd.NewWriter(dst); err != nil {
+			return err
+		}
+	} else {
+		w = gzip.NewWriter(dst)
+	}
+	if _, err := io.Copy(w, src); err != nil {
+		w.Close()
+		return fmt.Errorf("compressing log file %s: %w", path, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("compressing log file %s: %w", path, err)
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path+extension); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// isCompressedLogFile returns true if the rotated log file is compressed.
+func isCompressedLogFile(path string) bool {
+	return strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".zst")
+}
+
+// readRotatedLogFile calls fn with each line of a rotated log file,
+// decompressing it if needed, until fn returns false.
+func readRotatedLogFile(path string, fn func(*LogLine) bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("decompressing log file %s: %w", path, err)
+		}
+		defer gz.Close()
+		r = gz
+	case strings.HasSuffix(path, ".zst"):
+		zr, err := zstd.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("decompressing log file %s: %w", path, err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			continue
+		}
+		nll, err := NewLogLine(scanner.Text())
+		if err != nil {
+			return err
+		}
+		if !fn(nll) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading log file %s: %w", path, err)
+	}
+	return nil
+}
+
+// tailRotatedLogFile returns the lines making up the last n lines of a
+// rotated log file.  Only the lines needed are kept while reading it.
+func tailRotatedLogFile(path string, n int) ([]*LogLine, error) {
+	var lines []*LogLine
+	full := 0
+	err := readRotatedLogFile(path, func(line *LogLine) bool {
+		lines = append(lines, line)
+		if line.Partial() {
+			return true
+		}
+		full++
+		// Keep one full line more than needed, so trailing partial
+		// lines are counted as tailLogLines does.
+		for full > n+1 {
+			i := slices.IndexFunc(lines, func(l *LogLine) bool { return !l.Partial() })
+			lines = lines[i+1:]
+			full--
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tailLogLines(lines, n), nil
+}
+
+// tailLogLines returns the lines making up the last n lines of the log.  A
+// line consists of partial lines followed by a full one.
+func tailLogLines(lines []*LogLine, n int) []*LogLine {
+	count := 0
+	for i := len(lines) - 1; i >= 0; i-- {
+		if !lines[i].Partial() || i == len(lines)-1 {
+			count++
+		}
+		if count > n {
+			return lines[i+1:]
+		}
+	}
+	return lines
+}
+
+// countLogLines returns the number of lines of the log.
+func countLogLines(lines []*LogLine) int {
+	count := 0
+	for i, line := range lines {
+		if !line.Partial() || i == len(lines)-1 {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,144 @@
+package logs
+
+import (
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRotationOptions(t *testing.T) {
+	opts, err := ParseRotationOptions(map[string]string{"tag": "web"}, -1)
+	require.NoError(t, err)
+	assert.Equal(t, &RotationOptions{MaxFile: 1, MaxSize: -1}, opts)
+	assert.False(t, opts.Enabled())
+
+	opts, err = ParseRotationOptions(map[string]string{MaxFileOption: "3", CompressOption: "true"}, 1024)
+	require.NoError(t, err)
+	assert.Equal(t, &RotationOptions{MaxFile: 3, MaxSize: 1024, Compress: CompressionGzip}, opts)
+	assert.True(t, opts.Enabled())
+
+	opts, err = ParseRotationOptions(map[string]string{MaxFileOption: "2", CompressOption: "zstd"}, 1024)
+	require.NoError(t, err)
+	assert.Equal(t, CompressionZstd, opts.Compress)
+
+	for _, options := range []map[string]string{
+		{MaxFileOption: "0"},
+		{MaxFileOption: "many"},
+		{MaxFileOption: "3", CompressOption: "xz"},
+		{CompressOption: "true"},
+	} {
+		_, err := ParseRotationOptions(options, 1024)
+		assert.Error(t, err, options)
+	}
+	_, err = ParseRotationOptions(map[string]string{MaxFileOption: "3"}, 0)
+	assert.ErrorContains(t, err, "max-file requires a maximum log size")
+}
+
+// writeTestLog appends the lines to the log file.
+func writeTestLog(t *testing.T, path string, lines ...string) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	require.NoError(t, err)
+	defer f.Close()
+	for _, line := range lines {
+		_, err := fmt.Fprintf(f, "2023-08-07T19:56:34.223758260-06:00 stdout %s\n", line)
+		require.NoError(t, err)
+	}
+}
+
+// reopenTestLog replaces the log file as conmon does when reopening it.
+func reopenTestLog(path string) func() error {
+	return func() error {
+		if err := os.WriteFile(path+".tmp", nil, 0640); err != nil {
+			return err
+		}
+		return os.Rename(path+".tmp", path)
+	}
+}
+
+func TestRotateLogFile(t *testing.T) {
+	for _, compress := range []string{"", CompressionGzip, CompressionZstd} {
+		t.Run("compress="+compress, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ctr.log")
+			opts := &RotationOptions{MaxFile: 3, MaxSize: 1, Compress: compress}
+
+			for i := 1; i <= 4; i++ {
+				writeTestLog(t, path, fmt.Sprintf("F line%d", i))
+				require.NoError(t, RotateLogFile(path, opts, reopenTestLog(path)))
+			}
+			writeTestLog(t, path, "F 5", "P li", "F ne6")
+
+			files := rotatedLogFiles(path)
+			require.Len(t, files, 2, "only max-file - 1 rotated files are kept")
+			extension := map[string]string{"": "", CompressionGzip: ".gz", CompressionZstd: ".zst"}[compress]
+			assert.Equal(t, []string{path + ".1" + extension, path + ".2" + extension}, files)
+
+			lines, err := tailRotatedLogFile(files[0], 10)
+			require.NoError(t, err)
+			assert.Equal(t, []*LogLine{makeTestLogLine("F", "line4")}, lines)
+
+			// The whole log starts with the oldest rotated file.
+			_, logTail, err := GetLogFile(path, &LogOptions{Tail: -1})
+			require.NoError(t, err)
+			assert.Equal(t, []*LogLine{makeTestLogLine("F", "line3"), makeTestLogLine("F", "line4")}, collectLogLines(t, logTail))
+
+			// The tail spans the rotated files as needed.
+			tests := []struct {
+				tail int64
+				want []*LogLine
+			}{
+				{1, []*LogLine{makeTestLogLine("P", "li"), makeTestLogLine("F", "ne6")}},
+				{3, []*LogLine{makeTestLogLine("F", "line4"), makeTestLogLine("F", "5"), makeTestLogLine("P", "li"), makeTestLogLine("F", "ne6")}},
+				{10, []*LogLine{makeTestLogLine("F", "line3"), makeTestLogLine("F", "line4"), makeTestLogLine("F", "5"), makeTestLogLine("P", "li"), makeTestLogLine("F", "ne6")}},
+			}
+			for _, tt := range tests {
+				_, logTail, err := GetLogFile(path, &LogOptions{Tail: tt.tail})
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, collectLogLines(t, logTail), "tail %d", tt.tail)
+			}
+		})
+	}
+}
+
+func collectLogLines(t *testing.T, seq iter.Seq2[*LogLine, error]) []*LogLine {
+	var lines []*LogLine
+	for line, err := range seq {
+		require.NoError(t, err)
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestTailRotatedLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ctr.log.1")
+	writeTestLog(t, path, "F line1", "P lin", "F e2", "F line3", "P line4")
+	require.NoError(t, compressLogFile(path, CompressionGzip))
+
+	for n := 1; n <= 5; n++ {
+		lines, err := tailRotatedLogFile(path+".gz", n)
+		require.NoError(t, err)
+		var all []*LogLine
+		require.NoError(t, readRotatedLogFile(path+".gz", func(line *LogLine) bool {
+			all = append(all, line)
+			return true
+		}))
+		assert.Equal(t, tailLogLines(all, n), lines, "tail %d", n)
+	}
+}
+
+func TestTailLogLines(t *testing.T) {
+	lines := []*LogLine{
+		makeTestLogLine("F", "line1"),
+		makeTestLogLine("P", "lin"),
+		makeTestLogLine("F", "e2"),
+		makeTestLogLine("P", "line3"),
+	}
+	assert.Equal(t, 3, countLogLines(lines))
+	assert.Equal(t, lines[3:], tailLogLines(lines, 1))
+	assert.Equal(t, lines[1:], tailLogLines(lines, 2))
+	assert.Equal(t, lines, tailLogLines(lines, 5))
+}
@@ -0,0 +1,181 @@
+package logs
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SyslogAddressOption is the log option setting the address of the
+	// syslog server.
+	SyslogAddressOption = "syslog-address"
+	// SyslogFacilityOption is the log option setting the facility of
+	// the messages.
+	SyslogFacilityOption = "syslog-facility"
+	// SyslogFormatOption is the log option setting the format of the
+	// messages.
+	SyslogFormatOption = "syslog-format"
+
+	// SyslogFormatRFC3164 is the BSD syslog format.
+	SyslogFormatRFC3164 = "rfc3164"
+	// SyslogFormatRFC5424 is the IETF syslog format.
+	SyslogFormatRFC5424 = "rfc5424"
+
+	// DefaultSyslogAddress is the local syslog socket.
+	DefaultSyslogAddress = "unixgram:///dev/log"
+	// DefaultSyslogFacility is the facility of the messages unless
+	// configured otherwise.
+	DefaultSyslogFacility = "daemon"
+
+	// Severities of the messages of STDOUT and STDERR.
+	syslogSeverityErr  = 3
+	syslogSeverityInfo = 6
+
+	// syslogDialTimeout is the time to wait for connecting to the
+	// syslog server.
+	syslogDialTimeout = 5 * time.Second
+)
+
+// syslogFacilities maps the names of the syslog facilities to their codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SyslogOptions describes where and how container logs are sent to syslog.
+type SyslogOptions struct {
+	// Network to connect to the syslog server with: unix, unixgram, tcp
+	// or udp.
+	Network string
+	// Address of the syslog server, a socket path for unix networks.
+	Address string
+	// Facility code of the messages.
+	Facility int
+	// Format of the messages, rfc3164 or rfc5424.
+	Format string
+}
+
+// ParseSyslogOptions parses the syslog options out of the log options of a
+// container.  Options unrelated to syslog are ignored.
+func ParseSyslogOptions(options map[string]string) (*SyslogOptions, error) {
+	address := DefaultSyslogAddress
+	facility := DefaultSyslogFacility
+	opts := &SyslogOptions{Format: SyslogFormatRFC3164}
+	for key, value := range options {
+		switch key {
+		case SyslogAddressOption:
+			address = value
+		case SyslogFacilityOption:
+			facility = value
+		case SyslogFormatOption:
+			switch value {
+			case SyslogFormatRFC3164, SyslogFormatRFC5424:
+				opts.Format = value
+			default:
+				return nil, fmt.Errorf("invalid syslog format %q: must be %s or %s", value, SyslogFormatRFC3164, SyslogFormatRFC5424)
+			}
+		default:
+			if strings.HasPrefix(key, "syslog-") {
+				return nil, fmt.Errorf("unknown syslog log option %q", key)
+			}
+		}
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog address %q: %w", address, err)
+	}
+	opts.Network = u.Scheme
+	switch u.Scheme {
+	case "unix", "unixgram":
+		opts.Address = u.Path
+	case "tcp", "udp":
+		opts.Address = u.Host
+		if u.Port() == "" {
+			opts.Address = net.JoinHostPort(u.Host, "514")
+		}
+	default:
+		return nil, fmt.Errorf("invalid syslog address %q: protocol must be unix, unixgram, tcp or udp", address)
+	}
+	if opts.Address == "" {
+		return nil, fmt.Errorf("invalid syslog address %q: no socket path or host", address)
+	}
+
+	code, ok := syslogFacilities[facility]
+	if !ok {
+		code, err = strconv.Atoi(facility)
+		if err != nil || code < 0 || code > 23 {
+			return nil, fmt.Errorf("invalid syslog facility %q", facility)
+		}
+	}
+	opts.Facility = code
+
+	return opts, nil
+}
+
+// Dial connects to the syslog server.
+func (o *SyslogOptions) Dial() (net.Conn, error) {
+	return net.DialTimeout(o.Network, o.Address, syslogDialTimeout)
+}
+
+// Message formats the log line as a syslog message with the specified tag.
+// Messages sent over stream sockets are terminated by a newline.
+func (o *SyslogOptions) Message(line *LogLine, hostname, tag string) []byte {
+	severity := syslogSeverityInfo
+	if line.Device == "stderr" {
+		severity = syslogSeverityErr
+	}
+	priority := o.Facility*8 + severity
+
+	var msg string
+	if o.Format == SyslogFormatRFC5424 {
+		msg = fmt.Sprintf("<%d>1 %s %s %s - - - %s", priority, line.Time.Format("2006-01-02T15:04:05.000000Z07:00"), syslogHeaderField(hostname, 255), syslogHeaderField(tag, 48), line.Msg)
+	} else {
+		msg = fmt.Sprintf("<%d>%s %s %s: %s", priority, line.Time.Format(time.Stamp), hostname, tag, line.Msg)
+	}
+	if o.Network == "tcp" || o.Network == "unix" {
+		msg += "\n"
+	}
+	return []byte(msg)
+}
+
+// syslogHeaderField makes the value usable as a field of the header of
+// RFC 5424 messages, which must consist of at most size printable ASCII
+// characters.  An empty value is replaced by the NILVALUE.
+func syslogHeaderField(value string, size int) string {
+	if value == "" {
+		return "-"
+	}
+	field := []byte(value)
+	if len(field) > size {
+		field = field[:size]
+	}
+	for i, c := range field {
+		if c < 33 || c > 126 {
+			field[i] = '_'
+		}
+	}
+	return string(field)
+}
@@ -0,0 +1,74 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyslogOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options map[string]string
+		want    *SyslogOptions
+	}{
+		{
+			name:    "defaults",
+			options: map[string]string{"max-file": "3"},
+			want:    &SyslogOptions{Network: "unixgram", Address: "/dev/log", Facility: 3, Format: SyslogFormatRFC3164},
+		},
+		{
+			name: "unix stream socket",
+			options: map[string]string{
+				SyslogAddressOption:  "unix:///run/rsyslog.sock",
+				SyslogFacilityOption: "local3",
+				SyslogFormatOption:   SyslogFormatRFC5424,
+			},
+			want: &SyslogOptions{Network: "unix", Address: "/run/rsyslog.sock", Facility: 19, Format: SyslogFormatRFC5424},
+		},
+		{
+			name:    "udp with default port",
+			options: map[string]string{SyslogAddressOption: "udp://192.168.0.1", SyslogFacilityOption: "1"},
+			want:    &SyslogOptions{Network: "udp", Address: "192.168.0.1:514", Facility: 1, Format: SyslogFormatRFC3164},
+		},
+		{
+			name:    "tcp",
+			options: map[string]string{SyslogAddressOption: "tcp://[::1]:1514"},
+			want:    &SyslogOptions{Network: "tcp", Address: "[::1]:1514", Facility: 3, Format: SyslogFormatRFC3164},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSyslogOptions(tt.options)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, options := range []map[string]string{
+		{SyslogAddressOption: "http://localhost"},
+		{SyslogAddressOption: "unix://"},
+		{SyslogFacilityOption: "nope"},
+		{SyslogFacilityOption: "24"},
+		{SyslogFormatOption: "rfc5425"},
+		{"syslog-tls-cert": "/tmp/cert.pem"},
+	} {
+		_, err := ParseSyslogOptions(options)
+		assert.Error(t, err, options)
+	}
+}
+
+func TestSyslogMessage(t *testing.T) {
+	line := makeTestLogLine(FullLogType, "hello world")
+	errLine := makeTestLogLine(FullLogType, "oops")
+	errLine.Device = "stderr"
+
+	opts := &SyslogOptions{Network: "unixgram", Address: "/dev/log", Facility: 3, Format: SyslogFormatRFC3164}
+	assert.Equal(t, "<30>Aug  7 19:56:34 host web: hello world", string(opts.Message(line, "host", "web")))
+	assert.Equal(t, "<27>Aug  7 19:56:34 host web: oops", string(opts.Message(errLine, "host", "web")))
+
+	opts = &SyslogOptions{Network: "tcp", Address: "localhost:514", Facility: 16, Format: SyslogFormatRFC5424}
+	assert.Equal(t, "<134>1 2023-08-07T19:56:34.223758-06:00 host my_web - - - hello world\n", string(opts.Message(line, "host", "my web")))
+	assert.Equal(t, "<131>1 2023-08-07T19:56:34.223758-06:00 - web - - - oops\n", string(opts.Message(errLine, "", "web")))
+}
@@ -18,7 +18,6 @@ import (
 	"strings"
 	"sync"
 	"syscall"
-	"text/template"
 	"time"
 
 	"github.com/containers/common/pkg/config"
@@ -919,28 +918,6 @@ func waitPidStop(pid int, timeout time.Duration) error {
 	}
 }
 
-func (r *ConmonOCIRuntime) getLogTag(ctr *Container) (string, error) {
-	logTag := ctr.LogTag()
-	if logTag == "" {
-		return "", nil
-	}
-	data, err := ctr.inspectLocked(false)
-	if err != nil {
-		// FIXME: this error should probably be returned
-		return "", nil //nolint: nilerr
-	}
-	tmpl, err := template.New("container").Parse(logTag)
-	if err != nil {
-		return "", fmt.Errorf("template parsing error %s: %w", logTag, err)
-	}
-	var b bytes.Buffer
-	err = tmpl.Execute(&b, data)
-	if err != nil {
-		return "", err
-	}
-	return b.String(), nil
-}
-
 func getPreserveFdExtraFiles(preserveFD []uint, preserveFDs uint) (uint, []*os.File, []*os.File, error) {
 	var filesToClose []*os.File
 	var extraFiles []*os.File
@@ -996,7 +973,7 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 		ociLog = filepath.Join(ctr.state.RunDir, "oci-log")
 	}
 
-	logTag, err := r.getLogTag(ctr)
+	logTag, err := ctr.getLogTag()
 	if err != nil {
 		return 0, err
 	}
@@ -1331,6 +1308,10 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 		fallthrough
 	case define.JSONLogging:
 		fallthrough
+	case define.SyslogLogging:
+		// The k8s-file log is read by the syslog forwarder and by
+		// `podman logs`.
+		fallthrough
 	case define.KubernetesLogging:
 		logDriverArg = fmt.Sprintf("%s:%s", define.KubernetesLogging, logPath)
 	}
@@ -1346,6 +1327,21 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 	if ctr.config.LogSize > 0 {
 		size = ctr.config.LogSize
 	}
+	if size <= 0 && logDriver == define.SyslogLogging {
+		// Keep the local log of syslog containers from growing
+		// without bounds.
+		size = define.DefaultSyslogLocalLogSize
+	}
+	rotation, err := ctr.logRotation()
+	if err != nil {
+		return nil, err
+	}
+	if rotation.Enabled() {
+		// The log file is rotated by Podman at its maximum size.  Conmon
+		// only truncates it should the rotation fall behind, so that
+		// the log file stays bounded even without the rotator.
+		size = 2 * rotation.MaxSize
+	}
 	if size > 0 {
 		args = append(args, "--log-size-max", strconv.FormatInt(size, 10))
 	}
@@ -1132,7 +1132,7 @@ func WithLogDriver(driver string) CtrCreateOption {
 		switch driver {
 		case "":
 			return fmt.Errorf("log driver must be set: %w", define.ErrInvalidArg)
-		case define.JournaldLogging, define.KubernetesLogging, define.JSONLogging, define.NoLogging, define.PassthroughLogging, define.PassthroughTTYLogging:
+		case define.JournaldLogging, define.KubernetesLogging, define.JSONLogging, define.NoLogging, define.PassthroughLogging, define.PassthroughTTYLogging, define.SyslogLogging:
 			break
 		default:
 			return fmt.Errorf("invalid log driver: %w", define.ErrInvalidArg)
@@ -1144,6 +1144,19 @@ func WithLogDriver(driver string) CtrCreateOption {
 	}
 }
 
+// WithLogOptions sets options of the log driver.
+func WithLogOptions(options map[string]string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		ctr.config.LogOptions = options
+
+		return nil
+	}
+}
+
 // WithLogPath sets the path to the log file.
 func WithLogPath(path string) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -2050,6 +2063,20 @@ func WithStartupHealthcheck(startupHC *define.StartupHealthCheck) CtrCreateOptio
 	}
 }
 
+// WithReadinessCheck sets a readiness check for the container.
+func WithReadinessCheck(readiness *define.ReadinessCheck) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.ReadinessCheckConfig = new(define.ReadinessCheck)
+		if err := JSONDeepCopy(readiness, ctr.config.ReadinessCheckConfig); err != nil {
+			return fmt.Errorf("error copying readiness check into container: %w", err)
+		}
+		return nil
+	}
+}
+
 // Pod Creation Options
 
 // WithPodCreateCommand adds the full command plus arguments of the current
@@ -2375,6 +2402,23 @@ func WithServiceContainer(id string) PodCreateOption {
 	}
 }
 
+// WithPodCronJob sets the schedule of a pod running a Kubernetes CronJob.
+func WithPodCronJob(cronJob *define.PodCronJob) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		if cronJob == nil || cronJob.Calendar == "" {
+			return fmt.Errorf("a CronJob requires a calendar event: %w", define.ErrInvalidArg)
+		}
+
+		schedule := *cronJob
+		pod.config.CronJob = &schedule
+		return nil
+	}
+}
+
 // WithPodResources sets resource limits to be applied to the pod's cgroup
 // these will be inherited by all containers unless overridden.
 func WithPodResources(resources specs.LinuxResources) PodCreateOption {
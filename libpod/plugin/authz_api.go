@@ -0,0 +1,197 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/go-plugins-helpers/sdk"
+)
+
+// Copied from docker/go-plugins-helpers/authorization/api.go, which is not
+// vendored.
+var (
+	authZRequestPath  = "/AuthZPlugin.AuthZReq"
+	authZResponsePath = "/AuthZPlugin.AuthZRes"
+)
+
+const (
+	authZPluginType = "authz"
+
+	// authZPluginDir is where a plugin given by name is looked up, to
+	// make existing Docker authorization plugins easy to use.
+	authZPluginDir = "/run/docker/plugins"
+)
+
+// ErrNotAuthZPlugin is returned if a plugin does not implement authorization.
+var ErrNotAuthZPlugin = errors.New("plugin is not an authorization plugin")
+
+// AuthZRequest is the request sent to authorization plugins.  It follows
+// the Request of docker/go-plugins-helpers/authorization.  The response
+// fields are only set when the response is authorized.
+type AuthZRequest struct {
+	// User is the user of the request, if authenticated.
+	User string `json:"User,omitempty"`
+	// UserAuthNMethod is the method User was authenticated with.
+	UserAuthNMethod string `json:"UserAuthNMethod,omitempty"`
+	// RequestMethod is the HTTP method of the request.
+	RequestMethod string `json:"RequestMethod,omitempty"`
+	// RequestURI is the HTTP request URI including the API version.
+	RequestURI string `json:"RequestUri,omitempty"`
+	// RequestBody is the body of the request, only set for JSON bodies.
+	RequestBody []byte `json:"RequestBody,omitempty"`
+	// RequestHeaders are the HTTP headers of the request.
+	RequestHeaders map[string]string `json:"RequestHeaders,omitempty"`
+	// RequestPeerCertificates are the DER encoded TLS client certificates.
+	RequestPeerCertificates [][]byte `json:"RequestPeerCertificates,omitempty"`
+	// ResponseBody is the body of the response, only set for JSON bodies.
+	ResponseBody []byte `json:"ResponseBody,omitempty"`
+	// ResponseHeaders are the HTTP headers of the response.
+	ResponseHeaders map[string]string `json:"ResponseHeaders,omitempty"`
+	// ResponseStatusCode is the HTTP status code of the response.
+	ResponseStatusCode int `json:"ResponseStatusCode,omitempty"`
+}
+
+// AuthZResponse is the response of authorization plugins.
+type AuthZResponse struct {
+	// Allow is true if the request is authorized.
+	Allow bool `json:"Allow"`
+	// Msg is the reason a request was denied.
+	Msg string `json:"Msg,omitempty"`
+	// Err is set if the plugin failed to process the request.
+	Err string `json:"Err,omitempty"`
+}
+
+// AuthZPlugin is a single authorization plugin.
+type AuthZPlugin struct {
+	// Name is the name of the plugin, the base name of its socket.
+	Name string
+	// SocketPath is the unix socket at which the plugin is accessed.
+	SocketPath string
+	// Client is the HTTP client we use to connect to the plugin.
+	Client *http.Client
+}
+
+// GetAuthZPlugin gets the authorization plugin listening on the unix socket
+// at path.  A path without a slash is the name of a plugin in
+// /run/docker/plugins.  The plugin must be running, it is activated before
+// it is returned.
+func GetAuthZPlugin(path string, timeout time.Duration) (*AuthZPlugin, error) {
+	if !strings.Contains(path, "/") {
+		path = filepath.Join(authZPluginDir, path+".sock")
+	}
+
+	newPlugin := new(AuthZPlugin)
+	newPlugin.SocketPath = filepath.Clean(path)
+	newPlugin.Name = strings.TrimSuffix(filepath.Base(newPlugin.SocketPath), ".sock")
+	newPlugin.Client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", newPlugin.SocketPath)
+			},
+			DisableCompression: true,
+		},
+	}
+
+	stat, err := os.Stat(newPlugin.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot access plugin %s socket %q: %w", newPlugin.Name, newPlugin.SocketPath, err)
+	}
+	if stat.Mode()&os.ModeSocket == 0 {
+		return nil, fmt.Errorf("authorization plugin %s path %q is not a unix socket: %w", newPlugin.Name, newPlugin.SocketPath, ErrNotPlugin)
+	}
+
+	resp, err := newPlugin.sendRequest(nil, activatePath)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got status code %d from activation endpoint for plugin %s: %w", resp.StatusCode, newPlugin.Name, ErrNotPlugin)
+	}
+	respStruct := new(activateResponse)
+	if err := json.NewDecoder(resp.Body).Decode(respStruct); err != nil {
+		return nil, fmt.Errorf("unmarshalling plugin %s activation response: %w", newPlugin.Name, err)
+	}
+	if !slices.Contains(respStruct.Implements, authZPluginType) {
+		return nil, fmt.Errorf("plugin %s does not implement authorization plugin, instead provides %s: %w", newPlugin.Name, strings.Join(respStruct.Implements, ", "), ErrNotAuthZPlugin)
+	}
+
+	return newPlugin, nil
+}
+
+// Send a request to the authorization plugin for handling.
+// Callers *MUST* close the response when they are done.
+func (p *AuthZPlugin) sendRequest(toJSON interface{}, endpoint string) (*http.Response, error) {
+	var (
+		reqJSON []byte
+		err     error
+	)
+
+	if toJSON != nil {
+		reqJSON, err = json.Marshal(toJSON)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling request JSON for authorization plugin %s endpoint %s: %w", p.Name, endpoint, err)
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://plugin"+endpoint, bytes.NewReader(reqJSON))
+	if err != nil {
+		return nil, fmt.Errorf("making request to authorization plugin %s endpoint %s: %w", p.Name, endpoint, err)
+	}
+
+	req.Header.Set("Host", "unix://"+p.SocketPath)
+	req.Header.Set("Content-Type", sdk.DefaultContentTypeV1_1)
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request to authorization plugin %s endpoint %s: %w", p.Name, endpoint, err)
+	}
+	return resp, nil
+}
+
+// AuthZRequest asks the plugin whether req is authorized.  An error is
+// returned if the plugin could not process the request.
+func (p *AuthZPlugin) AuthZRequest(req *AuthZRequest) (*AuthZResponse, error) {
+	return p.authZ(req, authZRequestPath)
+}
+
+// AuthZResponse asks the plugin whether the response to req is authorized.
+// An error is returned if the plugin could not process the request.
+func (p *AuthZPlugin) AuthZResponse(req *AuthZRequest) (*AuthZResponse, error) {
+	return p.authZ(req, authZResponsePath)
+}
+
+func (p *AuthZPlugin) authZ(req *AuthZRequest, endpoint string) (*AuthZResponse, error) {
+	resp, err := p.sendRequest(req, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body from authorization plugin %s: %w", p.Name, err)
+	}
+	authZResp := new(AuthZResponse)
+	if err := json.Unmarshal(respBytes, authZResp); err != nil {
+		return nil, fmt.Errorf("unmarshalling authorization plugin %s response: %w", p.Name, err)
+	}
+	if authZResp.Err != "" {
+		return nil, fmt.Errorf("authorization plugin %s failed with error: %s", p.Name, authZResp.Err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got status code %d from authorization plugin %s", resp.StatusCode, p.Name)
+	}
+	return authZResp, nil
+}
@@ -182,6 +182,15 @@ func GetVolumePlugin(name string, path string, timeout *uint, cfg *config.Config
 	return newPlugin, nil
 }
 
+// Validate checks that the plugin is still available and responds to
+// activation requests.
+func (p *VolumePlugin) Validate() error {
+	if err := p.verifyReachable(); err != nil {
+		return err
+	}
+	return validatePlugin(p)
+}
+
 func (p *VolumePlugin) getURI() string {
 	return "unix://" + p.SocketPath
 }
@@ -73,6 +73,9 @@ type PodConfig struct {
 	// life cycle of service which may be started via `podman-play-kube`.
 	ServiceContainerID string `json:"serviceContainerID,omitempty"`
 
+	// CronJob is the schedule of a pod running a Kubernetes CronJob.
+	CronJob *define.PodCronJob `json:"cronJob,omitempty"`
+
 	// Time pod was created
 	CreatedTime time.Time `json:"created"`
 
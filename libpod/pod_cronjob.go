@@ -0,0 +1,25 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v5/libpod/define"
+)
+
+// cronJobUnitName returns the name of the systemd timer starting the pod of
+// a CronJob.
+func (p *Pod) cronJobUnitName() string {
+	return p.ID() + "-cronjob"
+}
+
+// StartCronJobTimer creates the systemd timer starting the pod on the
+// schedule of its CronJob.  The timer is recreated when the state is
+// refreshed after a reboot and removed along with the pod.
+func (p *Pod) StartCronJobTimer() error {
+	if p.config.CronJob == nil {
+		return fmt.Errorf("pod %s does not run a CronJob: %w", p.ID(), define.ErrInvalidArg)
+	}
+	return p.createCronJobTimer()
+}
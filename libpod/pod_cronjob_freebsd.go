@@ -0,0 +1,18 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"errors"
+)
+
+// createCronJobTimer is not supported, CronJobs are run by systemd timers.
+func (p *Pod) createCronJobTimer() error {
+	return errors.New("running CronJobs requires systemd")
+}
+
+// removeCronJobTimer is a no-op, no CronJob timer can exist.
+func (p *Pod) removeCronJobTimer(ctx context.Context) error {
+	return nil
+}
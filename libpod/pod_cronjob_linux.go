@@ -0,0 +1,99 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	systemdCommon "github.com/containers/common/pkg/systemd"
+	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/podman/v5/pkg/specgenutil"
+	"github.com/containers/podman/v5/pkg/systemd"
+	"github.com/sirupsen/logrus"
+)
+
+// createCronJobTimer creates a transient systemd timer starting the pod on
+// the calendar event of its CronJob, or restarting it if requested.
+func (p *Pod) createCronJobTimer() error {
+	if !systemdCommon.RunsOnSystemd() {
+		return errors.New("running CronJobs requires systemd")
+	}
+
+	podman, err := specgenutil.CreatePodmanCommandArgs(p.runtime.storageConfig, p.runtime.config, false)
+	if err != nil {
+		return fmt.Errorf("failed to get the podman command for a CronJob timer: %w", err)
+	}
+
+	var cmd []string
+	if rootless.IsRootless() {
+		cmd = append(cmd, "--user")
+	}
+	if path := os.Getenv("PATH"); path != "" {
+		cmd = append(cmd, "--setenv=PATH="+path)
+	}
+	cmd = append(cmd, "--unit", p.cronJobUnitName(), "--on-calendar="+p.config.CronJob.Calendar, "--timer-property=AccuracySec=1s")
+	cmd = append(cmd, podman...)
+	if p.config.CronJob.Restart {
+		cmd = append(cmd, "pod", "restart", p.ID())
+	} else {
+		cmd = append(cmd, "pod", "start", p.ID())
+	}
+
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to add CronJob timer: %w", err)
+	}
+	conn.Close()
+	logrus.Debugf("creating systemd-transient files: %s %s", "systemd-run", cmd)
+	systemdRun := exec.Command("systemd-run", cmd...)
+	if output, err := systemdRun.CombinedOutput(); err != nil {
+		exitError := &exec.ExitError{}
+		if errors.As(err, &exitError) {
+			return fmt.Errorf("systemd-run failed: %w: output: %s", err, strings.TrimSpace(string(output)))
+		}
+		return fmt.Errorf("failed to execute systemd-run: %w", err)
+	}
+	return nil
+}
+
+// removeCronJobTimer stops the transient systemd timer and service of the
+// CronJob of the pod, which removes them.
+func (p *Pod) removeCronJobTimer(ctx context.Context) error {
+	if !systemdCommon.RunsOnSystemd() {
+		return nil
+	}
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to remove CronJob timer: %w", err)
+	}
+	defer conn.Close()
+
+	// Stop the timer before the service to make sure the timer does not
+	// fire after the service is stopped.
+	unitName := p.cronJobUnitName()
+	var stopErrors []error
+	for _, unit := range []string{unitName + ".timer", unitName + ".service"} {
+		stopChan := make(chan string)
+		if _, err := conn.StopUnitContext(ctx, unit, "ignore-dependencies", stopChan); err != nil {
+			if !strings.HasSuffix(err.Error(), " not loaded.") {
+				stopErrors = append(stopErrors, fmt.Errorf("removing CronJob unit %q: %w", unit, err))
+			}
+			continue
+		}
+		if msg := <-stopChan; msg != "done" {
+			stopErrors = append(stopErrors, fmt.Errorf("stopping CronJob unit %q: expected %q but received %q", unit, "done", msg))
+		}
+	}
+	// systemd keeps failed transient services around, reset the service
+	// to make sure it is removed.
+	if err := conn.ResetFailedUnitContext(ctx, unitName+".service"); err != nil {
+		logrus.Debugf("Failed to reset unit file: %q", err)
+	}
+	return errorhandling.JoinErrors(stopErrors)
+}
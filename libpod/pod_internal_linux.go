@@ -32,5 +32,12 @@ func (p *Pod) platformRefresh() error {
 			return fmt.Errorf("unknown cgroups manager %s specified: %w", p.runtime.config.Engine.CgroupManager, define.ErrInvalidArg)
 		}
 	}
+	// Transient timers do not survive a reboot, recreate the timer
+	// running the CronJob of the pod
+	if p.config.CronJob != nil {
+		if err := p.createCronJobTimer(); err != nil {
+			logrus.Errorf("Creating CronJob timer for pod %s: %v", p.ID(), err)
+		}
+	}
 	return nil
 }
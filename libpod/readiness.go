@@ -0,0 +1,126 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+// ReadinessCheck runs the readiness check of the container and records its
+// result.  It returns whether the container is ready afterwards and the
+// result of the check.  The check is not run before the startup healthcheck
+// passed and within the initial delay, HealthCheckStartup is returned then.
+func (r *Runtime) ReadinessCheck(ctx context.Context, name string) (bool, define.HealthCheckStatus, error) {
+	ctr, err := r.LookupContainer(name)
+	if err != nil {
+		return false, define.HealthCheckContainerNotFound, fmt.Errorf("unable to look up %s to perform a readiness check: %w", name, err)
+	}
+	return ctr.runReadinessCheck(ctx)
+}
+
+func (c *Container) runReadinessCheck(ctx context.Context) (bool, define.HealthCheckStatus, error) {
+	c.lock.Lock()
+	if err := c.syncContainer(); err != nil {
+		c.lock.Unlock()
+		return false, define.HealthCheckInternalError, err
+	}
+	state := c.state.State
+	startupPassed := c.config.StartupHealthCheckConfig == nil || c.state.StartupHCPassed
+	startedTime := c.state.StartedTime
+	c.lock.Unlock()
+
+	if state != define.ContainerStateRunning {
+		return false, define.HealthCheckContainerStopped, fmt.Errorf("container %s is not running", c.ID())
+	}
+	config := c.config.ReadinessCheckConfig
+	if config == nil {
+		return false, define.HealthCheckNotDefined, fmt.Errorf("container %s has no defined readiness check", c.ID())
+	}
+	command, probe, ok := healthCheckTestCommand(config.Test)
+	if !ok {
+		return false, define.HealthCheckNotDefined, fmt.Errorf("container %s has no defined readiness check", c.ID())
+	}
+	// As with Kubernetes, readiness is only checked once the container
+	// started up.
+	if !startupPassed || time.Now().Before(startedTime.Add(config.StartPeriod)) {
+		return false, define.HealthCheckStartup, nil
+	}
+
+	output := &bytes.Buffer{}
+	exitCode, checkErr := c.execHealthCheckTest(command, probe, config.Timeout, output)
+	result := define.HealthCheckSuccess
+	if checkErr != nil || exitCode != 0 {
+		logrus.Debugf("Readiness check of container %s failed: %s %v", c.ID(), output.String(), checkErr)
+		result = define.HealthCheckFailure
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return false, define.HealthCheckInternalError, err
+	}
+	if err := c.updateReadiness(result == define.HealthCheckSuccess); err != nil {
+		return false, define.HealthCheckInternalError, err
+	}
+	return c.isReady(), result, nil
+}
+
+// updateReadiness records the result of a readiness check.  The container
+// becomes ready after the configured number of consecutive successes and is
+// no longer ready after the configured number of consecutive failures.
+// NOTE: The caller must lock and sync the container.
+func (c *Container) updateReadiness(success bool) error {
+	// The container may have been stopped while the check ran.
+	if c.state.State != define.ContainerStateRunning {
+		return nil
+	}
+
+	if success {
+		c.state.ReadinessFailureCount = 0
+		c.state.ReadinessSuccessCount++
+		if c.state.ReadinessSuccessCount >= max(c.config.ReadinessCheckConfig.Successes, 1) {
+			if !c.state.Ready {
+				logrus.Infof("Container %s is ready", c.ID())
+			}
+			c.state.Ready = true
+		}
+	} else {
+		c.state.ReadinessSuccessCount = 0
+		c.state.ReadinessFailureCount++
+		if c.state.ReadinessFailureCount >= max(c.config.ReadinessCheckConfig.Retries, 1) {
+			if c.state.Ready {
+				logrus.Infof("Container %s is no longer ready", c.ID())
+			}
+			c.state.Ready = false
+		}
+	}
+	return c.save()
+}
+
+// readinessStatus returns the human-readable readiness of a container.
+func readinessStatus(ready bool) string {
+	if ready {
+		return define.ReadinessCheckReady
+	}
+	return define.ReadinessCheckNotReady
+}
+
+// ReadinessStatus returns the readiness of the container, either "ready" or
+// "not ready".  An empty string is returned if the container has no
+// readiness check.
+func (c *Container) ReadinessStatus() (string, error) {
+	if c.config.ReadinessCheckConfig == nil {
+		return "", nil
+	}
+	ready, err := c.Ready()
+	if err != nil {
+		return "", err
+	}
+	return readinessStatus(ready), nil
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/containers/podman/v5/libpod/lock"
 	"github.com/containers/podman/v5/libpod/plugin"
 	"github.com/containers/podman/v5/libpod/shutdown"
+	"github.com/containers/podman/v5/pkg/containersconf"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	artStore "github.com/containers/podman/v5/pkg/libartifact/store"
@@ -68,6 +69,7 @@ type storageSet struct {
 // Runtime is the core libpod runtime
 type Runtime struct {
 	config        *config.Config
+	podmanConfig  *containersconf.Config
 	storageConfig storage.StoreOptions
 	storageSet    storageSet
 
@@ -122,6 +124,9 @@ type Runtime struct {
 
 	// secretsManager manages secrets
 	secretsManager *secrets.SecretsManager
+
+	// disabledPluginsCache caches the names of the disabled volume plugins
+	disabledPluginsCache disabledVolumePluginsCache
 }
 
 // SetXdgDirs ensures the XDG_RUNTIME_DIR env and XDG_CONFIG_HOME variables are set.
@@ -202,6 +207,12 @@ func newRuntimeFromConfig(ctx context.Context, conf *config.Config, options ...R
 		return nil, err
 	}
 
+	podmanConfig, err := containersconf.New(conf.LoadedModules())
+	if err != nil {
+		return nil, err
+	}
+	runtime.podmanConfig = podmanConfig
+
 	storeOpts, err := storage.DefaultStoreOptions()
 	if err != nil {
 		return nil, err
@@ -816,6 +827,10 @@ func (r *Runtime) Shutdown(force bool) error {
 			lastError = fmt.Errorf("shutting down container storage: %w", err)
 		}
 	}
+	// Wait for the events to be delivered to the sinks.
+	if sinks, ok := r.eventer.(*events.EventerWithSinks); ok {
+		sinks.Close()
+	}
 	if err := r.state.Close(); err != nil {
 		if lastError != nil {
 			logrus.Error(lastError)
@@ -1105,7 +1120,12 @@ func (r *Runtime) reloadContainersConf() error {
 	if err != nil {
 		return err
 	}
+	podmanConfig, err := containersconf.New(config.LoadedModules())
+	if err != nil {
+		return err
+	}
 	r.config = config
+	r.podmanConfig = podmanConfig
 	logrus.Infof("Applied new containers configuration: %v", config)
 	return nil
 }
@@ -1137,6 +1157,13 @@ func (r *Runtime) getVolumePlugin(volConfig *VolumeConfig) (*plugin.VolumePlugin
 		}
 		return nil, fmt.Errorf("no volume plugin with name %s available: %w", name, define.ErrMissingPlugin)
 	}
+	disabled, err := r.disabledVolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(disabled, name) {
+		return nil, fmt.Errorf("volume plugin %s: %w", name, define.ErrPluginDisabled)
+	}
 
 	return plugin.GetVolumePlugin(name, pluginPath, timeout, r.config)
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
+	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/podman/v5/libpod/shutdown"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/rootless"
@@ -557,10 +558,18 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 	switch ctr.config.LogDriver {
 	case define.NoLogging, define.PassthroughLogging, define.JournaldLogging:
 		break
+	case define.SyslogLogging:
+		if _, err := logs.ParseSyslogOptions(ctr.config.LogOptions); err != nil {
+			return nil, fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+		}
+		fallthrough
 	default:
 		if ctr.config.LogPath == "" {
 			ctr.config.LogPath = filepath.Join(ctr.config.StaticDir, "ctr.log")
 		}
+		if _, err := ctr.logRotation(); err != nil {
+			return nil, fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+		}
 	}
 
 	if useDevShm && !MountExists(ctr.config.Spec.Mounts, "/dev/shm") && ctr.config.ShmDir == "" && !ctr.config.NoShm {
@@ -272,6 +272,17 @@ func (r *Runtime) removePod(ctx context.Context, p *Pod, removeCtrs, force bool,
 		}
 	}
 
+	// Remove the timer of a CronJob pod so it does not outlive the pod
+	if p.config.CronJob != nil {
+		if err := p.removeCronJobTimer(ctx); err != nil {
+			if removalErr == nil {
+				removalErr = fmt.Errorf("removing pod %s CronJob timer: %w", p.ID(), err)
+			} else {
+				logrus.Errorf("Removing pod %s CronJob timer: %v", p.ID(), err)
+			}
+		}
+	}
+
 	// Remove pod cgroup
 	if err := p.removePodCgroup(); err != nil {
 		if removalErr == nil {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -258,7 +259,16 @@ func (r *Runtime) UpdateVolumePlugins(ctx context.Context) *define.VolumeReload
 		allPluginVolumes = map[string]struct{}{}
 	)
 
+	disabled, err := r.disabledVolumePlugins()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	for driverName, socket := range r.config.Engine.VolumePlugins {
+		// The volumes of disabled plugins are neither imported nor removed.
+		if slices.Contains(disabled, driverName) {
+			continue
+		}
 		driver, err := volplugin.GetVolumePlugin(driverName, socket, nil, r.config)
 		if err != nil {
 			errs = append(errs, err)
@@ -290,7 +300,7 @@ func (r *Runtime) UpdateVolumePlugins(ctx context.Context) *define.VolumeReload
 		errs = append(errs, fmt.Errorf("cannot delete dangling plugin volumes: failed to read libpod volumes: %w", err))
 	}
 	for _, vol := range libpodVolumes {
-		if vol.UsesVolumeDriver() {
+		if vol.UsesVolumeDriver() && !slices.Contains(disabled, vol.Driver()) {
 			if _, ok := allPluginVolumes[vol.Name()]; !ok {
 				// The volume is no longer in the plugin. Let's remove it from the libpod db.
 				if err := r.removeVolume(ctx, vol, false, nil, true); err != nil {
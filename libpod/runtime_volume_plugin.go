@@ -0,0 +1,237 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/plugin"
+	"github.com/containers/storage/pkg/ioutils"
+	"github.com/containers/storage/pkg/lockfile"
+)
+
+// disabledVolumePluginsFile lists the names of the disabled volume plugins.
+// Podman does not manage the processes of volume plugins, a disabled plugin
+// is merely not used anymore.
+const disabledVolumePluginsFile = "disabled-volume-plugins.json"
+
+// disabledVolumePluginsCache caches the names of the disabled volume plugins
+// along with the modification time and size of the file they were read from.
+// They are looked up for every volume of a plugin, e.g. when listing volumes.
+type disabledVolumePluginsCache struct {
+	lock    sync.Mutex
+	modTime time.Time
+	size    int64
+	names   []string
+}
+
+// Contains the public Runtime API for volume plugins
+
+// VolumePlugins returns the volume plugins configured in containers.conf.
+func (r *Runtime) VolumePlugins() ([]*define.VolumePluginInfo, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	names := make([]string, 0, len(r.config.Engine.VolumePlugins))
+	for name := range r.config.Engine.VolumePlugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	infos := make([]*define.VolumePluginInfo, 0, len(names))
+	for _, name := range names {
+		info, err := r.VolumePlugin(name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// VolumePlugin returns the volume plugin with the given name.  The plugin is
+// contacted to determine whether it is running.
+func (r *Runtime) VolumePlugin(name string) (*define.VolumePluginInfo, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	socketPath, ok := r.config.Engine.VolumePlugins[name]
+	if !ok {
+		return nil, fmt.Errorf("no volume plugin with name %s available: %w", name, define.ErrMissingPlugin)
+	}
+	disabled, err := r.disabledVolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+	vols, err := r.Volumes(func(v *Volume) bool { return v.Driver() == name })
+	if err != nil {
+		return nil, err
+	}
+
+	info := &define.VolumePluginInfo{
+		Name:       name,
+		SocketPath: socketPath,
+		Enabled:    !slices.Contains(disabled, name),
+		Volumes:    len(vols),
+	}
+	p, err := plugin.GetVolumePlugin(name, socketPath, nil, r.config)
+	if err == nil {
+		err = p.Validate()
+	}
+	if err != nil {
+		info.Error = err.Error()
+	} else {
+		info.Running = true
+	}
+	return info, nil
+}
+
+// EnableVolumePlugin enables a disabled volume plugin.  The plugin must be
+// running.
+func (r *Runtime) EnableVolumePlugin(name string) error {
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	socketPath, ok := r.config.Engine.VolumePlugins[name]
+	if !ok {
+		return fmt.Errorf("no volume plugin with name %s available: %w", name, define.ErrMissingPlugin)
+	}
+	p, err := plugin.GetVolumePlugin(name, socketPath, nil, r.config)
+	if err != nil {
+		return err
+	}
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return r.updateDisabledVolumePlugins(func(disabled []string) []string {
+		return slices.DeleteFunc(disabled, func(n string) bool { return n == name })
+	})
+}
+
+// DisableVolumePlugin disables a volume plugin, its volumes cannot be created
+// or used anymore.  Unless force is set, the plugin must not be used by any
+// volume.
+func (r *Runtime) DisableVolumePlugin(name string, force bool) error {
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	if _, ok := r.config.Engine.VolumePlugins[name]; !ok {
+		return fmt.Errorf("no volume plugin with name %s available: %w", name, define.ErrMissingPlugin)
+	}
+	if !force {
+		vols, err := r.Volumes(func(v *Volume) bool { return v.Driver() == name })
+		if err != nil {
+			return err
+		}
+		if len(vols) > 0 {
+			return fmt.Errorf("volume plugin %s is used by %d volume(s): %w", name, len(vols), define.ErrPluginInUse)
+		}
+	}
+
+	return r.updateDisabledVolumePlugins(func(disabled []string) []string {
+		if slices.Contains(disabled, name) {
+			return disabled
+		}
+		return append(disabled, name)
+	})
+}
+
+func (r *Runtime) disabledVolumePluginsPath() string {
+	return filepath.Join(r.config.Engine.StaticDir, disabledVolumePluginsFile)
+}
+
+// disabledVolumePlugins returns the names of the disabled volume plugins.
+// The file is only read again once it was modified, the returned slice must
+// not be modified.
+func (r *Runtime) disabledVolumePlugins() ([]string, error) {
+	path := r.disabledVolumePluginsPath()
+	// Do not create the lock if no plugin was ever disabled.
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	cache := &r.disabledPluginsCache
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if cache.names != nil && info.ModTime().Equal(cache.modTime) && info.Size() == cache.size {
+		return cache.names, nil
+	}
+
+	lock, err := lockfile.GetLockFile(path + ".lock")
+	if err != nil {
+		return nil, err
+	}
+	lock.RLock()
+	defer lock.Unlock()
+	names, err := readDisabledVolumePlugins(path)
+	if err != nil {
+		return nil, err
+	}
+	// The file may have been replaced since it was stat'ed, it is then
+	// read again by the next call.
+	cache.modTime, cache.size, cache.names = info.ModTime(), info.Size(), names
+	return names, nil
+}
+
+// updateDisabledVolumePlugins replaces the names of the disabled volume
+// plugins with the result of update.
+func (r *Runtime) updateDisabledVolumePlugins(update func([]string) []string) error {
+	path := r.disabledVolumePluginsPath()
+	lock, err := lockfile.GetLockFile(path + ".lock")
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	disabled, err := readDisabledVolumePlugins(path)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(update(disabled))
+	if err != nil {
+		return err
+	}
+	if err := ioutils.AtomicWriteFile(path, b, 0o600); err != nil {
+		return err
+	}
+
+	// Do not rely on the modification time for changes made by this
+	// runtime.
+	r.disabledPluginsCache.lock.Lock()
+	r.disabledPluginsCache.names = nil
+	r.disabledPluginsCache.lock.Unlock()
+	return nil
+}
+
+func readDisabledVolumePlugins(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	var disabled []string
+	if err := json.Unmarshal(b, &disabled); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return disabled, nil
+}
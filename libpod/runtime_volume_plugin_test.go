@@ -0,0 +1,43 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledVolumePlugins(t *testing.T) {
+	r := &Runtime{config: &config.Config{}}
+	r.config.Engine.StaticDir = t.TempDir()
+
+	disabled, err := r.disabledVolumePlugins()
+	require.NoError(t, err)
+	assert.Empty(t, disabled)
+
+	require.NoError(t, r.updateDisabledVolumePlugins(func(disabled []string) []string {
+		return append(disabled, "plugin1")
+	}))
+	disabled, err = r.disabledVolumePlugins()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plugin1"}, disabled)
+
+	// Changes by other processes are picked up once the file changed.
+	path := filepath.Join(r.config.Engine.StaticDir, disabledVolumePluginsFile)
+	require.NoError(t, os.WriteFile(path, []byte(`["plugin1","plugin2"]`), 0o600))
+	disabled, err = r.disabledVolumePlugins()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plugin1", "plugin2"}, disabled)
+
+	require.NoError(t, r.updateDisabledVolumePlugins(func([]string) []string {
+		return []string{"plugin3"}
+	}))
+	disabled, err = r.disabledVolumePlugins()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plugin3"}, disabled)
+}
@@ -331,14 +331,17 @@ func finalizeVolumeSqlite(vol *Volume) error {
 	// Retrieve volume driver
 	if vol.UsesVolumeDriver() {
 		plugin, err := vol.runtime.getVolumePlugin(vol.config)
-		if err != nil {
+		switch {
+		case errors.Is(err, define.ErrPluginDisabled):
+			logrus.Debugf("Volume %s uses disabled volume plugin %s", vol.Name(), vol.config.Driver)
+		case err != nil:
 			// We want to fail gracefully here, to ensure that we
 			// can still remove volumes even if their plugin is
 			// missing. Otherwise, we end up with volumes that
 			// cannot even be retrieved from the database and will
 			// cause things like `volume ls` to fail.
 			logrus.Errorf("Volume %s uses volume plugin %s, but it cannot be accessed - some functionality may not be available: %v", vol.Name(), vol.config.Driver, err)
-		} else {
+		default:
 			vol.plugin = plugin
 		}
 	}
@@ -11,16 +11,19 @@ import (
 	"io/fs"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/api/handlers/utils/apiutil"
+	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/storage/pkg/fileutils"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/selinux/go-selinux/label"
@@ -309,3 +312,33 @@ func evalSymlinksIfExists(toCheck string) (string, error) {
 	}
 	return checkedVal, nil
 }
+
+// startDetachedPodman starts a Podman process with the global options of the
+// runtime followed by the specified arguments.  The process runs in its own
+// session with its stdio connected to /dev/null and is not waited for, so it
+// outlives the calling process.  description names the process in logs and
+// errors.
+func (r *Runtime) startDetachedPodman(description string, podmanArgs ...string) error {
+	args, err := specgenutil.CreatePodmanCommandArgs(r.storageConfig, r.config, r.syslog || logrus.IsLevelEnabled(logrus.DebugLevel))
+	if err != nil {
+		return fmt.Errorf("creating %s command: %w", description, err)
+	}
+	args = append(args, podmanArgs...)
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer devNull.Close()
+
+	logrus.Debugf("Starting %s: %s", description, strings.Join(args, " "))
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = devNull
+	cmd.Stdout = devNull
+	cmd.Stderr = devNull
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting %s: %w", description, err)
+	}
+	return cmd.Process.Release()
+}
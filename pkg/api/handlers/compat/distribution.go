@@ -0,0 +1,160 @@
+//go:build !remote
+
+package compat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/image"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/pkg/shortnames"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/auth"
+	"github.com/docker/distribution/registry/api/errcode"
+	dockerRegistry "github.com/docker/docker/api/types/registry"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// attestationManifestType is the reference type of the manifests BuildKit
+// adds to image indexes for attestations.  They are not images for a
+// platform.
+const attestationManifestType = "attestation-manifest"
+
+// InspectDistribution returns the descriptor of the manifest the image
+// reference resolves to in its registry and the platforms the image is
+// available for.  Short names are resolved according to registries.conf.
+func InspectDistribution(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := utils.GetDecoder(r)
+	query := struct {
+		TLSVerify bool `schema:"tlsVerify"`
+	}{
+		TLSVerify: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	name := utils.GetName(r)
+
+	authConf, authfile, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	sys := runtime.SystemContext()
+	if authfile != "" {
+		sys.AuthFilePath = authfile
+	}
+	sys.DockerAuthConfig = authConf
+	if _, found := r.URL.Query()["tlsVerify"]; found {
+		sys.DockerInsecureSkipTLSVerify = types.NewOptionalBool(!query.TLSVerify)
+	}
+	if err := utils.PossiblyEnforceDockerHub(r, sys); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	resolved, err := shortnames.Resolve(sys, name)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var lastErr error
+	for _, candidate := range resolved.PullCandidates {
+		report, err := inspectDistribution(r.Context(), sys, candidate.Value)
+		if err != nil {
+			lastErr = fmt.Errorf("inspecting %s: %w", candidate.Value, err)
+			continue
+		}
+		utils.WriteResponse(w, http.StatusOK, report)
+		return
+	}
+
+	var (
+		unauthErr docker.ErrUnauthorizedForCredentials
+		errcd     errcode.ErrorCoder
+	)
+	switch {
+	case errors.As(lastErr, &unauthErr):
+		utils.Error(w, http.StatusUnauthorized, lastErr)
+	case errors.As(lastErr, &errcd) && errcd.ErrorCode().Descriptor().HTTPStatusCode == http.StatusUnauthorized:
+		utils.Error(w, http.StatusUnauthorized, lastErr)
+	case errors.As(lastErr, &errcd) && errcd.ErrorCode().Descriptor().HTTPStatusCode == http.StatusNotFound:
+		utils.Error(w, http.StatusNotFound, lastErr)
+	default:
+		utils.InternalServerError(w, lastErr)
+	}
+}
+
+// inspectDistribution fetches the manifest of named from its registry.  The
+// platform of a single image is read from its config.
+func inspectDistribution(ctx context.Context, sys *types.SystemContext, named reference.Named) (*dockerRegistry.DistributionInspect, error) {
+	ref, err := docker.NewReference(named)
+	if err != nil {
+		return nil, err
+	}
+	src, err := ref.NewImageSource(ctx, sys)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	manifestBytes, manifestType, err := src.GetManifest(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	manifestDigest, err := manifest.Digest(manifestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dockerRegistry.DistributionInspect{
+		Descriptor: imgspecv1.Descriptor{
+			MediaType: manifestType,
+			Digest:    manifestDigest,
+			Size:      int64(len(manifestBytes)),
+		},
+		Platforms: []imgspecv1.Platform{},
+	}
+
+	if manifest.MIMETypeIsMultiImage(manifestType) {
+		list, err := manifest.ListFromBlob(manifestBytes, manifestType)
+		if err != nil {
+			return nil, fmt.Errorf("parsing manifest list: %w", err)
+		}
+		for _, instanceDigest := range list.Instances() {
+			instance, err := list.Instance(instanceDigest)
+			if err != nil {
+				return nil, err
+			}
+			if instance.ReadOnly.Platform == nil || instance.ReadOnly.Annotations["vnd.docker.reference.type"] == attestationManifestType {
+				continue
+			}
+			report.Platforms = append(report.Platforms, *instance.ReadOnly.Platform)
+		}
+		return report, nil
+	}
+
+	img, err := image.FromUnparsedImage(ctx, sys, image.UnparsedInstance(src, nil))
+	if err != nil {
+		return nil, err
+	}
+	config, err := img.OCIConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading image config: %w", err)
+	}
+	report.Platforms = append(report.Platforms, config.Platform)
+	return report, nil
+}
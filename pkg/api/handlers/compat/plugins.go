@@ -0,0 +1,172 @@
+//go:build !remote
+
+package compat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/util"
+	docker "github.com/docker/docker/api/types"
+	"github.com/opencontainers/go-digest"
+)
+
+// volumeDriverCapability is the capability of Docker volume plugins.
+const volumeDriverCapability = "volumedriver"
+
+func ListPlugins(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	filterMap, err := util.PrepareFilters(r)
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	pluginFilters, err := generatePluginFilters(*filterMap)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	plugins, err := runtime.VolumePlugins()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	reports := make([]*docker.Plugin, 0, len(plugins))
+	for _, plugin := range plugins {
+		report := pluginToDocker(plugin)
+		match := true
+		for _, filter := range pluginFilters {
+			if !filter(report) {
+				match = false
+				break
+			}
+		}
+		if match {
+			reports = append(reports, report)
+		}
+	}
+	utils.WriteResponse(w, http.StatusOK, reports)
+}
+
+func InspectPlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+	plugin, err := runtime.VolumePlugin(name)
+	if err != nil {
+		utils.PluginNotFound(w, name, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, pluginToDocker(plugin))
+}
+
+func EnablePlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+	if err := runtime.EnableVolumePlugin(name); err != nil {
+		utils.PluginNotFound(w, name, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func DisablePlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := utils.GetDecoder(r)
+	query := struct {
+		Force bool `schema:"force"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	name := utils.GetName(r)
+	if err := runtime.DisableVolumePlugin(name, query.Force); err != nil {
+		if errors.Is(err, define.ErrPluginInUse) {
+			utils.Error(w, http.StatusConflict, err)
+			return
+		}
+		utils.PluginNotFound(w, name, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// pluginToDocker describes a volume plugin as a Docker managed plugin.  A
+// plugin is enabled for Docker if it is enabled and running.
+func pluginToDocker(plugin *define.VolumePluginInfo) *docker.Plugin {
+	return &docker.Plugin{
+		ID:      digest.FromString(plugin.Name).Encoded(),
+		Name:    plugin.Name,
+		Enabled: plugin.Enabled && plugin.Running,
+		Settings: docker.PluginSettings{
+			Args:    []string{},
+			Devices: []docker.PluginDevice{},
+			Env:     []string{},
+			Mounts:  []docker.PluginMount{},
+		},
+		Config: docker.PluginConfig{
+			Args: docker.PluginConfigArgs{
+				Settable: []string{},
+				Value:    []string{},
+			},
+			Description: "Volume plugin listening on " + plugin.SocketPath,
+			Entrypoint:  []string{},
+			Env:         []docker.PluginEnv{},
+			Interface: docker.PluginConfigInterface{
+				Socket: filepath.Base(plugin.SocketPath),
+				Types: []docker.PluginInterfaceType{
+					{Capability: volumeDriverCapability, Prefix: "docker", Version: "1.0"},
+				},
+			},
+			Linux: docker.PluginConfigLinux{
+				Capabilities: []string{},
+				Devices:      []docker.PluginDevice{},
+			},
+			Mounts:  []docker.PluginMount{},
+			Network: docker.PluginConfigNetwork{Type: "host"},
+		},
+	}
+}
+
+// generatePluginFilters returns the `capability` and `enable` filters of the
+// Docker API.
+func generatePluginFilters(filters map[string][]string) ([]func(*docker.Plugin) bool, error) {
+	pluginFilters := make([]func(*docker.Plugin) bool, 0, len(filters))
+	for key, values := range filters {
+		switch key {
+		case "capability":
+			match := slices.Contains(values, volumeDriverCapability)
+			pluginFilters = append(pluginFilters, func(*docker.Plugin) bool {
+				return match
+			})
+		case "enable":
+			enables := make([]bool, 0, len(values))
+			for _, value := range values {
+				enable, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid enable filter value %q: %w", value, err)
+				}
+				enables = append(enables, enable)
+			}
+			pluginFilters = append(pluginFilters, func(plugin *docker.Plugin) bool {
+				return slices.Contains(enables, plugin.Enabled)
+			})
+		default:
+			return nil, fmt.Errorf("invalid filter %q", key)
+		}
+	}
+	return pluginFilters, nil
+}
@@ -0,0 +1,148 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	"github.com/containers/podman/v5/pkg/api/server/idle"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/auth"
+	"github.com/containers/podman/v5/pkg/autoupdate"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/infra/abi"
+	"github.com/gorilla/schema"
+	"github.com/sirupsen/logrus"
+)
+
+// maxRegistryNotificationSize is the maximum size of the push notifications
+// of container registries.
+const maxRegistryNotificationSize = 1 << 20
+
+// autoUpdateLock serializes auto updates triggered by registry notifications
+// and API calls.
+var autoUpdateLock sync.Mutex
+
+// AutoUpdateWebhook handles push notifications of container registries and
+// auto-updates the containers created from one of the pushed images.  The
+// update runs in the background so that the registry does not time out
+// waiting for the response.
+func AutoUpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	images, err := autoupdate.ParseRegistryNotification(http.MaxBytesReader(w, r.Body, maxRegistryNotificationSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			utils.Error(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(images) == 0 {
+		images = []string{}
+	} else {
+		// The service must not time out before the update finished.
+		tracker := r.Context().Value(api.IdleTrackerKey).(*idle.Tracker)
+		tracker.Hold()
+		go func() {
+			defer tracker.Release()
+			autoUpdateLock.Lock()
+			defer autoUpdateLock.Unlock()
+
+			containerEngine := abi.ContainerEngine{Libpod: runtime}
+			options := entities.AutoUpdateOptions{
+				Images:   images,
+				Rollback: true,
+			}
+			reports, errs := containerEngine.AutoUpdate(context.Background(), options)
+			for _, report := range reports {
+				logrus.Infof("Auto-update of container %s in unit %s triggered by registry notification: %s", report.ContainerName, report.SystemdUnit, report.Updated)
+			}
+			for _, err := range errs {
+				logrus.Errorf("Auto-update triggered by registry notification for %v: %v", images, err)
+			}
+		}()
+	}
+
+	utils.WriteResponse(w, http.StatusAccepted, images)
+}
+
+// AutoUpdate auto-updates the containers according to their auto-update
+// policy.  Errors of single containers or units do not fail the request but
+// are returned along with the reports.
+func AutoUpdate(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		DryRun        bool               `schema:"dryRun"`
+		Images        []string           `schema:"images"`
+		Rollback      bool               `schema:"rollback"`
+		Staged        bool               `schema:"staged"`
+		StagedTimeout string             `schema:"stagedTimeout"`
+		TLSVerify     types.OptionalBool `schema:"tlsVerify"`
+	}{
+		Rollback:      true,
+		StagedTimeout: "1m",
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	stagedTimeout, err := time.ParseDuration(query.StagedTimeout)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid stagedTimeout %q: %w", query.StagedTimeout, err))
+		return
+	}
+
+	options := entities.AutoUpdateOptions{
+		DryRun:        query.DryRun,
+		Images:        query.Images,
+		Rollback:      query.Rollback,
+		Staged:        query.Staged,
+		StagedTimeout: stagedTimeout,
+	}
+
+	// If TLS verification is explicitly specified (True or False) in the query,
+	// set the InsecureSkipTLSVerify option accordingly.
+	switch query.TLSVerify {
+	case types.OptionalBoolTrue:
+		options.InsecureSkipTLSVerify = types.NewOptionalBool(false)
+	case types.OptionalBoolFalse:
+		options.InsecureSkipTLSVerify = types.NewOptionalBool(true)
+	}
+
+	_, authfile, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+	options.Authfile = authfile
+
+	autoUpdateLock.Lock()
+	defer autoUpdateLock.Unlock()
+
+	// Do not abort restarting the units halfway if the client goes away.
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	reports, errs := containerEngine.AutoUpdate(context.WithoutCancel(r.Context()), options)
+
+	response := entities.AutoUpdateResponse{Reports: reports}
+	for _, err := range errs {
+		response.Errors = append(response.Errors, err.Error())
+	}
+	utils.WriteResponse(w, http.StatusOK, response)
+}
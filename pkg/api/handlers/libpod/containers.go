@@ -10,16 +10,19 @@ import (
 	"os"
 	"strings"
 
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/api/handlers"
 	"github.com/containers/podman/v5/pkg/api/handlers/compat"
 	"github.com/containers/podman/v5/pkg/api/handlers/utils"
 	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/auth"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/podman/v5/pkg/util"
+	"github.com/containers/storage"
 	"github.com/gorilla/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -494,3 +497,105 @@ func ShouldRestart(w http.ResponseWriter, r *http.Request) {
 		utils.ContainerNotFound(w, name, define.ErrNoSuchCtr)
 	}
 }
+
+// CloneContainer creates a copy of the container, optionally destroying the
+// original one and starting the copy.
+func CloneContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	// override the golang type defaults with the ones of the CLI
+	options := entities.ContainerCloneOptions{
+		CreateOpts: entities.ContainerCreateOptions{
+			MemorySwappiness: -1,
+			ReadWriteTmpFS:   true,
+			SdNotifyMode:     define.SdNotifyModeContainer,
+			SeccompPolicy:    "default",
+			Systemd:          "true",
+		},
+	}
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("decode(): %w", err))
+		return
+	}
+	if options.Force && !options.Destroy {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("cannot set force without destroy: %w", define.ErrInvalidArg))
+		return
+	}
+	options.ID = name
+	options.CreateOpts.IsClone = true
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.ContainerClone(r.Context(), options)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusCreated, entities.ContainerCreateResponse{ID: report.Id, Warnings: []string{}})
+}
+
+// RunlabelContainer resolves the runlabel of the image into the command to
+// execute.  The image is pulled if needed but the command is not executed.
+func RunlabelContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		Args      []string           `schema:"args"`
+		Image     string             `schema:"image"`
+		Label     string             `schema:"label"`
+		Name      string             `schema:"name"`
+		Pull      bool               `schema:"pull"`
+		TLSVerify types.OptionalBool `schema:"tlsVerify"`
+	}{
+		Pull: true,
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Label == "" || query.Image == "" {
+		utils.Error(w, http.StatusBadRequest, errors.New("label and image must be specified"))
+		return
+	}
+
+	options := entities.ContainerRunlabelOptions{
+		Name:  query.Name,
+		Pull:  query.Pull,
+		Quiet: true,
+	}
+
+	// If TLS verification is explicitly specified (True or False) in the query,
+	// set the SkipTLSVerify option accordingly.
+	switch query.TLSVerify {
+	case types.OptionalBoolTrue:
+		options.SkipTLSVerify = types.NewOptionalBool(false)
+	case types.OptionalBoolFalse:
+		options.SkipTLSVerify = types.NewOptionalBool(true)
+	}
+
+	_, authfile, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+	options.Authfile = authfile
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.ContainerRunlabelCommand(r.Context(), query.Label, query.Image, query.Args, options)
+	if err != nil {
+		var notFound *entities.RunlabelNotFoundError
+		switch {
+		case errors.Is(err, storage.ErrImageUnknown):
+			utils.ImageNotFound(w, query.Image, err)
+		case errors.As(err, &notFound):
+			utils.Error(w, http.StatusBadRequest, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
@@ -102,6 +102,7 @@ func GenerateKube(w http.ResponseWriter, r *http.Request) {
 		Service    bool     `schema:"service"`
 		Type       string   `schema:"type"`
 		Replicas   int32    `schema:"replicas"`
+		Schedule   string   `schema:"schedule"`
 		NoTrunc    bool     `schema:"noTrunc"`
 	}{
 		// Defaults would go here.
@@ -130,6 +131,7 @@ func GenerateKube(w http.ResponseWriter, r *http.Request) {
 		Service:            query.Service,
 		Type:               generateType,
 		Replicas:           query.Replicas,
+		Schedule:           query.Schedule,
 		UseLongAnnotations: query.NoTrunc,
 	}
 	report, err := containerEngine.GenerateKube(r.Context(), query.Names, options)
@@ -3,18 +3,38 @@
 package libpod
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/api/handlers/utils"
 	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/gorilla/schema"
 )
 
 func RunHealthCheck(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Readiness bool `schema:"readiness"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
 	name := utils.GetName(r)
-	status, err := runtime.HealthCheck(r.Context(), name)
+	var (
+		status define.HealthCheckStatus
+		ready  bool
+		err    error
+	)
+	if query.Readiness {
+		ready, status, err = runtime.ReadinessCheck(r.Context(), name)
+	} else {
+		status, err = runtime.HealthCheck(r.Context(), name)
+	}
 	if err != nil {
 		if status == define.HealthCheckContainerNotFound {
 			utils.ContainerNotFound(w, name, err)
@@ -34,5 +54,11 @@ func RunHealthCheck(w http.ResponseWriter, r *http.Request) {
 	report := define.HealthCheckResults{
 		Status: status.String(),
 	}
+	if query.Readiness {
+		report.Status = define.ReadinessCheckNotReady
+		if ready {
+			report.Status = define.ReadinessCheckReady
+		}
+	}
 	utils.WriteResponse(w, http.StatusOK, report)
 }
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/containers/storage/pkg/archive"
 
@@ -122,6 +123,8 @@ func KubePlay(w http.ResponseWriter, r *http.Request) {
 		TLSVerify        bool              `schema:"tlsVerify"`
 		Userns           string            `schema:"userns"`
 		Wait             bool              `schema:"wait"`
+		WaitReady        bool              `schema:"waitReady"`
+		WaitReadyTimeout string            `schema:"waitReadyTimeout"`
 		Build            bool              `schema:"build"`
 	}{
 		TLSVerify: true,
@@ -133,6 +136,15 @@ func KubePlay(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	waitReadyTimeout := 5 * time.Minute
+	if query.WaitReadyTimeout != "" {
+		waitReadyTimeout, err = time.ParseDuration(query.WaitReadyTimeout)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid waitReadyTimeout: %w", err))
+			return
+		}
+	}
+
 	staticIPs := make([]net.IP, 0, len(query.StaticIPs))
 	for _, ipString := range query.StaticIPs {
 		ip := net.ParseIP(ipString)
@@ -197,6 +209,8 @@ func KubePlay(w http.ResponseWriter, r *http.Request) {
 		Username:           username,
 		Userns:             query.Userns,
 		Wait:               query.Wait,
+		WaitReady:          query.WaitReady,
+		WaitReadyTimeout:   waitReadyTimeout,
 		ContextDir:         contextDirectory,
 	}
 	if _, found := r.URL.Query()["build"]; found {
@@ -268,3 +282,43 @@ func KubeApply(w http.ResponseWriter, r *http.Request) {
 
 	utils.WriteResponse(w, http.StatusOK, "Deployed!")
 }
+
+func KubeScale(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Replicas int32 `schema:"replicas"`
+	}{
+		Replicas: -1,
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Replicas < 0 {
+		utils.Error(w, http.StatusBadRequest, errors.New("replicas must be set to a non-negative number"))
+		return
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.KubeScale(r.Context(), r.Body, entities.KubeScaleOptions{Replicas: query.Replicas})
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("scaling YAML file: %w", err))
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
+
+func KubeDiff(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	// The configMaps are part of the YAML of the request.
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.KubeDiff(r.Context(), r.Body, entities.KubeDiffOptions{})
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("comparing YAML file: %w", err))
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
@@ -249,3 +249,26 @@ func Prune(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusOK, pruneReports)
 }
+
+// ReloadNetwork reloads the network configuration of the container, which
+// restores its firewall rules.
+func ReloadNetwork(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	reports, err := ic.NetworkReload(r.Context(), []string{name}, entities.NetworkReloadOptions{})
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+	if err := reports[0].Err; err != nil {
+		if errors.Is(err, define.ErrCtrStateInvalid) || errors.Is(err, define.ErrNetworkModeInvalid) {
+			utils.Error(w, http.StatusConflict, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, reports[0])
+}
@@ -0,0 +1,44 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/infra/abi"
+	"github.com/containers/podman/v5/pkg/util"
+)
+
+func ListPlugins(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	filterMap, err := util.PrepareFilters(r)
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	plugins, err := ic.PluginList(r.Context(), entities.PluginListOptions{Filters: *filterMap})
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, plugins)
+}
+
+func InspectPlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+	plugin, err := runtime.VolumePlugin(name)
+	if err != nil {
+		utils.PluginNotFound(w, name, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, plugin)
+}
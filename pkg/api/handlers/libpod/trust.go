@@ -0,0 +1,83 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/infra/abi"
+	"github.com/gorilla/schema"
+)
+
+// ShowTrust returns the trust policy and the signature stores of the
+// registries.
+func ShowTrust(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		PolicyPath   string `schema:"policyPath"`
+		Raw          bool   `schema:"raw"`
+		RegistryPath string `schema:"registryPath"`
+	}{}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	options := entities.ShowTrustOptions{
+		PolicyPath:   query.PolicyPath,
+		Raw:          query.Raw,
+		RegistryPath: query.RegistryPath,
+	}
+	report, err := imageEngine.ShowTrust(r.Context(), nil, options)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
+
+// SetTrust sets the default trust policy or the trust policy of a registry.
+func SetTrust(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		PolicyPath  string   `schema:"policyPath"`
+		PubKeysFile []string `schema:"pubKeysFile"`
+		Scope       string   `schema:"scope"`
+		Type        string   `schema:"type"`
+	}{
+		Type: "signedBy",
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Scope == "" {
+		utils.Error(w, http.StatusBadRequest, errors.New("scope parameter is required"))
+		return
+	}
+
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	options := entities.SetTrustOptions{
+		PolicyPath:  query.PolicyPath,
+		PubKeysFile: query.PubKeysFile,
+		Type:        query.Type,
+	}
+	if err := imageEngine.SetTrust(r.Context(), []string{query.Scope}, options); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
@@ -215,3 +215,41 @@ func ExistsVolume(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusNoContent, "")
 }
+
+// ExportVolume streams the content of the volume as a tar archive.
+func ExportVolume(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+	if _, err := runtime.GetVolume(name); err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+
+	// set the correct header
+	w.Header().Set("Content-Type", "application/x-tar")
+	// NOTE: As described in w.Write() it automatically sets the http code to
+	// 200 on first write if no other code was set.
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	if err := ic.VolumeExport(r.Context(), name, entities.VolumeExportOptions{Output: w}); err != nil {
+		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("failed to export volume: %w", err))
+		return
+	}
+}
+
+// ImportVolume extracts the tar archive of the request body into the volume.
+func ImportVolume(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+	if _, err := runtime.GetVolume(name); err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	if err := ic.VolumeImport(r.Context(), name, entities.VolumeImportOptions{Input: r.Body}); err != nil {
+		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("failed to import volume: %w", err))
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
@@ -65,6 +65,13 @@ type volumeNotFound struct {
 	Body errorhandling.ErrorModel
 }
 
+// No such plugin
+// swagger:response
+type pluginNotFound struct {
+	// in:body
+	Body errorhandling.ErrorModel
+}
+
 // No such pod
 // swagger:response
 type podNotFound struct {
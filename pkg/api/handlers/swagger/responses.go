@@ -11,9 +11,11 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/inspect"
+	docker "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	dockerImage "github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/api/types/volume"
 )
 
@@ -38,6 +40,13 @@ type imageInspect struct {
 	Body handlers.ImageInspect
 }
 
+// Distribution Inspect
+// swagger:response
+type distributionInspect struct {
+	// in:body
+	Body registry.DistributionInspect
+}
+
 // Image Load
 // swagger:response
 type imagesLoadResponseLibpod struct {
@@ -80,6 +89,13 @@ type playKubeResponseLibpod struct {
 	Body entities.PlayKubeReport
 }
 
+// KubeDiff response
+// swagger:response
+type kubeDiffResponseLibpod struct {
+	// in:body
+	Body entities.KubeDiffReport
+}
+
 // Image Delete
 // swagger:response
 type imageDeleteResponse struct {
@@ -275,6 +291,34 @@ type volumeListLibpod struct {
 	Body []entities.VolumeConfigResponse
 }
 
+// Plugin list
+// swagger:response
+type pluginList struct {
+	// in:body
+	Body []docker.Plugin
+}
+
+// Plugin inspect
+// swagger:response
+type pluginInspect struct {
+	// in:body
+	Body docker.Plugin
+}
+
+// Volume plugin list
+// swagger:response
+type pluginListLibpod struct {
+	// in:body
+	Body []entities.PluginReport
+}
+
+// Volume plugin inspect
+// swagger:response
+type pluginInspectLibpod struct {
+	// in:body
+	Body entities.PluginReport
+}
+
 // Image Prune
 // swagger:response
 type imagesPruneLibpod struct {
@@ -324,6 +368,13 @@ type containerCreateResponse struct {
 	Body entities.ContainerCreateResponse
 }
 
+// Runlabel command
+// swagger:response
+type containerRunlabelResponse struct {
+	// in:body
+	Body entities.ContainerRunlabelReport
+}
+
 // Update container
 // swagger:response
 type containerUpdateResponse struct {
@@ -479,6 +530,13 @@ type networkPruneResponse struct {
 	Body []entities.NetworkPruneReport
 }
 
+// Network reload
+// swagger:response
+type networkReloadResponse struct {
+	// in:body
+	Body entities.NetworkReloadReport
+}
+
 // Inspect Artifact
 // swagger:response
 type inspectArtifactResponse struct {
@@ -520,3 +578,17 @@ type artifactPushResponse struct {
 	// in:body
 	Body entities.ArtifactPushReport
 }
+
+// Auto update
+// swagger:response
+type autoUpdateResponse struct {
+	// in:body
+	Body entities.AutoUpdateResponse
+}
+
+// Trust show
+// swagger:response
+type trustShowResponse struct {
+	// in:body
+	Body entities.ShowTrustReport
+}
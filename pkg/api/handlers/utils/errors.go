@@ -43,6 +43,14 @@ func VolumeNotFound(w http.ResponseWriter, name string, err error) {
 	InternalServerError(w, err)
 }
 
+func PluginNotFound(w http.ResponseWriter, name string, err error) {
+	if errors.Is(err, define.ErrMissingPlugin) {
+		Error(w, http.StatusNotFound, err)
+		return
+	}
+	InternalServerError(w, err)
+}
+
 func ContainerNotFound(w http.ResponseWriter, name string, err error) {
 	if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrExists) {
 		Error(w, http.StatusNotFound, err)
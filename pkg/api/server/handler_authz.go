@@ -0,0 +1,268 @@
+//go:build !remote
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/plugin"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	"github.com/containers/podman/v5/pkg/api/types"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+// maxAuthZBodySize is the maximum size of request bodies sent to
+// authorization plugins, larger bodies are not sent.
+const maxAuthZBodySize = 1024 * 1024
+
+// authZHandler only serves requests allowed by all plugins and only sends
+// responses allowed by all plugins.  The request is denied if a plugin fails
+// to process it.
+func authZHandler(plugins []*plugin.AuthZPlugin) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		if len(plugins) == 0 {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authZReq, err := newAuthZRequest(r)
+			if err != nil {
+				utils.InternalServerError(w, fmt.Errorf("preparing authorization request: %w", err))
+				return
+			}
+			for _, p := range plugins {
+				authZResp, err := p.AuthZRequest(authZReq)
+				if err != nil {
+					utils.InternalServerError(w, err)
+					return
+				}
+				if !authZResp.Allow {
+					logrus.Debugf("Authorization plugin %s denied %s %s for user %q", p.Name, r.Method, r.URL.Path, authZReq.User)
+					utils.Error(w, http.StatusForbidden, fmt.Errorf("authorization denied by plugin %s: %s", p.Name, authZResp.Msg))
+					return
+				}
+			}
+
+			aw := &authZResponseWriter{ResponseWriter: w, header: w.Header().Clone(), authorize: func(status int, header http.Header, body []byte) (int, error) {
+				authZReq.ResponseStatusCode = status
+				authZReq.ResponseHeaders = make(map[string]string, len(header))
+				for key := range header {
+					authZReq.ResponseHeaders[key] = header.Get(key)
+				}
+				authZReq.ResponseBody = body
+				for _, p := range plugins {
+					authZResp, err := p.AuthZResponse(authZReq)
+					if err != nil {
+						return http.StatusInternalServerError, err
+					}
+					if !authZResp.Allow {
+						logrus.Debugf("Authorization plugin %s denied the response to %s %s for user %q", p.Name, r.Method, r.URL.Path, authZReq.User)
+						return http.StatusForbidden, fmt.Errorf("authorization denied by plugin %s: %s", p.Name, authZResp.Msg)
+					}
+				}
+				return 0, nil
+			}}
+			h.ServeHTTP(aw, r)
+			_ = aw.commit(true)
+		})
+	}
+}
+
+// authZResponseWriter holds back the response until the authorization
+// plugins allowed it, so that denied responses are never sent.  The plugins
+// are asked once the handler returned, flushed or wrote more than
+// maxAuthZBodySize bytes.  Only complete JSON bodies are sent to the plugins,
+// streamed responses are authorized by their status and headers.  Hijacked
+// connections are not subject to the authorization of responses.
+type authZResponseWriter struct {
+	http.ResponseWriter
+	// authorize returns the status code and error to send instead of a
+	// denied response.
+	authorize func(status int, header http.Header, body []byte) (int, error)
+	// header are the headers set before the handler was called.
+	header    http.Header
+	status    int
+	body      bytes.Buffer
+	committed bool
+	// err is set if the response was denied.
+	err error
+}
+
+func (w *authZResponseWriter) WriteHeader(statusCode int) {
+	if w.committed {
+		if w.err == nil {
+			w.ResponseWriter.WriteHeader(statusCode)
+		}
+		return
+	}
+	if w.status == 0 {
+		w.status = statusCode
+	}
+}
+
+func (w *authZResponseWriter) Write(b []byte) (int, error) {
+	if !w.committed {
+		if w.body.Len()+len(b) <= maxAuthZBodySize {
+			if w.status == 0 {
+				w.status = http.StatusOK
+			}
+			return w.body.Write(b)
+		}
+		if err := w.commit(false); err != nil {
+			return 0, err
+		}
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *authZResponseWriter) Flush() {
+	if err := w.commit(false); err != nil {
+		return
+	}
+	if wrapped, ok := w.ResponseWriter.(http.Flusher); ok {
+		wrapped.Flush()
+	}
+}
+
+func (w *authZResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	wrapped, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("ResponseWriter does not support hijacking")
+	}
+	w.committed = true
+	return wrapped.Hijack()
+}
+
+// commit asks the plugins whether the response is allowed and sends what was
+// held back of it, or an error if it is denied.  complete is true if the
+// handler returned.
+func (w *authZResponseWriter) commit(complete bool) error {
+	if w.committed {
+		return w.err
+	}
+	w.committed = true
+
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	var body []byte
+	if complete && sendAuthZResponseBody(w.Header()) {
+		body = w.body.Bytes()
+	}
+	if errStatus, err := w.authorize(status, w.Header(), body); err != nil {
+		w.err = err
+		// None of the headers set by the handler must be sent.
+		for key := range w.Header() {
+			delete(w.Header(), key)
+		}
+		for key, values := range w.header {
+			w.Header()[key] = values
+		}
+		utils.Error(w.ResponseWriter, errStatus, err)
+		return err
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+	if w.body.Len() > 0 {
+		if _, err := w.ResponseWriter.Write(w.body.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newAuthZRequest describes r to authorization plugins.  The body of r is
+// restored after reading it.
+func newAuthZRequest(r *http.Request) (*plugin.AuthZRequest, error) {
+	authZReq := &plugin.AuthZRequest{
+		RequestMethod:  r.Method,
+		RequestURI:     r.URL.RequestURI(),
+		RequestHeaders: make(map[string]string, len(r.Header)),
+	}
+	for key := range r.Header {
+		switch key {
+		// Do not hand out credentials.
+		case "Authorization", "X-Registry-Auth", "X-Registry-Config":
+			continue
+		}
+		authZReq.RequestHeaders[key] = r.Header.Get(key)
+	}
+
+	if conn, ok := r.Context().Value(types.ConnKey).(net.Conn); ok {
+		switch c := conn.(type) {
+		case *tls.Conn:
+			certs := c.ConnectionState().PeerCertificates
+			if len(certs) > 0 {
+				authZReq.User = certs[0].Subject.CommonName
+				authZReq.UserAuthNMethod = "TLS"
+			}
+			for _, cert := range certs {
+				authZReq.RequestPeerCertificates = append(authZReq.RequestPeerCertificates, cert.Raw)
+			}
+		case *net.UnixConn:
+			if user, ok := peerUser(c); ok {
+				authZReq.User = user
+				authZReq.UserAuthNMethod = "peercred"
+			}
+		}
+	}
+
+	if sendAuthZBody(r) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxAuthZBodySize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(body) <= maxAuthZBodySize {
+			authZReq.RequestBody = body
+		}
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	}
+	return authZReq, nil
+}
+
+// sendAuthZResponseBody returns true if the body of a response with the
+// given headers is sent to authorization plugins, i.e., it is JSON.
+func sendAuthZResponseBody(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json"
+}
+
+// sendAuthZBody returns true if the body of r is sent to authorization
+// plugins, i.e., it is JSON not carrying credentials.
+func sendAuthZBody(r *http.Request) bool {
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength > maxAuthZBodySize {
+		return false
+	}
+	if strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/auth") {
+		return false
+	}
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		// podman-remote does not always set a Content-Type
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json"
+}
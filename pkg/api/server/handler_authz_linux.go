@@ -0,0 +1,36 @@
+//go:build !remote
+
+package server
+
+import (
+	"net"
+	"os/user"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// peerUser returns the name of the user on the other end of conn.
+func peerUser(conn *net.UnixConn) (string, bool) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		logrus.Debugf("Getting raw connection for peer credentials: %v", err)
+		return "", false
+	}
+	var (
+		cred    *unix.Ucred
+		credErr error
+	)
+	if err := rawConn.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil || credErr != nil {
+		logrus.Debugf("Getting peer credentials: %v %v", err, credErr)
+		return "", false
+	}
+	uid := strconv.FormatUint(uint64(cred.Uid), 10)
+	if u, err := user.LookupId(uid); err == nil {
+		return u.Username, true
+	}
+	return uid, true
+}
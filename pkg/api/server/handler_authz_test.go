@@ -0,0 +1,147 @@
+//go:build !remote
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/plugin"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startAuthZPlugin starts a stub authorization plugin denying privileged
+// container creation and failing on requests to /fail.  Responses carrying a
+// secret or an X-Deny header are denied.
+func startAuthZPlugin(t *testing.T) string {
+	socketPath := filepath.Join(t.TempDir(), "stub.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Plugin.Activate", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"Implements": ["authz"]}`))
+	})
+	mux.HandleFunc("/AuthZPlugin.AuthZReq", func(w http.ResponseWriter, r *http.Request) {
+		var req plugin.AuthZRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := plugin.AuthZResponse{Allow: true}
+		switch {
+		case strings.HasSuffix(req.RequestURI, "/fail"):
+			resp = plugin.AuthZResponse{Err: "stub failure"}
+		case strings.Contains(string(req.RequestBody), `"privileged":true`):
+			resp = plugin.AuthZResponse{Msg: "privileged containers are not allowed"}
+		case req.RequestHeaders["X-Registry-Auth"] != "":
+			resp = plugin.AuthZResponse{Msg: "credentials were sent"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/AuthZPlugin.AuthZRes", func(w http.ResponseWriter, r *http.Request) {
+		var req plugin.AuthZRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := plugin.AuthZResponse{Allow: true}
+		switch {
+		case strings.Contains(string(req.ResponseBody), `"secret"`):
+			resp = plugin.AuthZResponse{Msg: "secrets must not be returned"}
+		case req.ResponseHeaders["X-Deny"] != "":
+			resp = plugin.AuthZResponse{Msg: "response denied"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { server.Close() })
+	return socketPath
+}
+
+func TestAuthZHandler(t *testing.T) {
+	p, err := plugin.GetAuthZPlugin(startAuthZPlugin(t), 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "stub", p.Name)
+
+	router := mux.NewRouter()
+	router.Use(authZHandler([]*plugin.AuthZPlugin{p}))
+	router.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("deny") {
+			w.Header().Set("X-Deny", "1")
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("streamed"))
+	})
+	router.HandleFunc("/{path:.*}", func(w http.ResponseWriter, r *http.Request) {
+		// The handler must still see the complete body.
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.Copy(w, r.Body)
+	})
+
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		header string
+		code   int
+		output string
+	}{
+		{"allowed", "/containers/create", `{"image":"alpine"}`, "", http.StatusOK, `{"image":"alpine"}`},
+		{"denied", "/containers/create", `{"image":"alpine","privileged":true}`, "", http.StatusForbidden, "authorization denied by plugin stub: privileged containers are not allowed"},
+		{"plugin error", "/fail", "", "", http.StatusInternalServerError, "authorization plugin stub failed with error: stub failure"},
+		{"credentials", "/images/pull", "", "secret", http.StatusOK, ""},
+		{"response denied", "/secrets/create", `{"secret":"data"}`, "", http.StatusForbidden, "authorization denied by plugin stub: secrets must not be returned"},
+		{"streamed", "/stream", "", "", http.StatusAccepted, "streamed"},
+		{"streamed response denied", "/stream?deny", "", "", http.StatusForbidden, "authorization denied by plugin stub: response denied"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.header != "" {
+				req.Header.Set("X-Registry-Auth", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			assert.Equal(t, tt.code, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.output)
+			if tt.code == http.StatusForbidden {
+				assert.NotContains(t, rec.Body.String(), "data")
+				assert.NotContains(t, rec.Body.String(), "streamed")
+				assert.Empty(t, rec.Header().Get("X-Deny"))
+			}
+		})
+	}
+}
+
+func TestGetAuthZPluginNotAuthZ(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "volume.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"Implements": ["VolumeDriver"]}`))
+		}),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	_, err = plugin.GetAuthZPlugin(socketPath, 5*time.Second)
+	assert.ErrorIs(t, err, plugin.ErrNotAuthZPlugin)
+
+	_, err = plugin.GetAuthZPlugin(filepath.Join(t.TempDir(), "missing.sock"), 5*time.Second)
+	assert.ErrorContains(t, err, "cannot access plugin missing socket")
+}
@@ -0,0 +1,10 @@
+//go:build !remote && !linux
+
+package server
+
+import "net"
+
+// peerUser returns the name of the user on the other end of conn.
+func peerUser(_ *net.UnixConn) (string, bool) {
+	return "", false
+}
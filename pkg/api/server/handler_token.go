@@ -0,0 +1,29 @@
+//go:build !remote
+
+package server
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	"github.com/gorilla/mux"
+)
+
+// tokenHandler rejects requests not carrying the token in an
+// "Authorization: Bearer" header.  The token must not be empty.
+func tokenHandler(token string) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				utils.Error(w, http.StatusUnauthorized, errors.New("invalid or missing bearer token"))
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
@@ -93,6 +93,23 @@ func (t *Tracker) Close() {
 	t.ConnState(nil, http.StateClosed)
 }
 
+// Hold is used by handlers to keep the server from timing out while they
+// work in the background after their response was sent.  The work is counted
+// as a StateHijacked connection, Release must be called when it finished.
+func (t *Tracker) Hold() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.hijacked++
+	t.timer.Stop()
+}
+
+// Release is used to update Tracker that the background work of a handler
+// registered with Hold() has finished
+func (t *Tracker) Release() {
+	t.Close()
+}
+
 // ActiveConnections returns the number of current managed or StateHijacked connections
 func (t *Tracker) ActiveConnections() int {
 	return len(t.managed) + t.hijacked
@@ -0,0 +1,502 @@
+//go:build !remote
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/infra/abi"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+// requestDurationBuckets are the upper bounds, in seconds, of the buckets of
+// the API request latency histogram.
+var requestDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// diskUsageInterval is the interval the disk usage metrics of images and
+// volumes are refreshed at.  Computing the disk usage walks the storage,
+// which is too expensive to do on every scrape.
+const diskUsageInterval = 5 * time.Minute
+
+// metricFamily is a metric in the Prometheus text exposition format.
+type metricFamily struct {
+	name    string
+	help    string
+	typ     string
+	samples []metricSample
+}
+
+type metricSample struct {
+	// suffix is appended to the name of the family, e.g. "_bucket"
+	suffix string
+	// labels are pairs of label names and values
+	labels []string
+	value  float64
+}
+
+func (f *metricFamily) add(value float64, labels ...string) {
+	f.samples = append(f.samples, metricSample{labels: labels, value: value})
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// writeMetrics writes families in the Prometheus text exposition format.
+// Families without samples are omitted.
+func writeMetrics(w io.Writer, families []*metricFamily) error {
+	var b strings.Builder
+	for _, f := range families {
+		if len(f.samples) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.typ)
+		for _, s := range f.samples {
+			b.WriteString(f.name + s.suffix)
+			if len(s.labels) > 0 {
+				b.WriteByte('{')
+				for i := 0; i+1 < len(s.labels); i += 2 {
+					if i > 0 {
+						b.WriteByte(',')
+					}
+					fmt.Fprintf(&b, `%s="%s"`, s.labels[i], labelValueEscaper.Replace(s.labels[i+1]))
+				}
+				b.WriteByte('}')
+			}
+			b.WriteByte(' ')
+			b.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+			b.WriteByte('\n')
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// requestKey identifies a series of the API request latency histogram.
+type requestKey struct {
+	method string
+	path   string
+	code   string
+}
+
+type requestSeries struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+// requestHistogram records the latency of API requests.
+type requestHistogram struct {
+	lock   sync.Mutex
+	series map[requestKey]*requestSeries
+}
+
+func newRequestHistogram() *requestHistogram {
+	return &requestHistogram{series: make(map[requestKey]*requestSeries)}
+}
+
+func (h *requestHistogram) observe(key requestKey, duration time.Duration) {
+	seconds := duration.Seconds()
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	series, ok := h.series[key]
+	if !ok {
+		series = &requestSeries{buckets: make([]uint64, len(requestDurationBuckets))}
+		h.series[key] = series
+	}
+	for i, upper := range requestDurationBuckets {
+		if seconds <= upper {
+			series.buckets[i]++
+		}
+	}
+	series.count++
+	series.sum += seconds
+}
+
+func (h *requestHistogram) family() *metricFamily {
+	family := &metricFamily{
+		name: "podman_api_request_duration_seconds",
+		help: "Latency of API requests.",
+		typ:  "histogram",
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	keys := make([]requestKey, 0, len(h.series))
+	for key := range h.series {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].path != keys[j].path {
+			return keys[i].path < keys[j].path
+		}
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].code < keys[j].code
+	})
+	for _, key := range keys {
+		series := h.series[key]
+		labels := []string{"method", key.method, "path", key.path, "code", key.code}
+		for i, upper := range requestDurationBuckets {
+			family.samples = append(family.samples, metricSample{
+				suffix: "_bucket",
+				labels: append(labels[:len(labels):len(labels)], "le", strconv.FormatFloat(upper, 'g', -1, 64)),
+				value:  float64(series.buckets[i]),
+			})
+		}
+		family.samples = append(family.samples,
+			metricSample{suffix: "_bucket", labels: append(labels[:len(labels):len(labels)], "le", "+Inf"), value: float64(series.count)},
+			metricSample{suffix: "_sum", labels: labels, value: series.sum},
+			metricSample{suffix: "_count", labels: labels, value: float64(series.count)},
+		)
+	}
+	return family
+}
+
+// diskUsageCache caches the disk usage metrics of images and volumes for
+// diskUsageInterval.
+type diskUsageCache struct {
+	lock     sync.Mutex
+	families []*metricFamily
+	updated  time.Time
+}
+
+// get returns the cached metrics or refreshes them with collect once they
+// are older than diskUsageInterval.  Concurrent scrapes wait for a single
+// refresh.
+func (c *diskUsageCache) get(collect func() ([]*metricFamily, error)) ([]*metricFamily, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.families != nil && time.Since(c.updated) < diskUsageInterval {
+		return c.families, nil
+	}
+	families, err := collect()
+	if err != nil {
+		return nil, err
+	}
+	c.families = families
+	c.updated = time.Now()
+	return families, nil
+}
+
+// metricsHandler records the latency of requests in h.  Requests are
+// identified by the path template of their route without the API version.
+func metricsHandler(h *requestHistogram) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if h == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			mw := &metricsResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(mw, r)
+
+			path := r.URL.Path
+			if route := mux.CurrentRoute(r); route != nil {
+				if tmpl, err := route.GetPathTemplate(); err == nil {
+					path = strings.TrimPrefix(tmpl, VersionedPath(""))
+				}
+			}
+			h.observe(requestKey{method: r.Method, path: path, code: strconv.Itoa(mw.code)}, time.Since(start))
+		})
+	}
+}
+
+// metricsResponseWriter records the status code of a response.
+type metricsResponseWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *metricsResponseWriter) WriteHeader(statusCode int) {
+	w.code = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *metricsResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if wrapped, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return wrapped.Hijack()
+	}
+	return nil, nil, errors.New("ResponseWriter does not support hijacking")
+}
+
+func (w *metricsResponseWriter) Flush() {
+	if wrapped, ok := w.ResponseWriter.(http.Flusher); ok {
+		wrapped.Flush()
+	}
+}
+
+// setupMetrics enables the endpoint serving Prometheus metrics of the
+// containers, pods, images and volumes as well as of the API service.  The
+// endpoint is served on a separate address to not expose the API to the
+// scrapers.
+//
+// Example:
+// curl localhost:9882/metrics
+func (s *APIServer) setupMetrics() error {
+	if s.MetricsAddr == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.MetricsAddr)
+	if err != nil {
+		return fmt.Errorf("unable to create metrics socket %v: %w", s.MetricsAddr, err)
+	}
+	logrus.Infof("Metrics endpoint listening on %q", listener.Addr())
+
+	router := mux.NewRouter()
+	router.Use(panicHandler())
+	router.HandleFunc("/metrics", s.serveMetrics).Methods(http.MethodGet)
+
+	s.metricsServer = &http.Server{
+		BaseContext: s.Server.BaseContext,
+		ErrorLog:    s.Server.ErrorLog,
+		Handler:     router,
+	}
+	go func() {
+		err := s.metricsServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			logrus.Warnf("Metrics endpoint failed: %v", err)
+		}
+	}()
+	return nil
+}
+
+func (s *APIServer) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	families, err := collectMetrics(runtime)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	diskUsage, err := s.diskUsage.get(func() ([]*metricFamily, error) {
+		return collectDiskUsageMetrics(r.Context(), runtime)
+	})
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	families = append(families, diskUsage...)
+	families = append(families, s.requestHistogram.family())
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := writeMetrics(w, families); err != nil {
+		logrus.Errorf("Writing metrics: %v", err)
+	}
+}
+
+// collectMetrics returns the metrics of the containers and pods of runtime.
+// The container metrics follow the naming of cAdvisor.
+func collectMetrics(runtime *libpod.Runtime) ([]*metricFamily, error) {
+	var (
+		cpu          = &metricFamily{name: "container_cpu_usage_seconds_total", help: "Cumulative cpu time consumed in seconds.", typ: "counter"}
+		memory       = &metricFamily{name: "container_memory_usage_bytes", help: "Current memory usage in bytes.", typ: "gauge"}
+		memoryLimit  = &metricFamily{name: "container_spec_memory_limit_bytes", help: "Memory limit of the container in bytes.", typ: "gauge"}
+		fsReads      = &metricFamily{name: "container_fs_reads_bytes_total", help: "Cumulative count of bytes read.", typ: "counter"}
+		fsWrites     = &metricFamily{name: "container_fs_writes_bytes_total", help: "Cumulative count of bytes written.", typ: "counter"}
+		rxBytes      = &metricFamily{name: "container_network_receive_bytes_total", help: "Cumulative count of bytes received.", typ: "counter"}
+		rxPackets    = &metricFamily{name: "container_network_receive_packets_total", help: "Cumulative count of packets received.", typ: "counter"}
+		rxErrors     = &metricFamily{name: "container_network_receive_errors_total", help: "Cumulative count of errors encountered while receiving.", typ: "counter"}
+		rxDropped    = &metricFamily{name: "container_network_receive_packets_dropped_total", help: "Cumulative count of packets dropped while receiving.", typ: "counter"}
+		txBytes      = &metricFamily{name: "container_network_transmit_bytes_total", help: "Cumulative count of bytes transmitted.", typ: "counter"}
+		txPackets    = &metricFamily{name: "container_network_transmit_packets_total", help: "Cumulative count of packets transmitted.", typ: "counter"}
+		txErrors     = &metricFamily{name: "container_network_transmit_errors_total", help: "Cumulative count of errors encountered while transmitting.", typ: "counter"}
+		txDropped    = &metricFamily{name: "container_network_transmit_packets_dropped_total", help: "Cumulative count of packets dropped while transmitting.", typ: "counter"}
+		pids         = &metricFamily{name: "container_processes", help: "Number of processes running inside the container.", typ: "gauge"}
+		state        = &metricFamily{name: "podman_container_state", help: "State of the container, the value is always 1.", typ: "gauge"}
+		restarts     = &metricFamily{name: "podman_container_restarts_total", help: "Number of times the container was restarted by its restart policy.", typ: "counter"}
+		health       = &metricFamily{name: "podman_container_health_status", help: "Healthcheck status of the container, 1 for the current status.", typ: "gauge"}
+		podCPU       = &metricFamily{name: "podman_pod_cpu_usage_seconds_total", help: "Cumulative cpu time consumed by the containers of the pod in seconds.", typ: "counter"}
+		podMemory    = &metricFamily{name: "podman_pod_memory_usage_bytes", help: "Current memory usage of the containers of the pod in bytes.", typ: "gauge"}
+		podFsReads   = &metricFamily{name: "podman_pod_fs_reads_bytes_total", help: "Cumulative count of bytes read by the containers of the pod.", typ: "counter"}
+		podFsWrites  = &metricFamily{name: "podman_pod_fs_writes_bytes_total", help: "Cumulative count of bytes written by the containers of the pod.", typ: "counter"}
+		podRxBytes   = &metricFamily{name: "podman_pod_network_receive_bytes_total", help: "Cumulative count of bytes received by the pod.", typ: "counter"}
+		podTxBytes   = &metricFamily{name: "podman_pod_network_transmit_bytes_total", help: "Cumulative count of bytes transmitted by the pod.", typ: "counter"}
+		podCtrs      = &metricFamily{name: "podman_pod_containers", help: "Number of containers of the pod by state.", typ: "gauge"}
+		podStats     = make(map[string]*define.ContainerStats)
+		podStates    = make(map[string]map[string]int)
+		podNames     = make(map[string]string)
+		podNetworkID = make(map[string]string)
+	)
+
+	pods, err := runtime.GetAllPods()
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		podNames[pod.ID()] = pod.Name()
+		podStats[pod.ID()] = &define.ContainerStats{Network: make(map[string]define.ContainerNetworkStats)}
+		podStates[pod.ID()] = make(map[string]int)
+		// The containers of a pod share the network namespace of the
+		// infra container, so their network statistics are the same.
+		if infraID, err := pod.InfraContainerID(); err == nil {
+			podNetworkID[pod.ID()] = infraID
+		}
+	}
+
+	ctrs, err := runtime.GetAllContainers()
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range ctrs {
+		status, err := ctr.State()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return nil, err
+		}
+		_, imageName := ctr.Image()
+		podID := ctr.PodID()
+		labels := []string{"id", ctr.ID(), "name", ctr.Name(), "image", imageName, "pod", podNames[podID]}
+
+		state.add(1, append(labels[:len(labels):len(labels)], "state", status.String())...)
+		if states, ok := podStates[podID]; ok {
+			states[status.String()]++
+		}
+
+		restartCount, err := ctr.RestartCount()
+		if err != nil {
+			logrus.Debugf("Getting restart count of container %s for metrics: %v", ctr.ID(), err)
+		} else {
+			restarts.add(float64(restartCount), labels...)
+		}
+
+		healthStatus, err := ctr.HealthCheckStatus()
+		if err != nil {
+			logrus.Debugf("Getting health status of container %s for metrics: %v", ctr.ID(), err)
+		} else if healthStatus != "" {
+			for _, s := range []string{define.HealthCheckStarting, define.HealthCheckHealthy, define.HealthCheckUnhealthy} {
+				value := 0.0
+				if s == healthStatus {
+					value = 1
+				}
+				health.add(value, append(labels[:len(labels):len(labels)], "status", s)...)
+			}
+		}
+
+		if status != define.ContainerStateRunning && status != define.ContainerStatePaused {
+			continue
+		}
+		stats, err := ctr.GetContainerStats(nil)
+		if err != nil {
+			// Containers without cgroups or stopped meanwhile
+			logrus.Debugf("Getting stats of container %s for metrics: %v", ctr.ID(), err)
+			continue
+		}
+		cpu.add(float64(stats.CPUNano)/float64(time.Second), labels...)
+		memory.add(float64(stats.MemUsage), labels...)
+		memoryLimit.add(float64(stats.MemLimit), labels...)
+		fsReads.add(float64(stats.BlockInput), labels...)
+		fsWrites.add(float64(stats.BlockOutput), labels...)
+		pids.add(float64(stats.PIDs), labels...)
+		ifaces := make([]string, 0, len(stats.Network))
+		for iface := range stats.Network {
+			ifaces = append(ifaces, iface)
+		}
+		sort.Strings(ifaces)
+		for _, iface := range ifaces {
+			netStats := stats.Network[iface]
+			ifaceLabels := append(labels[:len(labels):len(labels)], "interface", iface)
+			rxBytes.add(float64(netStats.RxBytes), ifaceLabels...)
+			rxPackets.add(float64(netStats.RxPackets), ifaceLabels...)
+			rxErrors.add(float64(netStats.RxErrors), ifaceLabels...)
+			rxDropped.add(float64(netStats.RxDropped), ifaceLabels...)
+			txBytes.add(float64(netStats.TxBytes), ifaceLabels...)
+			txPackets.add(float64(netStats.TxPackets), ifaceLabels...)
+			txErrors.add(float64(netStats.TxErrors), ifaceLabels...)
+			txDropped.add(float64(netStats.TxDropped), ifaceLabels...)
+		}
+
+		if pod, ok := podStats[podID]; ok {
+			pod.CPUNano += stats.CPUNano
+			pod.MemUsage += stats.MemUsage
+			pod.BlockInput += stats.BlockInput
+			pod.BlockOutput += stats.BlockOutput
+			if networkID, ok := podNetworkID[podID]; !ok || networkID == ctr.ID() {
+				for iface, netStats := range stats.Network {
+					podNet := pod.Network[iface]
+					podNet.RxBytes += netStats.RxBytes
+					podNet.TxBytes += netStats.TxBytes
+					pod.Network[iface] = podNet
+				}
+			}
+		}
+	}
+
+	for _, pod := range pods {
+		labels := []string{"id", pod.ID(), "name", pod.Name()}
+		stats := podStats[pod.ID()]
+		podCPU.add(float64(stats.CPUNano)/float64(time.Second), labels...)
+		podMemory.add(float64(stats.MemUsage), labels...)
+		podFsReads.add(float64(stats.BlockInput), labels...)
+		podFsWrites.add(float64(stats.BlockOutput), labels...)
+		var rx, tx uint64
+		for _, netStats := range stats.Network {
+			rx += netStats.RxBytes
+			tx += netStats.TxBytes
+		}
+		podRxBytes.add(float64(rx), labels...)
+		podTxBytes.add(float64(tx), labels...)
+		states := make([]string, 0, len(podStates[pod.ID()]))
+		for s := range podStates[pod.ID()] {
+			states = append(states, s)
+		}
+		sort.Strings(states)
+		for _, s := range states {
+			podCtrs.add(float64(podStates[pod.ID()][s]), append(labels[:len(labels):len(labels)], "state", s)...)
+		}
+	}
+
+	return []*metricFamily{
+		cpu, memory, memoryLimit, fsReads, fsWrites,
+		rxBytes, rxPackets, rxErrors, rxDropped, txBytes, txPackets, txErrors, txDropped,
+		pids, state, restarts, health,
+		podCPU, podMemory, podFsReads, podFsWrites, podRxBytes, podTxBytes, podCtrs,
+	}, nil
+}
+
+// collectDiskUsageMetrics returns the disk usage metrics of the images and
+// volumes of runtime.
+func collectDiskUsageMetrics(ctx context.Context, runtime *libpod.Runtime) ([]*metricFamily, error) {
+	var (
+		imageSize   = &metricFamily{name: "podman_image_size_bytes", help: "Size of the image in bytes.", typ: "gauge"}
+		imagesSize  = &metricFamily{name: "podman_images_size_bytes", help: "Disk space used by all images in bytes.", typ: "gauge"}
+		volumeSize  = &metricFamily{name: "podman_volume_size_bytes", help: "Disk space used by the volume in bytes.", typ: "gauge"}
+		volumeLinks = &metricFamily{name: "podman_volume_containers", help: "Number of containers using the volume.", typ: "gauge"}
+	)
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	df, err := ic.SystemDf(ctx, entities.SystemDfOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting disk usage: %w", err)
+	}
+	imagesSize.add(float64(df.ImagesSize))
+	for _, image := range df.Images {
+		imageSize.add(float64(image.Size), "id", image.ImageID, "repository", image.Repository, "tag", image.Tag)
+	}
+	for _, volume := range df.Volumes {
+		volumeSize.add(float64(volume.Size), "name", volume.VolumeName)
+		volumeLinks.add(float64(volume.Links), "name", volume.VolumeName)
+	}
+
+	return []*metricFamily{imageSize, imagesSize, volumeSize, volumeLinks}, nil
+}
@@ -0,0 +1,78 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	families := []*metricFamily{
+		{name: "empty", help: "Omitted without samples.", typ: "gauge"},
+		{name: "container_memory_usage_bytes", help: "Current memory usage in bytes.", typ: "gauge"},
+	}
+	families[1].add(1048576, "name", `a"b\c`+"\n")
+	families[1].add(0.5)
+
+	var b strings.Builder
+	require.NoError(t, writeMetrics(&b, families))
+	assert.Equal(t, `# HELP container_memory_usage_bytes Current memory usage in bytes.
+# TYPE container_memory_usage_bytes gauge
+container_memory_usage_bytes{name="a\"b\\c\n"} 1.048576e+06
+container_memory_usage_bytes 0.5
+`, b.String())
+}
+
+func TestMetricsHandler(t *testing.T) {
+	h := newRequestHistogram()
+	router := mux.NewRouter()
+	router.Use(metricsHandler(h))
+	router.HandleFunc(VersionedPath("/libpod/containers/{name}/json"), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	router.HandleFunc("/_ping", func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+	})
+
+	for _, path := range []string{"/v5.0.0/libpod/containers/foo/json", "/v4.0.0/libpod/containers/bar/json", "/_ping"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	var b strings.Builder
+	require.NoError(t, writeMetrics(&b, []*metricFamily{h.family()}))
+	out := b.String()
+	assert.Contains(t, out, "# TYPE podman_api_request_duration_seconds histogram\n")
+	assert.Contains(t, out, `podman_api_request_duration_seconds_count{method="GET",path="/libpod/containers/{name}/json",code="404"} 2`)
+	assert.Contains(t, out, `podman_api_request_duration_seconds_bucket{method="GET",path="/_ping",code="200",le="0.01"} 0`)
+	assert.Contains(t, out, `podman_api_request_duration_seconds_bucket{method="GET",path="/_ping",code="200",le="+Inf"} 1`)
+	assert.Contains(t, out, `podman_api_request_duration_seconds_count{method="GET",path="/_ping",code="200"} 1`)
+}
+
+func TestDiskUsageCache(t *testing.T) {
+	cache := &diskUsageCache{}
+	calls := 0
+	collect := func() ([]*metricFamily, error) {
+		calls++
+		return []*metricFamily{{name: "podman_images_size_bytes"}}, nil
+	}
+
+	for range 2 {
+		families, err := cache.get(collect)
+		require.NoError(t, err)
+		assert.Len(t, families, 1)
+	}
+	assert.Equal(t, 1, calls)
+
+	cache.updated = time.Now().Add(-diskUsageInterval)
+	_, err := cache.get(collect)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,71 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerAutoUpdateHandlers(r *mux.Router) error {
+	// swagger:operation POST /libpod/autoupdate libpod AutoUpdateLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Auto update containers
+	// description: |
+	//   Auto update containers according to their auto-update policy.  Errors of
+	//   single containers or systemd units are returned along with the reports.
+	// produces:
+	// - application/json
+	// parameters:
+	//   - in: query
+	//     name: dryRun
+	//     type: boolean
+	//     description: Only check for pending updates
+	//     default: false
+	//   - in: query
+	//     name: images
+	//     type: array
+	//     items:
+	//       type: string
+	//     description: Only consider containers created from one of the images
+	//   - in: query
+	//     name: rollback
+	//     type: boolean
+	//     description: Rollback to the previous image if an update fails
+	//     default: true
+	//   - in: query
+	//     name: staged
+	//     type: boolean
+	//     description: Update units running the same images one after another, starting with a canary unit
+	//     default: false
+	//   - in: query
+	//     name: stagedTimeout
+	//     type: string
+	//     description: Time to wait for the containers of an updated unit to become healthy during a staged update
+	//     default: 1m
+	//   - in: query
+	//     name: tlsVerify
+	//     type: boolean
+	//     description: Require TLS verification.
+	//     default: true
+	//   - in: header
+	//     name: X-Registry-Auth
+	//     type: string
+	//     description: |
+	//       base-64 encoded auth config.
+	//       Must include the following four values: username, password, email and server address
+	//       OR simply just an identity token.
+	// responses:
+	//   200:
+	//     $ref: "#/responses/autoUpdateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/autoupdate"), s.APIHandler(libpod.AutoUpdate)).Methods(http.MethodPost)
+	return nil
+}
@@ -737,6 +737,65 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//     500:
 	//       $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/create"), s.APIHandler(libpod.CreateContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/runlabel libpod ContainerRunlabelLibpod
+	// ---
+	// tags:
+	//  - containers
+	// summary: Resolve a runlabel
+	// description: |
+	//   Resolve the command described by a label of the image.  The image is pulled
+	//   if needed but the command is not executed: the IMAGE and NAME placeholders
+	//   are replaced and environment variables are left for the client to expand.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: query
+	//    name: label
+	//    type: string
+	//    required: true
+	//    description: the name of the label, matched case-insensitively
+	//  - in: query
+	//    name: image
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the image
+	//  - in: query
+	//    name: args
+	//    type: array
+	//    items:
+	//      type: string
+	//    description: arguments to append to the command
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    description: the name of the container replacing the NAME placeholder, derived from the image name by default
+	//  - in: query
+	//    name: pull
+	//    type: boolean
+	//    default: true
+	//    description: pull the image if it does not exist locally
+	//  - in: query
+	//    name: tlsVerify
+	//    type: boolean
+	//    default: true
+	//    description: Require TLS verification.
+	//  - in: header
+	//    name: X-Registry-Auth
+	//    type: string
+	//    description: |
+	//      base-64 encoded auth config.
+	//      Must include the following four values: username, password, email and server address
+	//      OR simply just an identity token.
+	// responses:
+	//   200:
+	//     $ref: "#/responses/containerRunlabelResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/imageNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/runlabel"), s.APIHandler(libpod.RunlabelContainer)).Methods(http.MethodPost)
 	// swagger:operation GET /libpod/containers/json libpod ContainerListLibpod
 	// ---
 	// tags:
@@ -1774,6 +1833,37 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/rename"), s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/clone libpod ContainerCloneLibpod
+	// ---
+	// tags:
+	//  - containers
+	// summary: Clone a container
+	// description: Create a copy of an existing container, optionally destroying the original one and starting the copy.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container to clone
+	//  - in: body
+	//    name: options
+	//    description: |
+	//      the options of podman container clone. The ID is ignored in favor of the
+	//      container of the path.
+	//    schema:
+	//      $ref: "#/definitions/ContainerCloneOptions"
+	// produces:
+	// - application/json
+	// responses:
+	//   201:
+	//     $ref: "#/responses/containerCreateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/clone"), s.APIHandler(libpod.CloneContainer)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/containers/{name}/update libpod ContainerUpdateLibpod
 	// ---
 	// tags:
@@ -22,6 +22,11 @@ func (s *APIServer) registerHealthCheckHandlers(r *mux.Router) error {
 	//    type: string
 	//    required: true
 	//    description: the name or ID of the container
+	//  - in: query
+	//    name: readiness
+	//    type: boolean
+	//    default: false
+	//    description: run the readiness check of the container rather than its healthcheck
 	// produces:
 	// - application/json
 	// responses:
@@ -30,7 +35,7 @@ func (s *APIServer) registerHealthCheckHandlers(r *mux.Router) error {
 	//   404:
 	//     $ref: "#/responses/containerNotFound"
 	//   409:
-	//     description: container has no healthcheck or is not running
+	//     description: container has no healthcheck or readiness check or is not running
 	//   500:
 	//     $ref: '#/responses/internalError'
 	r.Handle(VersionedPath("/libpod/containers/{name:.*}/healthcheck"), s.APIHandler(libpod.RunHealthCheck)).Methods(http.MethodGet)
@@ -140,6 +140,16 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//    default: false
 	//    description: Clean up all objects created when a SIGTERM is received or pods exit.
 	//  - in: query
+	//    name: waitReady
+	//    type: boolean
+	//    default: false
+	//    description: Wait for all containers of the started pods to become ready.
+	//  - in: query
+	//    name: waitReadyTimeout
+	//    type: string
+	//    default: 5m
+	//    description: Maximum time to wait for the containers to become ready.
+	//  - in: query
 	//    name: build
 	//    type: boolean
 	//    description: Build the images with corresponding context.
@@ -209,7 +219,11 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//    type: integer
 	//    format: int32
 	//    default: 0
-	//    description: Set the replica number for Deployment kind.
+	//    description: Set the replica number for Deployment and StatefulSet kind.
+	//  - in: query
+	//    name: schedule
+	//    type: string
+	//    description: Set the schedule for CronJob kind in Cron format.
 	//  - in: query
 	//    name: noTrunc
 	//    type: boolean
@@ -277,5 +291,59 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/kube/apply"), s.APIHandler(libpod.KubeApply)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/kube/scale libpod KubeScaleLibpod
+	// ---
+	// tags:
+	//  - containers
+	//  - pods
+	// summary: Scale Deployments created from kube play
+	// description: |
+	//   Sets the number of replicas of the Deployments defined in a YAML file.
+	//   Missing replicas are created and surplus replicas are removed, the other replicas are left untouched.
+	// parameters:
+	//  - in: query
+	//    name: replicas
+	//    type: integer
+	//    format: int32
+	//    required: true
+	//    description: The number of replicas of each Deployment.
+	//  - in: body
+	//    name: request
+	//    description: Kubernetes YAML file.
+	//    schema:
+	//      type: string
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/playKubeResponseLibpod"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/kube/scale"), s.APIHandler(libpod.KubeScale)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/kube/diff libpod KubeDiffLibpod
+	// ---
+	// tags:
+	//  - containers
+	//  - pods
+	// summary: Compare a YAML file with the objects created from kube play
+	// description: |
+	//   Reports the pods, containers, volumes and secrets defined in a YAML file that differ from the ones
+	//   created by kube play, that is the changes kube play with replace would make. Nothing is changed.
+	// parameters:
+	//  - in: body
+	//    name: request
+	//    description: Kubernetes YAML file, followed by the configmap YAMLs used by the pods.
+	//    schema:
+	//      type: string
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/kubeDiffResponseLibpod"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/kube/diff"), s.APIHandler(libpod.KubeDiff)).Methods(http.MethodPost)
 	return nil
 }
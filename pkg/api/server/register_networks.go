@@ -439,5 +439,31 @@ func (s *APIServer) registerNetworkHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/networks/prune"), s.APIHandler(libpod.Prune)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/networks/reload libpod NetworkReloadLibpod
+	// ---
+	// tags:
+	//  - networks
+	// summary: Reload the networks of a container
+	// description: |
+	//   Reload the network configuration of a running container, which restores its
+	//   firewall rules and port forwarding after they were lost, e.g. by a firewall reload.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/networkReloadResponse"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/networks/reload"), s.APIHandler(libpod.ReloadNetwork)).Methods(http.MethodPost)
 	return nil
 }
@@ -3,13 +3,163 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/containers/podman/v5/pkg/api/handlers/compat"
+	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
 	"github.com/gorilla/mux"
 )
 
 func (s *APIServer) registerPluginsHandlers(r *mux.Router) error {
-	r.Handle(VersionedPath("/plugins"), s.APIHandler(compat.UnsupportedHandler))
+	// swagger:operation GET /plugins compat PluginList
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: List plugins
+	// description: Returns the volume plugins configured in containers.conf. A plugin is enabled if it was not disabled and responds on its socket.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: |
+	//      JSON encoded value of the filters (a map[string][]string) to process on the plugins list. Available filters:
+	//        - `capability=<capability name>` Matches plugins with the capability, only `volumedriver` is supported.
+	//        - `enable=<true>|<false>` Matches enabled or disabled plugins.
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginList"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins"), s.APIHandler(compat.ListPlugins)).Methods(http.MethodGet)
+	// Added non version path to URI to support docker non versioned paths
+	r.Handle("/plugins", s.APIHandler(compat.ListPlugins)).Methods(http.MethodGet)
+	// swagger:operation GET /plugins/{name}/json compat PluginInspect
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Inspect a plugin
+	// description: Returns the volume plugin with the given name.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginInspect"
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name:.*}/json"), s.APIHandler(compat.InspectPlugin)).Methods(http.MethodGet)
+	// Added non version path to URI to support docker non versioned paths
+	r.Handle("/plugins/{name:.*}/json", s.APIHandler(compat.InspectPlugin)).Methods(http.MethodGet)
+	// swagger:operation POST /plugins/{name}/enable compat PluginEnable
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Enable a plugin
+	// description: Enable a disabled volume plugin. The plugin must respond on its socket, Podman does not start plugins.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// responses:
+	//   200:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name:.*}/enable"), s.APIHandler(compat.EnablePlugin)).Methods(http.MethodPost)
 	// Added non version path to URI to support docker non versioned paths
-	r.Handle("/plugins", s.APIHandler(compat.UnsupportedHandler))
+	r.Handle("/plugins/{name:.*}/enable", s.APIHandler(compat.EnablePlugin)).Methods(http.MethodPost)
+	// swagger:operation POST /plugins/{name}/disable compat PluginDisable
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Disable a plugin
+	// description: Disable a volume plugin. Volumes of a disabled plugin cannot be created or used. Podman does not stop plugins.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	//  - in: query
+	//    name: force
+	//    type: boolean
+	//    default: false
+	//    description: disable the plugin even if volumes are using it
+	// responses:
+	//   200:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name:.*}/disable"), s.APIHandler(compat.DisablePlugin)).Methods(http.MethodPost)
+	// Added non version path to URI to support docker non versioned paths
+	r.Handle("/plugins/{name:.*}/disable", s.APIHandler(compat.DisablePlugin)).Methods(http.MethodPost)
+
+	/*
+	 * Libpod Endpoints
+	 */
+
+	// swagger:operation GET /libpod/plugins/json libpod PluginListLibpod
+	// ---
+	// tags:
+	//  - plugins
+	// summary: List volume plugins
+	// description: Returns the volume plugins configured in containers.conf.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: |
+	//      JSON encoded value of the filters (a map[string][]string) to process on the plugins list. Available filters:
+	//        - `name=<regex>` Matches plugins with a name matching the regular expression.
+	//        - `enabled=<true>|<false>` Matches enabled or disabled plugins.
+	//        - `running=<true>|<false>` Matches plugins responding or not responding on their socket.
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginListLibpod"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/plugins/json"), s.APIHandler(libpod.ListPlugins)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/plugins/{name}/json libpod PluginInspectLibpod
+	// ---
+	// tags:
+	//  - plugins
+	// summary: Inspect a volume plugin
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginInspectLibpod"
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/plugins/{name}/json"), s.APIHandler(libpod.InspectPlugin)).Methods(http.MethodGet)
 	return nil
 }
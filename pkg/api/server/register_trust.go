@@ -0,0 +1,81 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerTrustHandlers(r *mux.Router) error {
+	// swagger:operation GET /libpod/trust libpod TrustShowLibpod
+	// ---
+	// tags:
+	//   - images
+	// summary: Show trust policy
+	// description: |
+	//   Show the trust policy of the system, along with the signature stores
+	//   configured for the registries in registries.d.
+	// produces:
+	// - application/json
+	// parameters:
+	//   - in: query
+	//     name: policyPath
+	//     type: string
+	//     description: Path of the trust policy file, instead of the system one
+	//   - in: query
+	//     name: raw
+	//     type: boolean
+	//     description: Only return the raw content of the trust policy file
+	//     default: false
+	//   - in: query
+	//     name: registryPath
+	//     type: string
+	//     description: Path of the registries.d directory, instead of the system one
+	// responses:
+	//   200:
+	//     $ref: "#/responses/trustShowResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/trust"), s.APIHandler(libpod.ShowTrust)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/trust libpod TrustSetLibpod
+	// ---
+	// tags:
+	//   - images
+	// summary: Set trust policy
+	// description: Set the default trust policy or the trust policy of a registry
+	// parameters:
+	//   - in: query
+	//     name: scope
+	//     type: string
+	//     required: true
+	//     description: Registry or repository to set the policy for, or "default" for the default policy
+	//   - in: query
+	//     name: type
+	//     type: string
+	//     description: "Trust type: accept, insecureAcceptAnything, reject, signedBy or sigstoreSigned"
+	//     default: signedBy
+	//   - in: query
+	//     name: pubKeysFile
+	//     type: array
+	//     items:
+	//       type: string
+	//     description: Paths of the public keys on the server, required by signedBy and sigstoreSigned
+	//   - in: query
+	//     name: policyPath
+	//     type: string
+	//     description: Path of the trust policy file, instead of the system one
+	// responses:
+	//   204:
+	//     description: no error
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/trust"), s.APIHandler(libpod.SetTrust)).Methods(http.MethodPost)
+	return nil
+}
@@ -4,6 +4,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -17,8 +18,10 @@ import (
 	"time"
 
 	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/plugin"
 	"github.com/containers/podman/v5/libpod/shutdown"
 	"github.com/containers/podman/v5/pkg/api/handlers"
+	handlersLibpod "github.com/containers/podman/v5/pkg/api/handlers/libpod"
 	"github.com/containers/podman/v5/pkg/api/server/idle"
 	"github.com/containers/podman/v5/pkg/api/types"
 	"github.com/containers/podman/v5/pkg/domain/entities"
@@ -29,15 +32,22 @@ import (
 )
 
 type APIServer struct {
-	http.Server                      // The  HTTP work happens here
-	net.Listener                     // mux for routing HTTP API calls to libpod routines
-	*libpod.Runtime                  // Where the real work happens
-	*schema.Decoder                  // Decoder for Query parameters to structs
-	context.CancelFunc               // Stop APIServer
-	context.Context                  // Context to carry objects to handlers
-	CorsHeaders        string        // Inject Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr          string        // Binding network address for pprof profiles
-	idleTracker        *idle.Tracker // Track connections to support idle shutdown
+	http.Server                               // The  HTTP work happens here
+	net.Listener                              // mux for routing HTTP API calls to libpod routines
+	*libpod.Runtime                           // Where the real work happens
+	*schema.Decoder                           // Decoder for Query parameters to structs
+	context.CancelFunc                        // Stop APIServer
+	context.Context                           // Context to carry objects to handlers
+	AutoUpdateWebhookAddr   string            // Binding network address for the auto-update webhook
+	CorsHeaders             string            // Inject Cross-Origin Resource Sharing (CORS) headers
+	MetricsAddr             string            // Binding network address for Prometheus metrics
+	PProfAddr               string            // Binding network address for pprof profiles
+	autoUpdateWebhookServer *http.Server      // Serves the auto-update webhook, if enabled
+	autoUpdateWebhookToken  string            // Token required by the auto-update webhook
+	diskUsage               *diskUsageCache   // Disk usage metrics of images and volumes, if metrics are enabled
+	idleTracker             *idle.Tracker     // Track connections to support idle shutdown
+	metricsServer           *http.Server      // Serves the Prometheus metrics, if enabled
+	requestHistogram        *requestHistogram // Latency of API requests, if metrics are enabled
 }
 
 // Number of seconds to wait for next request, if exceeded shutdown server
@@ -65,12 +75,38 @@ func NewServerWithSettings(runtime *libpod.Runtime, listener net.Listener, opts
 
 func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.ServiceOptions) (*APIServer, error) {
 	logrus.Infof("API service listening on %q. URI: %q", listener.Addr(), runtime.RemoteURI())
+	if opts.TLSCertFile != "" || opts.TLSKeyFile != "" {
+		var err error
+		listener, err = ListenTLS(listener, opts.TLSCertFile, opts.TLSKeyFile, opts.TLSClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		if opts.TLSClientCAFile == "" {
+			logrus.Debug("TLS enabled without client certificate verification")
+		} else {
+			logrus.Debug("TLS enabled with client certificate verification")
+		}
+	}
 	if opts.CorsHeaders == "" {
 		logrus.Debug("CORS Headers were not set")
 	} else {
 		logrus.Debugf("CORS Headers were set to %q", opts.CorsHeaders)
 	}
 
+	rtConfig, err := runtime.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	authZPlugins := make([]*plugin.AuthZPlugin, 0, len(opts.AuthorizationPlugins))
+	for _, path := range opts.AuthorizationPlugins {
+		p, err := plugin.GetAuthZPlugin(path, time.Duration(rtConfig.Engine.VolumePluginTimeout)*time.Second)
+		if err != nil {
+			return nil, fmt.Errorf("loading authorization plugin: %w", err)
+		}
+		logrus.Infof("Using authorization plugin %s at %q", p.Name, p.SocketPath)
+		authZPlugins = append(authZPlugins, p)
+	}
+
 	router := mux.NewRouter().UseEncodedPath()
 	tracker := idle.NewTracker(opts.Timeout)
 
@@ -84,10 +120,13 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 			Handler:     router,
 			IdleTimeout: opts.Timeout * 2,
 		},
-		CorsHeaders: opts.CorsHeaders,
-		Listener:    listener,
-		PProfAddr:   opts.PProfAddr,
-		idleTracker: tracker,
+		AutoUpdateWebhookAddr:  opts.AutoUpdateWebhookAddr,
+		CorsHeaders:            opts.CorsHeaders,
+		Listener:               listener,
+		MetricsAddr:            opts.MetricsAddr,
+		PProfAddr:              opts.PProfAddr,
+		autoUpdateWebhookToken: opts.AutoUpdateWebhookToken,
+		idleTracker:            tracker,
 	}
 
 	server.BaseContext = func(l net.Listener) context.Context {
@@ -99,8 +138,15 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 	}
 
 	// Capture panics and print stack traces for diagnostics,
-	// additionally process X-Reference-Id Header to support event correlation
-	router.Use(panicHandler(), referenceIDHandler())
+	// additionally process X-Reference-Id Header to support event correlation,
+	// and only serve requests allowed by the authorization plugins, if any.
+	router.Use(panicHandler(), referenceIDHandler(), authZHandler(authZPlugins))
+	// Record the latency of requests if metrics are served.
+	if opts.MetricsAddr != "" {
+		server.diskUsage = &diskUsageCache{}
+		server.requestHistogram = newRequestHistogram()
+		router.Use(metricsHandler(server.requestHistogram))
+	}
 	router.NotFoundHandler = http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// We can track user errors...
@@ -121,6 +167,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		server.registerAuthHandlers,
 		server.registerArtifactHandlers,
 		server.registerArchiveHandlers,
+		server.registerAutoUpdateHandlers,
 		server.registerContainersHandlers,
 		server.registerDistributionHandlers,
 		server.registerEventsHandlers,
@@ -140,6 +187,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		server.registerSwaggerHandlers,
 		server.registerSwarmHandlers,
 		server.registerSystemHandlers,
+		server.registerTrustHandlers,
 		server.registerVersionHandlers,
 		server.registerVolumeHandlers,
 	} {
@@ -196,6 +244,12 @@ func (s *APIServer) setupSystemd() {
 // Serve starts responding to HTTP requests.
 func (s *APIServer) Serve() error {
 	s.setupPprof()
+	if err := s.setupAutoUpdateWebhook(); err != nil {
+		return err
+	}
+	if err := s.setupMetrics(); err != nil {
+		return err
+	}
 
 	if err := shutdown.Register("service", func(sig os.Signal) error {
 		err := s.Shutdown(true)
@@ -266,6 +320,48 @@ func (s *APIServer) setupPprof() {
 	}()
 }
 
+// setupAutoUpdateWebhook enables the endpoint receiving push notifications of
+// container registries to trigger auto updates.  The endpoint is served on a
+// separate address to not expose the entire API to registries.
+//
+// Example:
+// curl -X POST -H "Authorization: Bearer $TOKEN" -H "Content-Type: application/json" -d @notification.json localhost:8889/autoupdate/webhook
+func (s *APIServer) setupAutoUpdateWebhook() error {
+	if s.AutoUpdateWebhookAddr == "" {
+		return nil
+	}
+	if s.autoUpdateWebhookToken == "" {
+		return errors.New("the auto-update webhook requires a token")
+	}
+
+	listener, err := net.Listen("tcp", s.AutoUpdateWebhookAddr)
+	if err != nil {
+		return fmt.Errorf("unable to create auto-update webhook socket %v: %w", s.AutoUpdateWebhookAddr, err)
+	}
+	logrus.Infof("Auto-update webhook listening on %q", listener.Addr())
+
+	router := mux.NewRouter()
+	router.Use(panicHandler(), referenceIDHandler(), tokenHandler(s.autoUpdateWebhookToken))
+	router.Handle("/autoupdate/webhook", s.APIHandler(handlersLibpod.AutoUpdateWebhook)).Methods(http.MethodPost)
+
+	// Requests and the updates they trigger keep the service from timing
+	// out, as requests of the API do.
+	s.autoUpdateWebhookServer = &http.Server{
+		BaseContext: s.Server.BaseContext,
+		ConnState:   s.idleTracker.ConnState,
+		ErrorLog:    s.Server.ErrorLog,
+		Handler:     router,
+		IdleTimeout: s.Server.IdleTimeout,
+	}
+	go func() {
+		err := s.autoUpdateWebhookServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			logrus.Warnf("Auto-update webhook failed: %v", err)
+		}
+	}()
+	return nil
+}
+
 // Shutdown is a clean shutdown waiting on existing clients
 func (s *APIServer) Shutdown(halt bool) error {
 	switch {
@@ -280,6 +376,10 @@ func (s *APIServer) Shutdown(halt bool) error {
 		logrus.Debugf("API service shutdown, %d/%d connection(s)",
 			s.idleTracker.ActiveConnections(), s.idleTracker.TotalConnections())
 
+		// The endpoints served on separate addresses hold no long
+		// running requests worth waiting for.
+		s.closeEndpoints()
+
 		// Gracefully shutdown server(s), duration of wait same as idle window
 		deadline := 1 * time.Second
 		if s.idleTracker.Duration > 0 {
@@ -301,5 +401,20 @@ func (s *APIServer) Shutdown(halt bool) error {
 
 // Close immediately stops responding to clients and exits
 func (s *APIServer) Close() error {
+	s.closeEndpoints()
 	return s.Server.Close()
 }
+
+// closeEndpoints stops the endpoints served on separate addresses.
+func (s *APIServer) closeEndpoints() {
+	if s.autoUpdateWebhookServer != nil {
+		if err := s.autoUpdateWebhookServer.Close(); err != nil {
+			logrus.Errorf("Failed to close auto-update webhook: %v", err)
+		}
+	}
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Close(); err != nil {
+			logrus.Errorf("Failed to close metrics endpoint: %v", err)
+		}
+	}
+}
@@ -8,17 +8,23 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/libartifact/store"
+	libartTypes "github.com/containers/podman/v5/pkg/libartifact/types"
 	"github.com/containers/podman/v5/pkg/systemd"
 	systemdDefine "github.com/containers/podman/v5/pkg/systemd/define"
 	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/opencontainers/go-digest"
 	"github.com/sirupsen/logrus"
 )
 
@@ -45,9 +51,11 @@ var supportedPolicies = map[string]Policy{
 
 // updater includes shared state for auto-updating one or more containers.
 type updater struct {
+	artifactStore    *store.ArtifactStore        // The artifact store, only set if artifacts are mounted
 	conn             *dbus.Conn                  // DBUS connection
 	options          *entities.AutoUpdateOptions // User-specified options
 	unitToTasks      map[string][]*task          // Keeps track of tasks per unit
+	updatedArtifacts map[string]bool             // Keeps track of updated artifacts
 	updatedRawImages map[string]bool             // Keeps track of updated images
 	runtime          *libpod.Runtime             // The libpod runtime
 }
@@ -58,18 +66,39 @@ const (
 	statusNotUpdated = "false"       // No update was needed
 	statusPending    = "pending"     // The update is pending (see options.DryRun)
 	statusRolledBack = "rolled back" // Rollback after a failed update
+	statusAborted    = "aborted"     // The staged update has been aborted
+)
+
+const (
+	stageCanary  = "canary"  // The first unit of a staged update
+	stageRollout = "rollout" // The remaining units of a staged update
+
+	// Default time to wait for the containers of a unit to become
+	// healthy during a staged update.
+	defaultStagedTimeout = time.Minute
 )
 
 // task includes data and state for updating a container
 type task struct {
-	authfile     string            // Container-specific authfile
-	auto         *updater          // Reverse pointer to the updater
-	container    *libpod.Container // Container to update
-	policy       Policy            // Update policy
-	image        *libimage.Image   // Original image before the update
-	rawImageName string            // The container's raw image name
-	status       string            // Auto-update status
-	unit         string            // Name of the systemd unit
+	artifacts      []*artifact       // Artifacts mounted into the container
+	authfile       string            // Container-specific authfile
+	auto           *updater          // Reverse pointer to the updater
+	container      *libpod.Container // Container to update
+	policy         Policy            // Update policy
+	image          *libimage.Image   // Original image before the update
+	imageAvailable bool              // Whether a new image is available
+	rawImageName   string            // The container's raw image name
+	stage          string            // Stage of a staged update
+	status         string            // Auto-update status
+	unit           string            // Name of the systemd unit
+}
+
+// artifact includes data and state for updating an artifact mounted into a
+// container
+type artifact struct {
+	available bool          // Whether a new version is available
+	digest    digest.Digest // Manifest digest before the update
+	name      string        // Name of the artifact in the store
 }
 
 // LookupPolicy looks up the corresponding Policy for the specified
@@ -107,6 +136,16 @@ func LookupPolicy(s string) (Policy, error) {
 // of a running container is different than the local one. If the image digests
 // differ, it restarts the systemd unit with the new image.
 //
+// If options.Staged is set, units running the same images are updated one
+// after another, starting with a canary unit.  Each updated unit must become
+// healthy before the next one gets updated, otherwise the update is aborted
+// and all units updated so far are rolled back.
+//
+// Artifacts mounted into containers with PolicyRegistryImage are treated like
+// the image: if the digest of an artifact on the registry differs from the one
+// in the local artifact store, the artifact is pulled and the systemd unit gets
+// restarted.
+//
 // It returns a slice of successfully restarted systemd units and a slice of
 // errors encountered during auto update.
 func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
@@ -117,6 +156,7 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	auto := updater{
 		options:          &options,
 		runtime:          runtime,
+		updatedArtifacts: make(map[string]bool),
 		updatedRawImages: make(map[string]bool),
 	}
 
@@ -141,10 +181,20 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	runtime.NewSystemEvent(events.AutoUpdate)
 
 	// Update all images/container according to their auto-update policy.
+	if options.Staged {
+		for _, units := range auto.assembleStages() {
+			stageErrors := auto.updateStage(ctx, units)
+			allErrors = append(allErrors, stageErrors...)
+		}
+	} else {
+		for unit, tasks := range auto.unitToTasks {
+			unitErrors := auto.updateUnit(ctx, unit, tasks)
+			allErrors = append(allErrors, unitErrors...)
+		}
+	}
+
 	var allReports []*entities.AutoUpdateReport
-	for unit, tasks := range auto.unitToTasks {
-		unitErrors := auto.updateUnit(ctx, unit, tasks)
-		allErrors = append(allErrors, unitErrors...)
+	for _, tasks := range auto.unitToTasks {
 		for _, task := range tasks {
 			allReports = append(allReports, task.report())
 		}
@@ -153,6 +203,150 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	return allReports, allErrors
 }
 
+// assembleStages groups the units that run the same images.  Each group is
+// updated in stages by updateStage.  Groups and the units in a group are
+// sorted to make the choice of the canary unit predictable.
+func (u *updater) assembleStages() [][]string {
+	keyToUnits := make(map[string][]string)
+	for unit, tasks := range u.unitToTasks {
+		imageNames := make([]string, 0, len(tasks))
+		for _, task := range tasks {
+			imageNames = append(imageNames, task.rawImageName)
+		}
+		sort.Strings(imageNames)
+		key := strings.Join(imageNames, ",")
+		keyToUnits[key] = append(keyToUnits[key], unit)
+	}
+
+	keys := make([]string, 0, len(keyToUnits))
+	for key := range keyToUnits {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	stages := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		units := keyToUnits[key]
+		sort.Strings(units)
+		stages = append(stages, units)
+	}
+	return stages
+}
+
+// updateStage updates the specified units one after another.  The first unit
+// acts as the canary.  Once a unit has been updated, its containers must become
+// healthy before the next unit is updated.  On failure, the remaining units are
+// left untouched and, if rollbacks are enabled, all units updated so far are
+// rolled back.
+func (u *updater) updateStage(ctx context.Context, units []string) []error {
+	// There is nothing to stage with a single unit.
+	if len(units) == 1 {
+		return u.updateUnit(ctx, units[0], u.unitToTasks[units[0]])
+	}
+
+	var errs []error
+	var updatedUnits []string
+	for i, unit := range units {
+		tasks := u.unitToTasks[unit]
+		stage := stageRollout
+		if i == 0 {
+			stage = stageCanary
+		}
+		for _, task := range tasks {
+			task.stage = stage
+		}
+
+		unitErrors := u.updateUnit(ctx, unit, tasks)
+		errs = append(errs, unitErrors...)
+
+		failed := len(unitErrors) > 0 && tasksHaveStatus(tasks, statusFailed, statusRolledBack)
+		if !failed && tasksHaveStatus(tasks, statusUpdated) {
+			updatedUnits = append(updatedUnits, unit)
+			if err := u.waitForHealthyTasks(ctx, tasks); err != nil {
+				errs = append(errs, fmt.Errorf("waiting for unit %s to become healthy during %s update: %w", unit, stage, err))
+				for _, task := range tasks {
+					task.status = statusFailed
+				}
+				failed = true
+			}
+		}
+		if !failed {
+			continue
+		}
+
+		// Abort the update of the remaining units.
+		for _, remaining := range units[i+1:] {
+			for _, task := range u.unitToTasks[remaining] {
+				task.stage = stageRollout
+				task.status = statusAborted
+			}
+		}
+		if u.options.Rollback {
+			for _, updated := range updatedUnits {
+				errs = append(errs, u.rollbackUnit(ctx, updated, u.unitToTasks[updated])...)
+			}
+		}
+		break
+	}
+
+	return errs
+}
+
+// tasksHaveStatus returns whether any of the tasks has one of the statuses.
+func tasksHaveStatus(tasks []*task, statuses ...string) bool {
+	for _, task := range tasks {
+		for _, status := range statuses {
+			if task.status == status {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// waitForHealthyTasks waits for the containers of the tasks to become healthy.
+// Containers without a healthcheck are considered healthy once they exist.
+// Note that the containers are looked up by name as restarting the systemd
+// unit usually recreates them.
+func (u *updater) waitForHealthyTasks(ctx context.Context, tasks []*task) error {
+	timeout := u.options.StagedTimeout
+	if timeout == 0 {
+		timeout = defaultStagedTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	for _, task := range tasks {
+		name := task.container.Name()
+		for {
+			ctr, err := u.runtime.LookupContainer(name)
+			if err != nil && !errors.Is(err, define.ErrNoSuchCtr) {
+				return err
+			}
+			if err == nil {
+				status, err := ctr.HealthCheckStatus()
+				if err != nil {
+					return err
+				}
+				if status == "" || status == define.HealthCheckHealthy {
+					break
+				}
+				if status == define.HealthCheckUnhealthy {
+					return fmt.Errorf("container %s is %s", name, status)
+				}
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("container %s did not become healthy within %s", name, timeout)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+			}
+		}
+	}
+	return nil
+}
+
 // updateUnit auto updates the tasks in the specified systemd unit.
 func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []error {
 	var errors []error
@@ -213,11 +407,23 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 	}
 
 	// The update has failed and rollbacks are enabled.
+	return append(errors, u.rollbackUnit(ctx, unit, tasks)...)
+}
+
+// rollbackUnit rolls back the images and artifacts of the tasks in the
+// specified systemd unit and restarts the unit.
+func (u *updater) rollbackUnit(ctx context.Context, unit string, tasks []*task) []error {
+	var errors []error
+
 	for _, task := range tasks {
 		if err := task.rollbackImage(); err != nil {
 			err = fmt.Errorf("rolling back image for container %s in unit %s: %w", task.container.ID(), unit, err)
 			errors = append(errors, err)
 		}
+		if err := task.rollbackArtifacts(ctx); err != nil {
+			err = fmt.Errorf("rolling back artifacts for container %s in unit %s: %w", task.container.ID(), unit, err)
+			errors = append(errors, err)
+		}
 	}
 
 	if err := u.restartSystemdUnit(ctx, unit); err != nil {
@@ -238,11 +444,17 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 
 // report creates an auto-update report for the task.
 func (t *task) report() *entities.AutoUpdateReport {
+	var artifactNames []string
+	for _, a := range t.artifacts {
+		artifactNames = append(artifactNames, a.name)
+	}
 	return &entities.AutoUpdateReport{
+		ArtifactNames: artifactNames,
 		ContainerID:   t.container.ID(),
 		ContainerName: t.container.Name(),
 		ImageName:     t.container.RawImageName(),
 		Policy:        string(t.policy),
+		Stage:         t.stage,
 		SystemdUnit:   t.unit,
 		Updated:       t.status,
 	}
@@ -252,7 +464,16 @@ func (t *task) report() *entities.AutoUpdateReport {
 func (t *task) updateAvailable(ctx context.Context) (bool, error) {
 	switch t.policy {
 	case PolicyRegistryImage:
-		return t.registryUpdateAvailable(ctx)
+		imageAvailable, err := t.registryUpdateAvailable(ctx)
+		if err != nil {
+			return false, err
+		}
+		t.imageAvailable = imageAvailable
+		artifactsAvailable, err := t.registryArtifactsUpdateAvailable(ctx)
+		if err != nil {
+			return false, err
+		}
+		return imageAvailable || artifactsAvailable, nil
 	case PolicyLocalImage:
 		return t.localUpdateAvailable()
 	default:
@@ -264,7 +485,12 @@ func (t *task) updateAvailable(ctx context.Context) (bool, error) {
 func (t *task) update(ctx context.Context) error {
 	switch t.policy {
 	case PolicyRegistryImage:
-		return t.registryUpdate(ctx)
+		if t.imageAvailable {
+			if err := t.registryUpdate(ctx); err != nil {
+				return err
+			}
+		}
+		return t.registryArtifactsUpdate(ctx)
 	case PolicyLocalImage:
 		// Nothing to do as the image is already available in the local storage.
 		return nil
@@ -311,6 +537,65 @@ func (t *task) registryUpdate(ctx context.Context) error {
 	return nil
 }
 
+// registryArtifactsUpdateAvailable returns whether a new version of any of the
+// task's artifacts is available on the registry.
+func (t *task) registryArtifactsUpdateAvailable(ctx context.Context) (bool, error) {
+	available := false
+	for _, a := range t.artifacts {
+		// The newer artifact has already been pulled for another task,
+		// so we know there's a newer one available.
+		if t.auto.updatedArtifacts[a.name] {
+			a.available = true
+			available = true
+			continue
+		}
+
+		remoteRef, err := docker.ParseReference("//" + a.name)
+		if err != nil {
+			return false, fmt.Errorf("checking artifact %s: %w", a.name, err)
+		}
+		sys := t.auto.runtime.SystemContext()
+		if t.authfile != "" {
+			sys.AuthFilePath = t.authfile
+		}
+		if t.auto.options.InsecureSkipTLSVerify != types.OptionalBoolUndefined {
+			sys.DockerInsecureSkipTLSVerify = t.auto.options.InsecureSkipTLSVerify
+		}
+		remoteDigest, err := docker.GetDigest(ctx, sys, remoteRef)
+		if err != nil {
+			return false, fmt.Errorf("checking artifact %s: %w", a.name, err)
+		}
+		a.available = remoteDigest != a.digest
+		available = available || a.available
+	}
+	return available, nil
+}
+
+// registryArtifactsUpdate pulls down the task's artifacts that have a newer
+// version on the registry.
+func (t *task) registryArtifactsUpdate(ctx context.Context) error {
+	for _, a := range t.artifacts {
+		if !a.available {
+			continue
+		}
+		// The newer artifact has already been pulled for another task.
+		if t.auto.updatedArtifacts[a.name] {
+			continue
+		}
+
+		pullOptions := libimage.CopyOptions{}
+		pullOptions.AuthFilePath = t.authfile
+		pullOptions.Writer = os.Stderr
+		pullOptions.InsecureSkipTLSVerify = t.auto.options.InsecureSkipTLSVerify
+		if _, err := t.auto.artifactStore.Pull(ctx, a.name, pullOptions); err != nil {
+			return fmt.Errorf("pulling artifact %s: %w", a.name, err)
+		}
+
+		t.auto.updatedArtifacts[a.name] = true
+	}
+	return nil
+}
+
 // localUpdateAvailable returns whether a new image in the local storage is available.
 func (t *task) localUpdateAvailable() (bool, error) {
 	localImg, _, err := t.auto.runtime.LibimageRuntime().LookupImage(t.rawImageName, nil)
@@ -330,6 +615,25 @@ func (t *task) rollbackImage() error {
 	return nil
 }
 
+// rollbackArtifacts rolls back the task's artifacts to the previous versions
+// before the update.
+func (t *task) rollbackArtifacts(ctx context.Context) error {
+	var errs []error
+	for _, a := range t.artifacts {
+		if !a.available {
+			continue
+		}
+		// To fallback, point the name to the previous manifest which
+		// is still present in the store.
+		if err := t.auto.artifactStore.Tag(ctx, a.digest, a.name); err != nil {
+			errs = append(errs, fmt.Errorf("artifact %s: %w", a.name, err))
+			continue
+		}
+		t.auto.updatedArtifacts[a.name] = false
+	}
+	return errors.Join(errs...)
+}
+
 // restartSystemdUnit restarts the systemd unit the container is running in.
 func (u *updater) restartSystemdUnit(ctx context.Context, unit string) error {
 	restartChan := make(chan string)
@@ -368,6 +672,15 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 
 	u.unitToTasks = make(map[string][]*task)
 
+	// Only consider containers using one of the specified images.
+	var images map[string]bool
+	if len(u.options.Images) > 0 {
+		images = make(map[string]bool)
+		for _, name := range u.options.Images {
+			images[normalizeImageName(name)] = true
+		}
+	}
+
 	errs := []error{}
 	for _, c := range allContainers {
 		ctr := c
@@ -425,6 +738,9 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 			errs = append(errs, fmt.Errorf("locally auto-updating container %q: raw-image name is empty", ctr.ID()))
 			continue
 		}
+		if images != nil && !images[normalizeImageName(rawImageName)] {
+			continue
+		}
 
 		// Use user-specified auth file (CLI or env variable) unless
 		// the container was created with the auth-file label.
@@ -432,7 +748,17 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 		if fromContainer, ok := labels[define.AutoUpdateAuthfileLabel]; ok {
 			authfile = fromContainer
 		}
+		var artifacts []*artifact
+		if policy == PolicyRegistryImage {
+			artifacts, err = u.artifactsForContainer(ctx, ctr)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("auto-updating container %q: %w", ctr.ID(), err))
+				continue
+			}
+		}
+
 		t := task{
+			artifacts:    artifacts,
 			authfile:     authfile,
 			auto:         u,
 			container:    ctr,
@@ -450,6 +776,41 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 	return errs
 }
 
+// artifactsForContainer returns the artifacts mounted into the container that
+// are subject to auto updates.  Artifacts mounted by digest are pinned and
+// hence skipped.
+func (u *updater) artifactsForContainer(ctx context.Context, c *libpod.Container) ([]*artifact, error) {
+	artifactVolumes := c.ConfigNoCopy().ArtifactVolumes
+	if len(artifactVolumes) == 0 {
+		return nil, nil
+	}
+
+	if u.artifactStore == nil {
+		artStore, err := u.runtime.ArtifactStore()
+		if err != nil {
+			return nil, err
+		}
+		u.artifactStore = artStore
+	}
+
+	var artifacts []*artifact
+	for _, volume := range artifactVolumes {
+		manifestDigest, err := u.artifactStore.ManifestDigest(ctx, volume.Source)
+		if err != nil {
+			if errors.Is(err, libartTypes.ErrArtifactNotExist) {
+				logrus.Debugf("Skipping auto-update of artifact %q of container %s: not referenced by name", volume.Source, c.ID())
+				continue
+			}
+			return nil, err
+		}
+		artifacts = append(artifacts, &artifact{
+			digest: manifestDigest,
+			name:   volume.Source,
+		})
+	}
+	return artifacts, nil
+}
+
 // systemdUnitForContainer returns the name of the container's systemd unit.
 // If the container is part of a pod, the pod's infra container's systemd unit
 // is returned.  This allows for auto update to restart the pod's systemd unit.
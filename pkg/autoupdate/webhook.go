@@ -0,0 +1,120 @@
+//go:build !remote
+
+package autoupdate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/containers/image/v5/docker/reference"
+)
+
+// distributionNotification is the envelope of notifications sent by the
+// CNCF Distribution registry (and compatible registries).
+type distributionNotification struct {
+	Events []struct {
+		Action string `json:"action"`
+		Target struct {
+			Repository string `json:"repository"`
+			Tag        string `json:"tag"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+// harborNotification is the payload of webhooks sent by Harbor.
+type harborNotification struct {
+	Type      string `json:"type"`
+	EventData struct {
+		Resources []struct {
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+	} `json:"event_data"`
+}
+
+// quayNotification is the payload of repository push notifications sent by
+// Quay.
+type quayNotification struct {
+	DockerURL   string   `json:"docker_url"`
+	UpdatedTags []string `json:"updated_tags"`
+}
+
+// ParseRegistryNotification parses the push notification of a container
+// registry and returns the references of the pushed images.  Notifications
+// of the Distribution registry, Harbor and Quay are supported.  Notifications
+// that do not describe a push, such as pull or delete events, yield no
+// references.
+func ParseRegistryNotification(r io.Reader) ([]string, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []string
+
+	var distribution distributionNotification
+	if err := json.Unmarshal(body, &distribution); err != nil {
+		return nil, fmt.Errorf("decoding registry notification: %w", err)
+	}
+	if len(distribution.Events) > 0 {
+		for _, event := range distribution.Events {
+			// Manifest pushes without a tag (e.g., the blobs or the
+			// per-platform manifests of a manifest list) cannot match
+			// any container.
+			if event.Action != "push" || event.Target.Tag == "" {
+				continue
+			}
+			repository := event.Target.Repository
+			if event.Request.Host != "" {
+				repository = event.Request.Host + "/" + repository
+			}
+			images = append(images, repository+":"+event.Target.Tag)
+		}
+		return images, nil
+	}
+
+	var harbor harborNotification
+	if err := json.Unmarshal(body, &harbor); err != nil {
+		return nil, fmt.Errorf("decoding registry notification: %w", err)
+	}
+	if harbor.Type != "" {
+		if harbor.Type != "PUSH_ARTIFACT" {
+			return nil, nil
+		}
+		for _, resource := range harbor.EventData.Resources {
+			if resource.ResourceURL != "" {
+				images = append(images, resource.ResourceURL)
+			}
+		}
+		return images, nil
+	}
+
+	var quay quayNotification
+	if err := json.Unmarshal(body, &quay); err != nil {
+		return nil, fmt.Errorf("decoding registry notification: %w", err)
+	}
+	if quay.DockerURL != "" {
+		for _, tag := range quay.UpdatedTags {
+			images = append(images, quay.DockerURL+":"+tag)
+		}
+		return images, nil
+	}
+
+	return nil, fmt.Errorf("unsupported registry notification")
+}
+
+// normalizeImageName returns the fully-qualified and tagged form of the
+// image name to allow for comparing names used at container creation with
+// names sent in registry notifications.  If the name cannot be parsed, it is
+// returned unchanged.
+func normalizeImageName(name string) string {
+	named, err := reference.ParseNormalizedNamed(strings.TrimPrefix(name, "docker://"))
+	if err != nil {
+		return name
+	}
+	return reference.TagNameOnly(named).String()
+}
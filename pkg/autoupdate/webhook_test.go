@@ -0,0 +1,72 @@
+//go:build !remote
+
+package autoupdate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegistryNotification(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification string
+		images       []string
+		err          string
+	}{
+		{
+			name: "distribution push",
+			notification: `{"events": [
+				{"action": "push", "target": {"repository": "foo/bar", "tag": "latest"}, "request": {"host": "registry.example.com"}},
+				{"action": "push", "target": {"repository": "foo/bar"}, "request": {"host": "registry.example.com"}},
+				{"action": "pull", "target": {"repository": "foo/baz", "tag": "latest"}, "request": {"host": "registry.example.com"}}
+			]}`,
+			images: []string{"registry.example.com/foo/bar:latest"},
+		},
+		{
+			name:         "harbor push",
+			notification: `{"type": "PUSH_ARTIFACT", "event_data": {"resources": [{"resource_url": "harbor.example.com/library/app:1.0"}]}}`,
+			images:       []string{"harbor.example.com/library/app:1.0"},
+		},
+		{
+			name:         "harbor delete",
+			notification: `{"type": "DELETE_ARTIFACT", "event_data": {"resources": [{"resource_url": "harbor.example.com/library/app:1.0"}]}}`,
+		},
+		{
+			name:         "quay push",
+			notification: `{"docker_url": "quay.io/foo/bar", "updated_tags": ["latest", "v2"]}`,
+			images:       []string{"quay.io/foo/bar:latest", "quay.io/foo/bar:v2"},
+		},
+		{
+			name:         "unsupported",
+			notification: `{"foo": "bar"}`,
+			err:          "unsupported registry notification",
+		},
+		{
+			name:         "invalid JSON",
+			notification: `not json`,
+			err:          "decoding registry notification",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			images, err := ParseRegistryNotification(strings.NewReader(tt.notification))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.images, images)
+		})
+	}
+}
+
+func TestNormalizeImageName(t *testing.T) {
+	assert.Equal(t, "docker.io/library/alpine:latest", normalizeImageName("alpine"))
+	assert.Equal(t, "quay.io/foo/bar:v2", normalizeImageName("docker://quay.io/foo/bar:v2"))
+	assert.Equal(t, "Invalid", normalizeImageName("Invalid"))
+}
@@ -0,0 +1,201 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	imgTypes "github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/pkg/auth"
+	"github.com/containers/podman/v5/pkg/bindings"
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+)
+
+// Add adds the content of the reader as a file named fileName to the artifact.
+// The content is streamed to the service.
+func Add(ctx context.Context, artifactName, fileName string, r io.Reader, options *AddOptions) (*entitiesTypes.ArtifactAddReport, error) {
+	var report entitiesTypes.ArtifactAddReport
+	if options == nil {
+		options = new(AddOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	params.Set("name", artifactName)
+	params.Set("fileName", fileName)
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	response, err := conn.DoRequest(ctx, r, http.MethodPost, "/artifacts/add", params, header)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// Extract writes the blobs of the artifact to w as a tar stream.  Each blob is
+// named after its title annotation, or its digest if it has none.
+func Extract(ctx context.Context, artifactName string, w io.Writer, options *ExtractOptions) error {
+	if options == nil {
+		options = new(ExtractOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/artifacts/%s/extract", params, nil, artifactName)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.IsSuccess() {
+		_, err = io.Copy(w, response.Body)
+		return err
+	}
+	return response.Process(nil)
+}
+
+// Inspect returns information about the artifact.
+func Inspect(ctx context.Context, artifactName string, options *InspectOptions) (*entitiesTypes.ArtifactInspectReport, error) {
+	var report entitiesTypes.ArtifactInspectReport
+	if options == nil {
+		options = new(InspectOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/artifacts/%s/json", nil, nil, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// List returns the artifacts in local storage.
+func List(ctx context.Context, options *ListOptions) ([]*entitiesTypes.ArtifactListReport, error) {
+	var reports []*entitiesTypes.ArtifactListReport
+	if options == nil {
+		options = new(ListOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/artifacts/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return reports, response.Process(&reports)
+}
+
+// Pull pulls the artifact from a registry into local storage.
+func Pull(ctx context.Context, artifactName string, options *PullOptions) (*entitiesTypes.ArtifactPullReport, error) {
+	var report entitiesTypes.ArtifactPullReport
+	if options == nil {
+		options = new(PullOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	params.Set("name", artifactName)
+
+	// SkipTLSVerify is special.  It's not being serialized by ToParams()
+	// because we need to flip the boolean.
+	if options.SkipTLSVerify != nil {
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+
+	header, err := auth.MakeXRegistryAuthHeader(&imgTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/artifacts/pull", params, header)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// Push pushes the artifact from local storage to the registry it is named
+// after.
+func Push(ctx context.Context, artifactName string, options *PushOptions) (*entitiesTypes.ArtifactPushReport, error) {
+	var report entitiesTypes.ArtifactPushReport
+	if options == nil {
+		options = new(PushOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	// SkipTLSVerify is special.  It's not being serialized by ToParams()
+	// because we need to flip the boolean.
+	if options.SkipTLSVerify != nil {
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+
+	header, err := auth.MakeXRegistryAuthHeader(&imgTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/artifacts/%s/push", params, header, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// Remove removes the artifact from local storage.
+func Remove(ctx context.Context, artifactName string, options *RemoveOptions) (*entitiesTypes.ArtifactRemoveReport, error) {
+	var report entitiesTypes.ArtifactRemoveReport
+	if options == nil {
+		options = new(RemoveOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodDelete, "/artifacts/%s", nil, nil, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
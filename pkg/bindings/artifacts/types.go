@@ -0,0 +1,81 @@
+package artifacts
+
+// AddOptions are optional options for adding artifacts
+//
+//go:generate go run ../generator/generator.go AddOptions
+type AddOptions struct {
+	// Annotations to set on the added file, in the KEY=VALUE format
+	Annotations []string
+	// ArtifactMIMEType is the media type of the artifact
+	ArtifactMIMEType *string
+	// Append the file to an existing artifact
+	Append *bool
+	// FileMIMEType is the media type of the added file
+	FileMIMEType *string
+}
+
+// ExtractOptions are optional options for extracting artifacts
+//
+//go:generate go run ../generator/generator.go ExtractOptions
+type ExtractOptions struct {
+	// Digest of the only blob to extract.  Conflicts with Title.
+	Digest *string
+	// Title annotation of the only blob to extract.  Conflicts with Digest.
+	Title *string
+}
+
+// InspectOptions are optional options for inspecting artifacts
+//
+//go:generate go run ../generator/generator.go InspectOptions
+type InspectOptions struct {
+}
+
+// ListOptions are optional options for listing artifacts
+//
+//go:generate go run ../generator/generator.go ListOptions
+type ListOptions struct {
+}
+
+// PullOptions are optional options for pulling artifacts
+//
+//go:generate go run ../generator/generator.go PullOptions
+type PullOptions struct {
+	// Authfile is the path to the authentication file. Ignored for remote
+	// calls.
+	Authfile *string `schema:"-"`
+	// Password for authenticating against the registry.
+	Password *string `schema:"-"`
+	// Retry number of times to retry pull in case of failure
+	Retry *uint
+	// RetryDelay between retries in case of pull failures
+	RetryDelay *string
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify *bool `schema:"-"`
+	// Username for authenticating against the registry.
+	Username *string `schema:"-"`
+}
+
+// PushOptions are optional options for pushing artifacts
+//
+//go:generate go run ../generator/generator.go PushOptions
+type PushOptions struct {
+	// Authfile is the path to the authentication file. Ignored for remote
+	// calls.
+	Authfile *string `schema:"-"`
+	// Password for authenticating against the registry.
+	Password *string `schema:"-"`
+	// Retry number of times to retry push in case of failure
+	Retry *uint
+	// RetryDelay between retries in case of push failures
+	RetryDelay *string
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify *bool `schema:"-"`
+	// Username for authenticating against the registry.
+	Username *string `schema:"-"`
+}
+
+// RemoveOptions are optional options for removing artifacts
+//
+//go:generate go run ../generator/generator.go RemoveOptions
+type RemoveOptions struct {
+}
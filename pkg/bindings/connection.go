@@ -3,6 +3,8 @@ package bindings
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -79,6 +81,28 @@ func JoinURL(elements ...string) string {
 	return "/" + strings.Join(elements, "/")
 }
 
+// Options describe how to connect to a service with NewConnectionWithOptions.
+type Options struct {
+	// URI of the service, see NewConnectionWithIdentity for the valid forms.
+	URI string
+	// Identity is the path to the ssh identity file for ssh:// URIs.
+	Identity string
+	// Machine is true if the service runs in a podman machine.
+	Machine bool
+	// TLSCA is the path to the CA certificate used to verify the service
+	// of tcp+tls:// and https:// URIs.  The system roots are used if unset.
+	// The TLS files can also be set via the CONTAINER_TLS_CA,
+	// CONTAINER_TLS_CERT and CONTAINER_TLS_KEY environment variables and
+	// the tls_ca, tls_cert and tls_key query parameters of URI, in this
+	// order of precedence.
+	TLSCA string
+	// TLSCert is the path to the client certificate presented to the
+	// service of tcp+tls:// and https:// URIs.
+	TLSCert string
+	// TLSKey is the path to the key of TLSCert.
+	TLSKey string
+}
+
 // NewConnection creates a new service connection without an identity
 func NewConnection(ctx context.Context, uri string) (context.Context, error) {
 	return NewConnectionWithIdentity(ctx, uri, "", false)
@@ -90,10 +114,22 @@ func NewConnection(ctx context.Context, uri string) (context.Context, error) {
 //
 // A valid URI connection should be scheme://
 // For example tcp://localhost:<port>
+// or tcp+tls://localhost:<port> or https://localhost:<port>
 // or unix:///run/podman/podman.sock
 // or ssh://<user>@<host>[:port]/run/podman/podman.sock
 func NewConnectionWithIdentity(ctx context.Context, uri string, identity string, machine bool) (context.Context, error) {
+	return NewConnectionWithOptions(ctx, Options{
+		URI:      uri,
+		Identity: identity,
+		Machine:  machine,
+	})
+}
+
+// NewConnectionWithOptions is NewConnectionWithIdentity with the additional
+// TLS settings of tcp+tls:// and https:// URIs.
+func NewConnectionWithOptions(ctx context.Context, opts Options) (context.Context, error) {
 	var err error
+	uri, identity, machine := opts.URI, opts.Identity, opts.Machine
 	if v, found := os.LookupEnv("CONTAINER_HOST"); found && uri == "" {
 		uri = v
 	}
@@ -102,6 +138,19 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string,
 		identity = v
 	}
 
+	for _, env := range []struct {
+		name  string
+		value *string
+	}{
+		{"CONTAINER_TLS_CA", &opts.TLSCA},
+		{"CONTAINER_TLS_CERT", &opts.TLSCert},
+		{"CONTAINER_TLS_KEY", &opts.TLSKey},
+	} {
+		if v, found := os.LookupEnv(env.name); found && *env.value == "" {
+			*env.value = v
+		}
+	}
+
 	_url, err := url.Parse(uri)
 	if err != nil {
 		return nil, fmt.Errorf("value of CONTAINER_HOST is not a valid url: %s: %w", uri, err)
@@ -127,7 +176,21 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string,
 		if !strings.HasPrefix(uri, "tcp://") {
 			return nil, errors.New("tcp URIs should begin with tcp://")
 		}
-		conn, err := tcpClient(_url)
+		conn, err := tcpClient(_url, nil)
+		if err != nil {
+			return nil, newConnectError(err)
+		}
+		connection = conn
+	case "tcp+tls", "https":
+		if !strings.HasPrefix(uri, _url.Scheme+"://") {
+			return nil, fmt.Errorf("%s URIs should begin with %s://", _url.Scheme, _url.Scheme)
+		}
+		tlsOptionsFromURL(_url, &opts)
+		tlsConfig, err := newTLSConfig(_url, opts.TLSCA, opts.TLSCert, opts.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := tcpClient(_url, tlsConfig)
 		if err != nil {
 			return nil, newConnectError(err)
 		}
@@ -278,7 +341,61 @@ func sshClient(_url *url.URL, uri string, identity string, machine bool) (Connec
 	return connection, nil
 }
 
-func tcpClient(_url *url.URL) (Connection, error) {
+// tlsOptionsFromURL sets the TLS files not set in opts from the tls_ca,
+// tls_cert and tls_key query parameters of _url, which is how `podman system
+// connection add` stores them, and removes the parameters from _url.
+func tlsOptionsFromURL(_url *url.URL, opts *Options) {
+	query := _url.Query()
+	for _, param := range []struct {
+		name  string
+		value *string
+	}{
+		{"tls_ca", &opts.TLSCA},
+		{"tls_cert", &opts.TLSCert},
+		{"tls_key", &opts.TLSKey},
+	} {
+		if *param.value == "" {
+			*param.value = query.Get(param.name)
+		}
+		query.Del(param.name)
+	}
+	_url.RawQuery = query.Encode()
+}
+
+// newTLSConfig returns the configuration to connect to the service at _url
+// over TLS.  The client certificate is only presented if certFile is set.
+func newTLSConfig(_url *url.URL, caFile, certFile, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: _url.Hostname(),
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading TLS CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in TLS CA %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, errors.New("both a TLS client certificate and key are required")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading TLS client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// tcpClient connects to the service at _url over tcp, using TLS if
+// tlsConfig is set.
+func tcpClient(_url *url.URL, tlsConfig *tls.Config) (Connection, error) {
 	connection := Connection{
 		URI: _url,
 	}
@@ -310,6 +427,24 @@ func tcpClient(_url *url.URL) (Connection, error) {
 			}
 		}
 	}
+	if tlsConfig != nil {
+		// The handshake is done by the dialer rather than by
+		// http.Transport, so GetDialer() also returns TLS connections
+		// to hijack for attach and exec.
+		tcpDialContext := dialContext
+		dialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := tcpDialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			tlsConn := tls.Client(conn, tlsConfig)
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return tlsConn, nil
+		}
+	}
 	connection.Client = &http.Client{
 		Transport: &http.Transport{
 			DialContext:        dialContext,
@@ -394,7 +529,7 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 	}
 
 	baseURL := "http://d"
-	if c.URI.Scheme == "tcp" {
+	if c.URI.Scheme == "tcp" || c.URI.Scheme == "tcp+tls" || c.URI.Scheme == "https" {
 		// Allow path prefixes for tcp connections to match Docker behavior
 		baseURL = "http://" + c.URI.Host + c.URI.Path
 	}
@@ -0,0 +1,36 @@
+package containers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/containers/podman/v5/pkg/bindings"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Clone creates a copy of the container identified by the ID of cloneOptions.
+func Clone(ctx context.Context, cloneOptions *types.ContainerCloneOptions, options *CloneOptions) (types.ContainerCreateResponse, error) {
+	var ccr types.ContainerCreateResponse
+	if options == nil {
+		options = new(CloneOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return ccr, err
+	}
+	cloneString, err := jsoniter.MarshalToString(cloneOptions)
+	if err != nil {
+		return ccr, err
+	}
+	stringReader := strings.NewReader(cloneString)
+	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/containers/%s/clone", nil, nil, cloneOptions.ID)
+	if err != nil {
+		return ccr, err
+	}
+	defer response.Body.Close()
+
+	return ccr, response.Process(&ccr)
+}
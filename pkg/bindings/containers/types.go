@@ -121,7 +121,10 @@ type ExecStartOptions struct {
 // the health of a container
 //
 //go:generate go run ../generator/generator.go HealthCheckOptions
-type HealthCheckOptions struct{}
+type HealthCheckOptions struct {
+	// Readiness - run the readiness check rather than the healthcheck
+	Readiness *bool
+}
 
 // MountOptions are optional options for mounting
 // containers
@@ -342,3 +345,29 @@ type CopyOptions struct {
 type ExecRemoveOptions struct {
 	Force *bool
 }
+
+// CloneOptions are optional options for cloning containers
+//
+//go:generate go run ../generator/generator.go CloneOptions
+type CloneOptions struct{}
+
+// RunlabelOptions are optional options for resolving the runlabel of an image
+//
+//go:generate go run ../generator/generator.go RunlabelOptions
+type RunlabelOptions struct {
+	// Args to append to the command of the runlabel
+	Args []string
+	// Authfile is the path to the authentication file. Ignored for remote
+	// calls.
+	Authfile *string `schema:"-"`
+	// Name of the container replacing the NAME placeholder
+	Name *string
+	// Password for authenticating against the registry.
+	Password *string `schema:"-"`
+	// Pull the image if it does not exist locally
+	Pull *bool
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify *bool `schema:"-"`
+	// Username for authenticating against the registry.
+	Username *string `schema:"-"`
+}
@@ -10,8 +10,10 @@ type KubeOptions struct {
 	Service *bool
 	// Type - the k8s kind to be generated i.e Pod or Deployment
 	Type *string
-	// Replicas - the value to set in the replicas field for a Deployment
+	// Replicas - the value to set in the replicas field for a Deployment or StatefulSet
 	Replicas *int32
+	// Schedule - the value to set in the schedule field for a CronJob
+	Schedule *string
 	// NoTrunc - don't truncate annotations to the Kubernetes maximum length of 63 characters
 	NoTrunc *bool
 }
@@ -77,6 +77,21 @@ func (o *KubeOptions) GetReplicas() int32 {
 	return *o.Replicas
 }
 
+// WithSchedule set field Schedule to given value
+func (o *KubeOptions) WithSchedule(value string) *KubeOptions {
+	o.Schedule = &value
+	return o
+}
+
+// GetSchedule returns value of field Schedule
+func (o *KubeOptions) GetSchedule() string {
+	if o.Schedule == nil {
+		var z string
+		return z
+	}
+	return *o.Schedule
+}
+
 // WithNoTrunc set field NoTrunc to given value
 func (o *KubeOptions) WithNoTrunc(value bool) *KubeOptions {
 	o.NoTrunc = &value
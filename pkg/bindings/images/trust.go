@@ -0,0 +1,57 @@
+package images
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/bindings"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+)
+
+// ShowTrust returns the trust policy and the signature stores configured for
+// the registries.
+func ShowTrust(ctx context.Context, options *ShowTrustOptions) (*types.ShowTrustReport, error) {
+	var report types.ShowTrustReport
+	if options == nil {
+		options = new(ShowTrustOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/trust", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// SetTrust sets the trust policy of the scope, which is either a registry or
+// repository, or "default" for the default policy.
+func SetTrust(ctx context.Context, scope string, options *SetTrustOptions) error {
+	if options == nil {
+		options = new(SetTrustOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	params.Set("scope", scope)
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/trust", params, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
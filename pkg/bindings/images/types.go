@@ -248,3 +248,27 @@ type ScpOptions struct {
 	Quiet       *bool
 	Destination *string
 }
+
+// ShowTrustOptions are optional options for showing the trust policy
+//
+//go:generate go run ../generator/generator.go ShowTrustOptions
+type ShowTrustOptions struct {
+	// PolicyPath is the path of the trust policy file on the server.
+	PolicyPath *string
+	// Raw only returns the raw content of the trust policy file.
+	Raw *bool
+	// RegistryPath is the path of the registries.d directory on the server.
+	RegistryPath *string
+}
+
+// SetTrustOptions are optional options for setting the trust policy
+//
+//go:generate go run ../generator/generator.go SetTrustOptions
+type SetTrustOptions struct {
+	// PolicyPath is the path of the trust policy file on the server.
+	PolicyPath *string
+	// PubKeysFile are the paths of the public keys on the server.
+	PubKeysFile []string
+	// Type is the trust type.
+	Type *string
+}
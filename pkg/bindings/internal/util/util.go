@@ -17,7 +17,9 @@ func IsSimpleType(f reflect.Value) bool {
 	}
 
 	switch f.Kind() {
-	case reflect.Bool, reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.String:
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		return true
 	}
 
@@ -32,10 +34,10 @@ func SimpleTypeToParam(f reflect.Value) string {
 	switch f.Kind() {
 	case reflect.Bool:
 		return strconv.FormatBool(f.Bool())
-	case reflect.Int, reflect.Int64:
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		// f.Int() is always an int64
 		return strconv.FormatInt(f.Int(), 10)
-	case reflect.Uint, reflect.Uint64:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		// f.Uint() is always an uint64
 		return strconv.FormatUint(f.Uint(), 10)
 	case reflect.String:
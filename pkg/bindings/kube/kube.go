@@ -52,22 +52,10 @@ func PlayWithBody(ctx context.Context, body io.Reader, options *PlayOptions) (*e
 
 	// For the remote case, read any configMaps passed and append it to the main yaml content
 	if options.ConfigMaps != nil {
-		yamlBytes, err := io.ReadAll(body)
+		body, err = appendConfigMaps(body, *options.ConfigMaps)
 		if err != nil {
 			return nil, err
 		}
-
-		for _, cm := range *options.ConfigMaps {
-			// Add kube yaml splitter
-			yamlBytes = append(yamlBytes, []byte("---\n")...)
-			cmBytes, err := os.ReadFile(cm)
-			if err != nil {
-				return nil, err
-			}
-			cmBytes = append(cmBytes, []byte("\n")...)
-			yamlBytes = append(yamlBytes, cmBytes...)
-		}
-		body = io.NopCloser(bytes.NewReader(yamlBytes))
 	}
 
 	header, err := auth.MakeXRegistryAuthHeader(&types.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
@@ -88,6 +76,27 @@ func PlayWithBody(ctx context.Context, body io.Reader, options *PlayOptions) (*e
 	return &report, nil
 }
 
+// appendConfigMaps appends the configmap YAMLs at the given paths to the
+// kube YAML.
+func appendConfigMaps(body io.Reader, configMaps []string) (io.Reader, error) {
+	yamlBytes, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cm := range configMaps {
+		// Add kube yaml splitter
+		yamlBytes = append(yamlBytes, []byte("---\n")...)
+		cmBytes, err := os.ReadFile(cm)
+		if err != nil {
+			return nil, err
+		}
+		cmBytes = append(cmBytes, []byte("\n")...)
+		yamlBytes = append(yamlBytes, cmBytes...)
+	}
+	return io.NopCloser(bytes.NewReader(yamlBytes)), nil
+}
+
 func Down(ctx context.Context, path string, options DownOptions) (*entitiesTypes.KubePlayReport, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -166,3 +175,101 @@ func ApplyWithBody(ctx context.Context, body io.Reader, options *ApplyOptions) e
 
 	return nil
 }
+
+// Scale sets the number of replicas of the Deployments in the YAML file.
+func Scale(ctx context.Context, path string, options *ScaleOptions) (*entitiesTypes.KubePlayReport, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logrus.Warn(err)
+		}
+	}()
+
+	return ScaleWithBody(ctx, f, options)
+}
+
+func ScaleWithBody(ctx context.Context, body io.Reader, options *ScaleOptions) (*entitiesTypes.KubePlayReport, error) {
+	var report entitiesTypes.KubePlayReport
+	if options == nil {
+		options = new(ScaleOptions)
+	}
+
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	// As with play, the configMaps are sent along with the YAML.
+	if options.ConfigMaps != nil {
+		body, err = appendConfigMaps(body, *options.ConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := conn.DoRequest(ctx, body, http.MethodPost, "/kube/scale", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// Diff compares the objects of the YAML file with the ones created by kube
+// play.
+func Diff(ctx context.Context, path string, options *DiffOptions) (*entitiesTypes.KubeDiffReport, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logrus.Warn(err)
+		}
+	}()
+
+	return DiffWithBody(ctx, f, options)
+}
+
+func DiffWithBody(ctx context.Context, body io.Reader, options *DiffOptions) (*entitiesTypes.KubeDiffReport, error) {
+	var report entitiesTypes.KubeDiffReport
+	if options == nil {
+		options = new(DiffOptions)
+	}
+
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// As with play, the configMaps are sent along with the YAML.
+	if options.ConfigMaps != nil {
+		body, err = appendConfigMaps(body, *options.ConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := conn.DoRequest(ctx, body, http.MethodPost, "/kube/diff", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
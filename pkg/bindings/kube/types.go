@@ -63,6 +63,11 @@ type PlayOptions struct {
 	// Wait - indicates whether to return after having created the pods
 	Wait             *bool
 	ServiceContainer *bool
+	// WaitReady - wait for all containers of the started pods to become ready
+	WaitReady *bool
+	// WaitReadyTimeout - maximum time to wait for the containers to become
+	// ready, for example "5m"
+	WaitReadyTimeout *string
 }
 
 // ApplyOptions are optional options for applying kube YAML files to a k8s cluster
@@ -88,3 +93,22 @@ type DownOptions struct {
 	// Force - remove volumes on --down
 	Force *bool
 }
+
+// ScaleOptions are optional options for scaling the Deployments of kube YAML files
+//
+//go:generate go run ../generator/generator.go ScaleOptions
+type ScaleOptions struct {
+	// Replicas - the number of replicas of each Deployment
+	Replicas *int32
+	// ConfigMaps - slice of pathnames to kubernetes configmap YAMLs.
+	ConfigMaps *[]string
+}
+
+// DiffOptions are optional options for comparing kube YAML files with the
+// objects created by kube play
+//
+//go:generate go run ../generator/generator.go DiffOptions
+type DiffOptions struct {
+	// ConfigMaps - slice of pathnames to kubernetes configmap YAMLs.
+	ConfigMaps *[]string
+}
@@ -236,3 +236,24 @@ func Prune(ctx context.Context, options *PruneOptions) ([]*entitiesTypes.Network
 
 	return prunedNetworks, response.Process(&prunedNetworks)
 }
+
+// Reload reloads the network configuration of the container, which restores
+// its firewall rules.
+func Reload(ctx context.Context, containerNameOrID string, options *ReloadOptions) (*entitiesTypes.NetworkReloadReport, error) {
+	var report entitiesTypes.NetworkReloadReport
+	if options == nil {
+		options = new(ReloadOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/containers/%s/networks/reload", nil, nil, containerNameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
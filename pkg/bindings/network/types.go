@@ -101,3 +101,10 @@ type ExtraCreateOptions struct {
 	// IgnoreIfExists if true, do not fail if the network already exists
 	IgnoreIfExists *bool `schema:"ignoreIfExists"`
 }
+
+// ReloadOptions are optional options for reloading the networks of
+// containers
+//
+//go:generate go run ../generator/generator.go ReloadOptions
+type ReloadOptions struct {
+}
@@ -0,0 +1,54 @@
+package plugins
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/bindings"
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+)
+
+// Inspect returns information about a volume plugin.
+func Inspect(ctx context.Context, name string, options *InspectOptions) (*entitiesTypes.PluginReport, error) {
+	var (
+		inspect entitiesTypes.PluginReport
+	)
+	if options == nil {
+		options = new(InspectOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/plugins/%s/json", nil, nil, name)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &inspect, response.Process(&inspect)
+}
+
+// List returns the volume plugins configured on the server.  Optionally,
+// filters can be used to refine the list of plugins.
+func List(ctx context.Context, options *ListOptions) ([]*entitiesTypes.PluginReport, error) {
+	var (
+		plugins []*entitiesTypes.PluginReport
+	)
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/plugins/json", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return plugins, response.Process(&plugins)
+}
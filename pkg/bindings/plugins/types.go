@@ -0,0 +1,15 @@
+package plugins
+
+// InspectOptions are optional options for inspecting plugins
+//
+//go:generate go run ../generator/generator.go InspectOptions
+type InspectOptions struct {
+}
+
+// ListOptions are optional options for listing plugins
+//
+//go:generate go run ../generator/generator.go ListOptions
+type ListOptions struct {
+	// Filters applied to the listing of plugins
+	Filters map[string][]string
+}
@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
+	imgTypes "github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/auth"
 	"github.com/containers/podman/v5/pkg/bindings"
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
 	"github.com/sirupsen/logrus"
@@ -170,3 +173,39 @@ func DiskUsage(ctx context.Context, options *DiskOptions) (*types.SystemDfReport
 
 	return &report, response.Process(&report)
 }
+
+// AutoUpdate auto-updates containers according to their auto-update policy.
+// Errors of single containers or units are returned in the response.
+func AutoUpdate(ctx context.Context, options *AutoUpdateOptions) (*types.AutoUpdateResponse, error) {
+	var report types.AutoUpdateResponse
+	if options == nil {
+		options = new(AutoUpdateOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	// SkipTLSVerify is special.  It's not being serialized by ToParams()
+	// because we need to flip the boolean.
+	if options.SkipTLSVerify != nil {
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+
+	header, err := auth.MakeXRegistryAuthHeader(&imgTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/autoupdate", params, header)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
@@ -49,3 +49,25 @@ type CheckOptions struct {
 	RepairLossy                 *bool   `schema:"repair_lossy"`
 	UnreferencedLayerMaximumAge *string `schema:"unreferenced_layer_max_age"`
 }
+
+// AutoUpdateOptions are optional options for auto-updating containers
+//
+//go:generate go run ../generator/generator.go AutoUpdateOptions
+type AutoUpdateOptions struct {
+	// Authfile is the path to the authentication file. Its credentials
+	// are sent to the service.
+	Authfile *string `schema:"-"`
+	// DryRun only checks for pending updates.
+	DryRun *bool
+	// Images restricts the update to containers created from one of them.
+	Images []string
+	// Rollback to the previous image if an update fails.
+	Rollback *bool
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify *bool `schema:"-"`
+	// Staged updates units running the same images one after another.
+	Staged *bool
+	// StagedTimeout is the time to wait for updated units to become
+	// healthy during a staged update.
+	StagedTimeout *string
+}
@@ -63,4 +63,11 @@ var _ = Describe("Binding types", func() {
 		Expect(params.Get("quiet")).To(Equal("true"))
 		Expect(params.Has("skiptlsverify")).To(BeFalse())
 	})
+
+	It("serialize kube scale options", func() {
+		opts := new(kube.ScaleOptions).WithReplicas(0)
+		params, err := opts.ToParams()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params.Get("replicas")).To(Equal("0"))
+	})
 })
@@ -43,3 +43,15 @@ type RemoveOptions struct {
 //go:generate go run ../generator/generator.go ExistsOptions
 type ExistsOptions struct {
 }
+
+// ExportOptions are optional options for exporting volumes
+//
+//go:generate go run ../generator/generator.go ExportOptions
+type ExportOptions struct {
+}
+
+// ImportOptions are optional options for importing volumes
+//
+//go:generate go run ../generator/generator.go ImportOptions
+type ImportOptions struct {
+}
@@ -2,6 +2,7 @@ package volumes
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strings"
 
@@ -139,3 +140,47 @@ func Exists(ctx context.Context, nameOrID string, options *ExistsOptions) (bool,
 
 	return response.IsSuccess(), nil
 }
+
+// Export writes the content of the volume to w as a tar stream.
+func Export(ctx context.Context, nameOrID string, w io.Writer, options *ExportOptions) error {
+	if options == nil {
+		options = new(ExportOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/volumes/%s/export", nil, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.IsSuccess() {
+		_, err = io.Copy(w, response.Body)
+		return err
+	}
+	return response.Process(nil)
+}
+
+// Import extracts the tar stream of r into the volume.
+func Import(ctx context.Context, nameOrID string, r io.Reader, options *ImportOptions) error {
+	if options == nil {
+		options = new(ImportOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-tar")
+	response, err := conn.DoRequest(ctx, r, http.MethodPost, "/volumes/%s/import", nil, header, nameOrID)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
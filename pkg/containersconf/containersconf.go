@@ -0,0 +1,156 @@
+//go:build !windows
+
+// Package containersconf reads the options of containers.conf that are
+// specific to Podman and not known to containers/common.  The files are read
+// in the same order and with the same precedence as containers/common reads
+// them, see containers.conf(5), so the options can be set alongside the
+// common ones.  Keys unknown to containers/common are ignored by it.
+package containersconf
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/storage/pkg/unshare"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	containersConfEnv         = "CONTAINERS_CONF"
+	containersConfOverrideEnv = containersConfEnv + "_OVERRIDE"
+)
+
+// Config contains the Podman specific options of containers.conf.
+type Config struct {
+	Engine EngineConfig `toml:"engine"`
+}
+
+// EngineConfig contains the Podman specific options of the [engine] table.
+type EngineConfig struct {
+	// AuthorizationPlugins are the authorization plugins consulted, in
+	// order, by the API service before serving a request.  Each entry is
+	// the path to the unix socket of a plugin implementing the Docker
+	// AuthZ plugin protocol, or a plugin name looked up as
+	// /run/docker/plugins/NAME.sock.
+	AuthorizationPlugins []string `toml:"authorization_plugins,omitempty"`
+
+	// EventsSinkExec is an executable every event is piped to in addition
+	// to being logged.  The event is written as JSON to its stdin.
+	EventsSinkExec string `toml:"events_sink_exec,omitempty"`
+
+	// EventsSinkFilters limits the events delivered to the sinks.  The
+	// filters have the same syntax as the ones of `podman events`.
+	EventsSinkFilters []string `toml:"events_sink_filters,omitempty"`
+
+	// EventsSinkWebhook is a URL every event is POSTed to as JSON in
+	// addition to being logged.
+	EventsSinkWebhook string `toml:"events_sink_webhook,omitempty"`
+
+	// HealthcheckScheduler is the scheduler running the healthchecks of
+	// containers.  "systemd" (default) uses transient systemd timers,
+	// "podman" runs the healthchecks in a Podman process next to the
+	// container which does not require systemd.
+	HealthcheckScheduler string `toml:"healthcheck_scheduler,omitempty"`
+}
+
+// New reads the Podman specific options from the containers.conf files on
+// the system followed by the specified modules, which must be absolute paths
+// as returned by config.Config.LoadedModules().  Later files override the
+// options set by earlier ones.
+func New(modules []string) (*Config, error) {
+	conf := &Config{}
+
+	paths, err := systemConfigs()
+	if err != nil {
+		return nil, fmt.Errorf("finding config on system: %w", err)
+	}
+	for _, path := range paths {
+		if err := readConfigFromFile(path, conf, true); err != nil {
+			return nil, err
+		}
+	}
+
+	additional := modules
+	// As with containers/common, the override must always win.
+	if path := os.Getenv(containersConfOverrideEnv); path != "" {
+		additional = append(additional, path)
+	}
+	for _, path := range additional {
+		if err := readConfigFromFile(path, conf, false); err != nil {
+			return nil, err
+		}
+	}
+	return conf, nil
+}
+
+// systemConfigs returns the containers.conf files in the order they are
+// read by containers/common.
+func systemConfigs() ([]string, error) {
+	if path := os.Getenv(containersConfEnv); path != "" {
+		return []string{path}, nil
+	}
+
+	paths := []string{config.DefaultContainersConfig, config.OverrideContainersConfig}
+	paths, err := addConfigs(config.OverrideContainersConfig+".d", paths)
+	if err != nil {
+		return nil, err
+	}
+
+	userPath, err := userConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	paths = append(paths, userPath)
+	return addConfigs(userPath+".d", paths)
+}
+
+// userConfigPath returns the path of the containers.conf file of the user.
+func userConfigPath() (string, error) {
+	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
+		return filepath.Join(configHome, strings.TrimPrefix(config.UserOverrideContainersConfig, ".config/")), nil
+	}
+	home, err := unshare.HomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, config.UserOverrideContainersConfig), nil
+}
+
+// addConfigs appends the *.conf files of the drop-in directory dir, sorted
+// by name, to paths.
+func addConfigs(dir string, paths []string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return paths, nil
+		}
+		return nil, err
+	}
+	var dropIns []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".conf") {
+			dropIns = append(dropIns, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(dropIns)
+	return append(paths, dropIns...), nil
+}
+
+// readConfigFromFile merges the options set in the file at path into conf.
+func readConfigFromFile(path string, conf *Config, ignoreErrNotExist bool) error {
+	if _, err := toml.DecodeFile(path, conf); err != nil {
+		if ignoreErrNotExist && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("decode configuration %v: %w", path, err)
+	}
+	logrus.Debugf("Read Podman options of config %q", path)
+	return nil
+}
@@ -0,0 +1,68 @@
+//go:build !windows
+
+package containersconf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConf(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	conf := writeConf(t, dir, "containers.conf", "[engine]\nhealthcheck_scheduler = \"podman\"\nevents_logger = \"file\"\nevents_sink_filters = [\"event=died\", \"event=oom\"]\n")
+	module := writeConf(t, dir, "module.conf", "[engine]\nhealthcheck_scheduler = \"module\"\n")
+	override := writeConf(t, dir, "override.conf", "[engine]\nhealthcheck_scheduler = \"override\"\n")
+
+	t.Setenv(containersConfEnv, conf)
+	t.Setenv(containersConfOverrideEnv, "")
+	podmanConfig, err := New(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "podman", podmanConfig.Engine.HealthcheckScheduler)
+	assert.Equal(t, []string{"event=died", "event=oom"}, podmanConfig.Engine.EventsSinkFilters)
+
+	podmanConfig, err = New([]string{module})
+	require.NoError(t, err)
+	assert.Equal(t, "module", podmanConfig.Engine.HealthcheckScheduler)
+
+	t.Setenv(containersConfOverrideEnv, override)
+	podmanConfig, err = New([]string{module})
+	require.NoError(t, err)
+	assert.Equal(t, "override", podmanConfig.Engine.HealthcheckScheduler)
+
+	_, err = New([]string{filepath.Join(dir, "missing.conf")})
+	assert.Error(t, err)
+
+	writeConf(t, dir, "invalid.conf", "[engine\n")
+	t.Setenv(containersConfEnv, filepath.Join(dir, "invalid.conf"))
+	_, err = New(nil)
+	assert.ErrorContains(t, err, "decode configuration")
+}
+
+func TestUserDropIns(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(containersConfEnv, "")
+	t.Setenv(containersConfOverrideEnv, "")
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	confDir := filepath.Join(dir, "containers")
+	dropInDir := filepath.Join(confDir, "containers.conf.d")
+	require.NoError(t, os.MkdirAll(dropInDir, 0755))
+	writeConf(t, confDir, "containers.conf", "[engine]\nhealthcheck_scheduler = \"user\"\n")
+	writeConf(t, dropInDir, "20-b.conf", "[engine]\nhealthcheck_scheduler = \"b\"\n")
+	writeConf(t, dropInDir, "10-a.conf", "[engine]\nhealthcheck_scheduler = \"a\"\n")
+	writeConf(t, dropInDir, "30-ignored.txt", "[engine]\nhealthcheck_scheduler = \"ignored\"\n")
+
+	podmanConfig, err := New(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "b", podmanConfig.Engine.HealthcheckScheduler)
+}
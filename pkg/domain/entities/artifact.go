@@ -6,8 +6,6 @@ import (
 	"github.com/containers/image/v5/types"
 	encconfig "github.com/containers/ocicrypt/config"
 	entityTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
-	"github.com/containers/podman/v5/pkg/libartifact"
-	"github.com/opencontainers/go-digest"
 )
 
 type ArtifactAddOptions struct {
@@ -94,24 +92,14 @@ type ArtifactRemoveOptions struct {
 	All bool
 }
 
-type ArtifactPullReport struct {
-	ArtifactDigest *digest.Digest
-}
+type ArtifactPullReport = entityTypes.ArtifactPullReport
 
-type ArtifactPushReport struct {
-	ArtifactDigest *digest.Digest
-}
+type ArtifactPushReport = entityTypes.ArtifactPushReport
 
 type ArtifactInspectReport = entityTypes.ArtifactInspectReport
 
-type ArtifactListReport struct {
-	*libartifact.Artifact
-}
+type ArtifactListReport = entityTypes.ArtifactListReport
 
-type ArtifactAddReport struct {
-	ArtifactDigest *digest.Digest
-}
+type ArtifactAddReport = entityTypes.ArtifactAddReport
 
-type ArtifactRemoveReport struct {
-	ArtifactDigests []*digest.Digest
-}
+type ArtifactRemoveReport = entityTypes.ArtifactRemoveReport
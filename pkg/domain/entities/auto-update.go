@@ -1,6 +1,11 @@
 package entities
 
-import "github.com/containers/image/v5/types"
+import (
+	"time"
+
+	"github.com/containers/image/v5/types"
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+)
 
 // AutoUpdateOptions are the options for running auto-update.
 type AutoUpdateOptions struct {
@@ -16,21 +21,24 @@ type AutoUpdateOptions struct {
 	// Allow contacting registries over HTTP, or HTTPS with failed TLS
 	// verification. Note that this does not affect other TLS connections.
 	InsecureSkipTLSVerify types.OptionalBool
+	// If set, only containers created from one of the specified images
+	// are considered for auto updates.  Names are compared in their
+	// fully-qualified form (e.g., "alpine" matches
+	// "docker.io/library/alpine:latest").
+	Images []string
+	// Update units running the same images in stages: a canary unit
+	// first and, once its containers are healthy, the remaining units one
+	// after another.  If a unit fails to update or to become healthy, the
+	// remaining units are not updated and, if Rollback is set, all units
+	// updated so far are rolled back.
+	Staged bool
+	// Time to wait for the containers of an updated unit to become
+	// healthy during a staged update.  Defaults to one minute.
+	StagedTimeout time.Duration
 }
 
 // AutoUpdateReport contains the results from running auto-update.
-type AutoUpdateReport struct {
-	// ID of the container *before* an update.
-	ContainerID string
-	// Name of the container *before* an update.
-	ContainerName string
-	// Name of the image.
-	ImageName string
-	// The configured auto-update policy.
-	Policy string
-	// SystemdUnit running a container configured for auto updates.
-	SystemdUnit string
-	// Indicates the update status: true, false, failed, pending (see
-	// DryRun).
-	Updated string
-}
+type AutoUpdateReport = entitiesTypes.AutoUpdateReport
+
+// AutoUpdateResponse is the response of the auto-update endpoint.
+type AutoUpdateResponse = entitiesTypes.AutoUpdateResponse
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"fmt"
 	"io"
 	"net/url"
 	"os"
@@ -47,8 +48,20 @@ type ContainerRunlabelOptions struct {
 	SkipTLSVerify imageTypes.OptionalBool
 }
 
-// ContainerRunlabelReport contains the results from executing container-runlabel.
-type ContainerRunlabelReport struct{}
+// ContainerRunlabelReport contains the command resolved from the runlabel of
+// an image.
+type ContainerRunlabelReport = types.ContainerRunlabelReport
+
+// RunlabelNotFoundError is returned when the image does not have the
+// requested runlabel.
+type RunlabelNotFoundError struct {
+	Label string
+	Image string
+}
+
+func (e *RunlabelNotFoundError) Error() string {
+	return fmt.Sprintf("cannot find the value of label: %s in image: %s", e.Label, e.Image)
+}
 
 // WaitOptions are arguments for waiting for a container.
 type WaitOptions struct {
@@ -384,6 +397,13 @@ type ContainerCleanupReport struct {
 	RmiErr   error
 }
 
+// ContainerSyslogOptions are the options of the process forwarding the log
+// of a container to syslog.
+type ContainerSyslogOptions struct {
+	// Since is the time to forward log lines written since.
+	Since time.Time
+}
+
 // ContainerInitOptions describes input options
 // for the container init cli
 type ContainerInitOptions struct {
@@ -483,15 +503,7 @@ type ContainerRenameOptions struct {
 }
 
 // ContainerCloneOptions contains options for cloning an existing container
-type ContainerCloneOptions struct {
-	ID           string
-	Destroy      bool
-	CreateOpts   ContainerCreateOptions
-	Image        string
-	RawImageName string
-	Run          bool
-	Force        bool
-}
+type ContainerCloneOptions = types.ContainerCloneOptions
 
 // ContainerUpdateOptions containers options for updating an existing containers cgroup configuration
 type ContainerUpdateOptions = types.ContainerUpdateOptions
@@ -34,8 +34,10 @@ type GenerateKubeOptions struct {
 	Service bool
 	// Type - the k8s kind to be generated i.e Pod or Deployment
 	Type string
-	// Replicas - the value to set in the replicas field for a Deployment
+	// Replicas - the value to set in the replicas field for a Deployment or StatefulSet
 	Replicas int32
+	// Schedule - the value to set in the schedule field for a CronJob
+	Schedule string
 	// UseLongAnnotations - don't truncate annotations to the Kubernetes maximum length of 63 characters
 	UseLongAnnotations bool
 }
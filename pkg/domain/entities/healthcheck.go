@@ -1,3 +1,15 @@
 package entities
 
-type HealthCheckOptions struct{}
+type HealthCheckOptions struct {
+	// Readiness runs the readiness check rather than the healthcheck.
+	Readiness bool
+}
+
+// HealthCheckSchedulerOptions are the options of the Podman healthcheck
+// scheduler.
+type HealthCheckSchedulerOptions struct {
+	// Name of the scheduler as recorded in the state of the container.
+	Name string
+	// Readiness runs the readiness check rather than the healthchecks.
+	Readiness bool
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"net"
+	"time"
 
 	"github.com/containers/image/v5/types"
 	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
@@ -79,6 +80,12 @@ type PlayKubeOptions struct {
 	PublishAllPorts bool
 	// Wait - indicates whether to return after having created the pods
 	Wait bool
+	// WaitReady - wait for all containers of the started pods to become
+	// ready before returning
+	WaitReady bool
+	// WaitReadyTimeout - maximum time to wait for the containers to become
+	// ready
+	WaitReadyTimeout time.Duration
 	// SystemContext - used when building the image
 	SystemContext *types.SystemContext
 }
@@ -99,6 +106,25 @@ type PlayKubeDownOptions struct {
 	Force bool
 }
 
+// KubeScaleOptions are options for scaling the Deployments of a kube YAML
+type KubeScaleOptions struct {
+	// Replicas - the number of replicas of each Deployment
+	Replicas int32
+	// ConfigMaps - slice of pathnames to kubernetes configmap YAMLs.
+	ConfigMaps []string
+}
+
+// KubeDiffOptions are options for comparing a kube YAML with the objects
+// created from it
+type KubeDiffOptions struct {
+	// ConfigMaps - slice of pathnames to kubernetes configmap YAMLs.
+	ConfigMaps []string
+}
+
+// KubeDiffReport contains the differences between a kube YAML and the
+// objects created from it
+type KubeDiffReport = entitiesTypes.KubeDiffReport
+
 // PlayKubeDownReport contains the results of tearing down play kube
 type PlayKubeTeardown = entitiesTypes.PlayKubeTeardown
 
@@ -0,0 +1,11 @@
+package entities
+
+import "github.com/containers/podman/v5/pkg/domain/entities/types"
+
+// PluginListOptions describes the options for listing volume plugins.
+type PluginListOptions struct {
+	Filters map[string][]string
+}
+
+// PluginReport describes a volume plugin configured in containers.conf.
+type PluginReport = types.PluginReport
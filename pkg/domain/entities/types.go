@@ -1,13 +1,9 @@
 package entities
 
 import (
-	"net"
-
-	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
 	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
-	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/storage/pkg/archive"
 )
 
@@ -47,22 +43,7 @@ type NetFlags struct {
 
 // NetOptions reflect the shared network options between
 // pods and containers
-type NetOptions struct {
-	AddHosts           []string                           `json:"hostadd,omitempty"`
-	Aliases            []string                           `json:"network_alias,omitempty"`
-	Networks           map[string]types.PerNetworkOptions `json:"networks,omitempty"`
-	UseImageResolvConf bool                               `json:"no_manage_resolv_conf,omitempty"`
-	DNSOptions         []string                           `json:"dns_option,omitempty"`
-	DNSSearch          []string                           `json:"dns_search,omitempty"`
-	DNSServers         []net.IP                           `json:"dns_server,omitempty"`
-	HostsFile          string                             `json:"hosts_file,omitempty"`
-	Network            specgen.Namespace                  `json:"netns,omitempty"`
-	NoHostname         bool                               `json:"no_manage_hostname,omitempty"`
-	NoHosts            bool                               `json:"no_manage_hosts,omitempty"`
-	PublishPorts       []types.PortMapping                `json:"portmappings,omitempty"`
-	// NetworkOptions are additional options for each network
-	NetworkOptions map[string][]string `json:"network_options,omitempty"`
-}
+type NetOptions = entitiesTypes.NetOptions
 
 // InspectOptions all CLI inspect commands and inspect sub-commands use the same options
 type InspectOptions struct {
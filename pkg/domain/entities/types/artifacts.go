@@ -1,8 +1,31 @@
 package types
 
-import "github.com/containers/podman/v5/pkg/libartifact"
+import (
+	"github.com/containers/podman/v5/pkg/libartifact"
+	"github.com/opencontainers/go-digest"
+)
 
 type ArtifactInspectReport struct {
 	*libartifact.Artifact
 	Digest string
 }
+
+type ArtifactListReport struct {
+	*libartifact.Artifact
+}
+
+type ArtifactAddReport struct {
+	ArtifactDigest *digest.Digest
+}
+
+type ArtifactPullReport struct {
+	ArtifactDigest *digest.Digest
+}
+
+type ArtifactPushReport struct {
+	ArtifactDigest *digest.Digest
+}
+
+type ArtifactRemoveReport struct {
+	ArtifactDigests []*digest.Digest
+}
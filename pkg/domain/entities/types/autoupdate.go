@@ -0,0 +1,32 @@
+package types
+
+// AutoUpdateReport contains the results from running auto-update.
+type AutoUpdateReport struct {
+	// Names of the artifacts mounted into the container that are
+	// checked for updates along with the image.
+	ArtifactNames []string
+	// ID of the container *before* an update.
+	ContainerID string
+	// Name of the container *before* an update.
+	ContainerName string
+	// Name of the image.
+	ImageName string
+	// The configured auto-update policy.
+	Policy string
+	// The stage of a staged update: canary, rollout or empty if the
+	// unit has not been updated in stages.
+	Stage string
+	// SystemdUnit running a container configured for auto updates.
+	SystemdUnit string
+	// Indicates the update status: true, false, failed, pending (see
+	// DryRun), rolled back or aborted (see Staged).
+	Updated string
+}
+
+// AutoUpdateResponse is the response of the auto-update endpoint.
+type AutoUpdateResponse struct {
+	// Reports of the containers configured for auto updates.
+	Reports []*AutoUpdateReport
+	// Errors that occurred while checking for or performing updates.
+	Errors []string `json:",omitempty"`
+}
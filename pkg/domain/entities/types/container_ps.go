@@ -71,6 +71,9 @@ type ListContainer struct {
 	State string
 	// Status is a human-readable approximation of a duration for json output
 	Status string
+	// Readiness of the container, "ready" or "not ready".  Empty if the
+	// container has no readiness check.
+	Readiness string `json:",omitempty"`
 }
 
 // ListContainerNamespaces contains the identifiers of the container's Linux namespaces
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"net"
+
 	commonTypes "github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/pkg/specgen"
 )
 
 // NetworkPruneReport containers the name of network and an error
@@ -48,3 +51,22 @@ type NetworkContainerInfo struct {
 	// Interfaces configured for this container with their addresses
 	Interfaces map[string]commonTypes.NetInterface `json:"interfaces,omitempty"`
 }
+
+// NetOptions reflect the shared network options between
+// pods and containers
+type NetOptions struct {
+	AddHosts           []string                                 `json:"hostadd,omitempty"`
+	Aliases            []string                                 `json:"network_alias,omitempty"`
+	Networks           map[string]commonTypes.PerNetworkOptions `json:"networks,omitempty"`
+	UseImageResolvConf bool                                     `json:"no_manage_resolv_conf,omitempty"`
+	DNSOptions         []string                                 `json:"dns_option,omitempty"`
+	DNSSearch          []string                                 `json:"dns_search,omitempty"`
+	DNSServers         []net.IP                                 `json:"dns_server,omitempty"`
+	HostsFile          string                                   `json:"hosts_file,omitempty"`
+	Network            specgen.Namespace                        `json:"netns,omitempty"`
+	NoHostname         bool                                     `json:"no_manage_hostname,omitempty"`
+	NoHosts            bool                                     `json:"no_manage_hosts,omitempty"`
+	PublishPorts       []commonTypes.PortMapping                `json:"portmappings,omitempty"`
+	// NetworkOptions are additional options for each network
+	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+}
@@ -46,3 +46,51 @@ type PlayKubeTeardown struct {
 type PlaySecret struct {
 	CreateReport *SecretCreateReport
 }
+
+// Actions of a KubeDiffChange.
+const (
+	KubeDiffActionAdd    = "add"
+	KubeDiffActionRemove = "remove"
+	KubeDiffActionChange = "change"
+)
+
+type KubeDiffField struct {
+	// Field - the path of the field, for example "image" or "env.FOO".
+	Field string
+	// Current - the current value, nil if the field is not set.
+	Current *string `json:",omitempty"`
+	// Desired - the value in the YAML, nil if the field is not set.
+	Desired *string `json:",omitempty"`
+	// Redacted - the values are secret and therefore left empty.
+	Redacted bool `json:",omitempty"`
+}
+
+type KubeDiffChange struct {
+	// Action - either "add", "remove" or "change".
+	Action string
+	// Kind - the kind of the object: Pod, Container, Volume or Secret.
+	Kind string
+	// Name - the name of the object.
+	Name string
+	// Fields - the changed fields of the object if it is changed.
+	Fields []KubeDiffField `json:",omitempty"`
+}
+
+type KubeDiffUnchecked struct {
+	// Kind - the kind of the object: Pod or Container.
+	Kind string
+	// Name - the name of the object.
+	Name string
+	// Fields - the fields set in the YAML that are not compared.
+	Fields []string
+}
+
+// KubeDiffReport contains the differences between a kube YAML and the
+// objects created from it.
+type KubeDiffReport struct {
+	// Changes - the objects kube play --replace adds, removes or changes.
+	Changes []KubeDiffChange
+	// Unchecked - the existing objects with fields set in the YAML that
+	// are not compared, changes of these fields are not detected.
+	Unchecked []KubeDiffUnchecked `json:",omitempty"`
+}
@@ -0,0 +1,6 @@
+package types
+
+import "github.com/containers/podman/v5/libpod/define"
+
+// PluginReport describes a volume plugin configured in containers.conf.
+type PluginReport = define.VolumePluginInfo
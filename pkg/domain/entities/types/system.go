@@ -9,10 +9,17 @@ import (
 
 // ServiceOptions provides the input for starting an API and sidecar pprof services
 type ServiceOptions struct {
-	CorsHeaders string        // Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr   string        // Network address to bind pprof profiles service
-	Timeout     time.Duration // Duration of inactivity the service should wait before shutting down
-	URI         string        // Path to unix domain socket service should listen on
+	AuthorizationPlugins   []string      // Authorization plugins consulted before serving a request
+	AutoUpdateWebhookAddr  string        // Network address to bind the auto-update webhook
+	AutoUpdateWebhookToken string        // Token registries must send to the auto-update webhook
+	CorsHeaders            string        // Cross-Origin Resource Sharing (CORS) headers
+	MetricsAddr            string        // Network address to bind the Prometheus metrics endpoint
+	PProfAddr              string        // Network address to bind pprof profiles service
+	Timeout                time.Duration // Duration of inactivity the service should wait before shutting down
+	TLSCertFile            string        // Certificate the service presents to TLS clients
+	TLSClientCAFile        string        // CA certificate required to have signed the client certificates
+	TLSKeyFile             string        // Key of TLSCertFile
+	URI                    string        // Path to unix domain socket service should listen on
 }
 
 // SystemCheckOptions provides options for checking storage consistency.
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"io"
 	"net/url"
 
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
@@ -37,6 +38,18 @@ type VolumeListReport = types.VolumeListReport
 // VolumeReloadReport describes the response from reload volume plugins
 type VolumeReloadReport = types.VolumeReloadReport
 
+// VolumeExportOptions describes the options needed to export a volume.
+type VolumeExportOptions struct {
+	// Output receives the content of the volume as a tar stream.
+	Output io.Writer
+}
+
+// VolumeImportOptions describes the options needed to import a volume.
+type VolumeImportOptions struct {
+	// Input is the tar stream to extract into the volume.
+	Input io.Reader
+}
+
 /*
  * Docker API compatibility types
  */
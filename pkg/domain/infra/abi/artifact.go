@@ -149,6 +149,12 @@ func (ir *ImageEngine) ArtifactPush(ctx context.Context, name string, opts entit
 		retryDelay = &rd
 	}
 
+	// The API service passes the credentials as username and password.
+	credentials := opts.CredentialsCLI
+	if credentials == "" && opts.Username != "" {
+		credentials = opts.Username + ":" + opts.Password
+	}
+
 	copyOpts := libimage.CopyOptions{
 		SystemContext:                    nil,
 		SourceLookupReferenceFunc:        nil,
@@ -183,7 +189,7 @@ func (ir *ImageEngine) ArtifactPush(ctx context.Context, name string, opts entit
 		Variant:                          "",
 		Username:                         "",
 		Password:                         "",
-		Credentials:                      opts.CredentialsCLI,
+		Credentials:                      credentials,
 		IdentityToken:                    "",
 		Writer:                           opts.Writer,
 	}
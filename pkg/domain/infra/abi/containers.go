@@ -1346,6 +1346,14 @@ func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []st
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerLogRotate(ctx context.Context, nameOrID string) error {
+	return ic.Libpod.LogRotator(ctx, nameOrID)
+}
+
+func (ic *ContainerEngine) ContainerSyslog(ctx context.Context, nameOrID string, options entities.ContainerSyslogOptions) error {
+	return ic.Libpod.SyslogForwarder(ctx, nameOrID, options.Since)
+}
+
 func (ic *ContainerEngine) ContainerInit(ctx context.Context, namesOrIds []string, options entities.ContainerInitOptions) ([]*entities.ContainerInitReport, error) {
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds})
 	if err != nil {
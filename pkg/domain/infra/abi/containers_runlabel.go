@@ -6,65 +6,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
-	envLib "github.com/containers/podman/v5/pkg/env"
+	domainUtils "github.com/containers/podman/v5/pkg/domain/utils"
 	"github.com/containers/podman/v5/utils"
-	"github.com/containers/storage/pkg/fileutils"
 	"github.com/google/shlex"
 	"github.com/sirupsen/logrus"
 )
 
 func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string, imageRef string, args []string, options entities.ContainerRunlabelOptions) error {
-	pullOptions := &libimage.PullOptions{}
-	pullOptions.AuthFilePath = options.Authfile
-	pullOptions.CertDirPath = options.CertDir
-	pullOptions.Credentials = options.Credentials
-	pullOptions.SignaturePolicyPath = options.SignaturePolicy
-	pullOptions.InsecureSkipTLSVerify = options.SkipTLSVerify
-
-	pullPolicy := config.PullPolicyNever
-	if options.Pull {
-		pullPolicy = config.PullPolicyMissing
-	}
-	if !options.Quiet {
-		pullOptions.Writer = os.Stderr
-	}
-
-	pulledImages, err := ic.Libpod.LibimageRuntime().Pull(ctx, imageRef, pullPolicy, pullOptions)
+	report, err := ic.ContainerRunlabelCommand(ctx, label, imageRef, args, options)
 	if err != nil {
 		return err
 	}
 
-	if len(pulledImages) != 1 {
-		return errors.New("internal error: expected an image to be pulled (or an error)")
-	}
-
-	// Extract the runlabel from the image.
-	labels, err := pulledImages[0].Labels(ctx)
-	if err != nil {
-		return err
-	}
-
-	var runlabel string
-	for k, v := range labels {
-		if strings.EqualFold(k, label) {
-			runlabel = v
-			break
-		}
-	}
-	if runlabel == "" {
-		return fmt.Errorf("cannot find the value of label: %s in image: %s", label, imageRef)
-	}
-
-	cmd, env, err := generateRunlabelCommand(runlabel, pulledImages[0], imageRef, args, options)
+	cmd, env, err := domainUtils.RunlabelCommand(report.Command, "/proc/self/exe", options)
 	if err != nil {
 		return err
 	}
@@ -109,14 +70,61 @@ func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string,
 	return utils.ExecCmdWithStdStreams(stdIn, stdOut, stdErr, env, cmd[0], cmd[1:]...)
 }
 
+// ContainerRunlabelCommand pulls the image if needed and resolves its runlabel
+// into the command to execute.
+func (ic *ContainerEngine) ContainerRunlabelCommand(ctx context.Context, label string, imageRef string, args []string, options entities.ContainerRunlabelOptions) (*entities.ContainerRunlabelReport, error) {
+	pullOptions := &libimage.PullOptions{}
+	pullOptions.AuthFilePath = options.Authfile
+	pullOptions.CertDirPath = options.CertDir
+	pullOptions.Credentials = options.Credentials
+	pullOptions.SignaturePolicyPath = options.SignaturePolicy
+	pullOptions.InsecureSkipTLSVerify = options.SkipTLSVerify
+
+	pullPolicy := config.PullPolicyNever
+	if options.Pull {
+		pullPolicy = config.PullPolicyMissing
+	}
+	if !options.Quiet {
+		pullOptions.Writer = os.Stderr
+	}
+
+	pulledImages, err := ic.Libpod.LibimageRuntime().Pull(ctx, imageRef, pullPolicy, pullOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pulledImages) != 1 {
+		return nil, errors.New("internal error: expected an image to be pulled (or an error)")
+	}
+
+	// Extract the runlabel from the image.
+	labels, err := pulledImages[0].Labels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var runlabel string
+	for k, v := range labels {
+		if strings.EqualFold(k, label) {
+			runlabel = v
+			break
+		}
+	}
+	if runlabel == "" {
+		return nil, &entities.RunlabelNotFoundError{Label: label, Image: imageRef}
+	}
+
+	cmd, err := generateRunlabelCommand(runlabel, pulledImages[0], imageRef, args, options)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ContainerRunlabelReport{Command: cmd}, nil
+}
+
 // generateRunlabelCommand generates the to-be-executed command as a string
-// slice along with a base environment.
-func generateRunlabelCommand(runlabel string, img *libimage.Image, inputName string, args []string, options entities.ContainerRunlabelOptions) ([]string, []string, error) {
-	var (
-		err             error
-		name, imageName string
-		cmd             []string
-	)
+// slice.  Environment variables are expanded by the caller.
+func generateRunlabelCommand(runlabel string, img *libimage.Image, inputName string, args []string, options entities.ContainerRunlabelOptions) ([]string, error) {
+	var name, imageName string
 
 	// Extract the imageName (or ID).
 	imgNames := img.NamesHistory()
@@ -147,50 +155,7 @@ func generateRunlabelCommand(runlabel string, img *libimage.Image, inputName str
 		runlabel = fmt.Sprintf("%s %s", runlabel, strings.Join(args, " "))
 	}
 
-	cmd, err = generateCommand(runlabel, imageName, name)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	env := generateRunEnvironment(options)
-	env = append(env, "PODMAN_RUNLABEL_NESTED=1")
-	envmap, err := envLib.ParseSlice(env)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	envmapper := func(k string) string {
-		switch k {
-		case "OPT1":
-			return envmap["OPT1"]
-		case "OPT2":
-			return envmap["OPT2"]
-		case "OPT3":
-			return envmap["OPT3"]
-		case "PWD":
-			// I would prefer to use os.getenv but it appears PWD is not in the os env list.
-			d, err := os.Getwd()
-			if err != nil {
-				logrus.Error("Unable to determine current working directory")
-				return ""
-			}
-			return d
-		case "HOME":
-			h, err := os.UserHomeDir()
-			if err != nil {
-				logrus.Warnf("Unable to determine user's home directory: %s", err)
-				return ""
-			}
-			return h
-		}
-		return ""
-	}
-	newS := os.Expand(strings.Join(cmd, " "), envmapper)
-	cmd, err = shlex.Split(newS)
-	if err != nil {
-		return nil, nil, err
-	}
-	return cmd, env, nil
+	return generateCommand(runlabel, imageName, name)
 }
 
 func replaceName(arg, name string) string {
@@ -229,11 +194,10 @@ func generateCommand(command, imageName, name string) ([]string, error) {
 		return nil, err
 	}
 
-	prog, err := substituteCommand(cmd[0])
-	if err != nil {
-		return nil, err
+	if len(cmd) == 0 {
+		return nil, errors.New("the runlabel does not contain a command")
 	}
-	newCommand := []string{prog}
+	newCommand := []string{cmd[0]}
 	for _, arg := range cmd[1:] {
 		var newArg string
 		switch arg {
@@ -249,50 +213,3 @@ func generateCommand(command, imageName, name string) ([]string, error) {
 	}
 	return newCommand, nil
 }
-
-// GenerateRunEnvironment merges the current environment variables with optional
-// environment variables provided by the user
-func generateRunEnvironment(options entities.ContainerRunlabelOptions) []string {
-	newEnv := os.Environ()
-	if options.Optional1 != "" {
-		newEnv = append(newEnv, fmt.Sprintf("OPT1=%s", options.Optional1))
-	}
-	if options.Optional2 != "" {
-		newEnv = append(newEnv, fmt.Sprintf("OPT2=%s", options.Optional2))
-	}
-	if options.Optional3 != "" {
-		newEnv = append(newEnv, fmt.Sprintf("OPT3=%s", options.Optional3))
-	}
-	return newEnv
-}
-
-func substituteCommand(cmd string) (string, error) {
-	var (
-		newCommand string
-	)
-
-	// Replace cmd with "/proc/self/exe" if "podman" or "docker" is being
-	// used. If "/usr/bin/docker" is provided, we also sub in podman.
-	// Otherwise, leave the command unchanged.
-	if cmd == "podman" || filepath.Base(cmd) == "docker" {
-		newCommand = "/proc/self/exe"
-	} else {
-		newCommand = cmd
-	}
-
-	// If cmd is an absolute or relative path, check if the file exists.
-	// Throw an error if it doesn't exist.
-	if strings.Contains(newCommand, "/") || strings.HasPrefix(newCommand, ".") {
-		res, err := filepath.Abs(newCommand)
-		if err != nil {
-			return "", err
-		}
-		if err := fileutils.Exists(res); !errors.Is(err, fs.ErrNotExist) {
-			return res, nil
-		} else if err != nil {
-			return "", err
-		}
-	}
-
-	return newCommand, nil
-}
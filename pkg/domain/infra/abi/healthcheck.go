@@ -10,6 +10,17 @@ import (
 )
 
 func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, options entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+	if options.Readiness {
+		ready, _, err := ic.Libpod.ReadinessCheck(ctx, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		status := define.ReadinessCheckNotReady
+		if ready {
+			status = define.ReadinessCheckReady
+		}
+		return &define.HealthCheckResults{Status: status}, nil
+	}
 	status, err := ic.Libpod.HealthCheck(ctx, nameOrID)
 	if err != nil {
 		return nil, err
@@ -19,3 +30,7 @@ func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string,
 	}
 	return &report, nil
 }
+
+func (ic *ContainerEngine) HealthCheckScheduler(ctx context.Context, nameOrID string, options entities.HealthCheckSchedulerOptions) error {
+	return ic.Libpod.HealthCheckScheduler(ctx, nameOrID, options.Name, options.Readiness)
+}
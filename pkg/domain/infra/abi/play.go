@@ -5,11 +5,14 @@ package abi
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,7 +32,6 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi/internal/expansion"
-	v1apps "github.com/containers/podman/v5/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
 	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
 	"github.com/containers/podman/v5/pkg/specgen"
@@ -38,7 +40,6 @@ import (
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/podman/v5/pkg/systemd/notifyproxy"
 	"github.com/containers/podman/v5/pkg/util"
-	"github.com/containers/podman/v5/utils"
 	"github.com/containers/storage/pkg/fileutils"
 	"github.com/coreos/go-systemd/v22/daemon"
 	"github.com/opencontainers/go-digest"
@@ -173,6 +174,20 @@ func (ic *ContainerEngine) prepareAutomountImages(ctx context.Context, forContai
 	return toReturn, nil
 }
 
+// removeDirContents removes everything inside of dir but not dir itself.
+func removeDirContents(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func prepareVolumesFrom(forContainer, podName string, ctrNames, annotations map[string]string) ([]string, error) {
 	annotationVolsFrom := define.VolumesFromAnnotation + "/" + forContainer
 
@@ -260,18 +275,11 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 		return nil, err
 	}
 
-	// split yaml document
-	documentList, err := splitMultiDocYAML(content)
+	documents, err := readKubeDocuments(content)
 	if err != nil {
 		return nil, err
 	}
 
-	// sort kube kinds
-	documentList, err = sortKubeKinds(documentList)
-	if err != nil {
-		return nil, fmt.Errorf("unable to sort kube kinds: %w", err)
-	}
-
 	ipIndex := 0
 
 	var configMaps []v1.ConfigMap
@@ -319,14 +327,11 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 
 	// create pod on each document if it is a pod or deployment
 	// any other kube kind will be skipped
-	for _, document := range documentList {
-		kind, err := getKubeKind(document)
-		if err != nil {
-			return nil, fmt.Errorf("unable to read kube YAML: %w", err)
-		}
+	for _, document := range documents {
+		kind := document.kind
 
 		// TODO: create constants for the various "kinds" of yaml files.
-		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment") {
+		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment" || kind == "StatefulSet") {
 			ctr, err := ic.createServiceContainer(ctx, k8sName(content, "service"), options)
 			if err != nil {
 				return nil, err
@@ -348,30 +353,26 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			}()
 		}
 
+		plans, err := document.podPlans(options.PublishAllPorts)
+		if err != nil {
+			return nil, err
+		}
+
 		switch kind {
 		case "Pod":
-			var podYAML v1.Pod
-			var podTemplateSpec v1.PodTemplateSpec
-
-			if err := yaml.Unmarshal(document, &podYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Pod: %w", err)
-			}
-
-			podTemplateSpec.ObjectMeta = podYAML.ObjectMeta
-			podTemplateSpec.Spec = podYAML.Spec
-
+			plan := plans[0]
 			for name, val := range options.Annotations {
-				if podYAML.Annotations == nil {
-					podYAML.Annotations = make(map[string]string)
+				if plan.annotations == nil {
+					plan.annotations = make(map[string]string)
 				}
-				podYAML.Annotations[name] = val
+				plan.annotations[name] = val
 			}
 
-			if err := annotations.ValidateAnnotations(podYAML.Annotations); err != nil {
+			if err := annotations.ValidateAnnotations(plan.annotations); err != nil {
 				return nil, err
 			}
 
-			r, proxies, err := ic.playKubePod(ctx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, options, &ipIndex, podYAML.Annotations, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubePod(ctx, plan.name, plan.template, options, &ipIndex, plan.annotations, configMaps, serviceContainer, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -380,14 +381,8 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
 			setRanContainers(r)
-		case "DaemonSet":
-			var daemonSetYAML v1apps.DaemonSet
-
-			if err := yaml.Unmarshal(document, &daemonSetYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube DaemonSet: %w", err)
-			}
-
-			r, proxies, err := ic.playKubeDaemonSet(ctx, &daemonSetYAML, options, &ipIndex, configMaps, serviceContainer)
+		case "DaemonSet", "Job":
+			r, proxies, err := ic.playKubePodPlan(ctx, plans[0], options, &ipIndex, configMaps, serviceContainer, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -396,44 +391,28 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
 			setRanContainers(r)
-		case "Deployment":
-			var deploymentYAML v1apps.Deployment
-
-			if err := yaml.Unmarshal(document, &deploymentYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Deployment: %w", err)
-			}
-
-			r, proxies, err := ic.playKubeDeployment(ctx, &deploymentYAML, options, &ipIndex, configMaps, serviceContainer)
+		case "Deployment", "StatefulSet":
+			r, proxies, err := ic.playKubeReplicas(ctx, &document, plans, options, &ipIndex, configMaps, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
 			notifyProxies = append(notifyProxies, proxies...)
 
 			report.Pods = append(report.Pods, r.Pods...)
+			report.Volumes = append(report.Volumes, r.Volumes...)
 			validKinds++
 			setRanContainers(r)
-		case "Job":
-			var jobYAML v1.Job
-
-			if err := yaml.Unmarshal(document, &jobYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Job: %w", err)
-			}
-
-			r, proxies, err := ic.playKubeJob(ctx, &jobYAML, options, &ipIndex, configMaps, serviceContainer)
+		case "CronJob":
+			r, err := ic.playKubeCronJob(ctx, document.cronJob, plans[0], options, &ipIndex, configMaps)
 			if err != nil {
 				return nil, err
 			}
-			notifyProxies = append(notifyProxies, proxies...)
 
+			// The pod is started on schedule, not by kube play.
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
-			setRanContainers(r)
 		case "PersistentVolumeClaim":
-			var pvcYAML v1.PersistentVolumeClaim
-
-			if err := yaml.Unmarshal(document, &pvcYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube PersistentVolumeClaim: %w", err)
-			}
+			pvcYAML := document.claim
 
 			for name, val := range options.Annotations {
 				if pvcYAML.Annotations == nil {
@@ -448,7 +427,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 				}
 			}
 
-			r, err := ic.playKubePVC(ctx, "", &pvcYAML)
+			r, err := ic.playKubePVC(ctx, "", pvcYAML)
 			if err != nil {
 				return nil, err
 			}
@@ -456,28 +435,14 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Volumes = append(report.Volumes, r.Volumes...)
 			validKinds++
 		case "ConfigMap":
-			var configMap v1.ConfigMap
-
-			if err := yaml.Unmarshal(document, &configMap); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube ConfigMap: %w", err)
-			}
-			configMaps = append(configMaps, configMap)
+			configMaps = append(configMaps, *document.configMap)
 		case "Secret":
-			var secret v1.Secret
-
-			if err := yaml.Unmarshal(document, &secret); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as kube secret: %w", err)
-			}
-
-			r, err := ic.playKubeSecret(&secret)
+			r, err := ic.playKubeSecret(document.secret)
 			if err != nil {
 				return nil, err
 			}
 			report.Secrets = append(report.Secrets, entities.PlaySecret{CreateReport: r})
 			validKinds++
-		default:
-			logrus.Infof("Kube kind %s not supported", kind)
-			continue
 		}
 	}
 
@@ -488,6 +453,12 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 		return nil, fmt.Errorf("YAML document does not contain any supported kube kind")
 	}
 
+	if options.WaitReady && options.Start != types.OptionalBoolFalse {
+		if err := ic.waitForPodsReady(ctx, report.Pods, options.WaitReadyTimeout); err != nil {
+			return nil, err
+		}
+	}
+
 	if !options.ServiceContainer {
 		return report, nil
 	}
@@ -539,84 +510,83 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 	return report, nil
 }
 
-func (ic *ContainerEngine) playKubeDaemonSet(ctx context.Context, daemonSetYAML *v1apps.DaemonSet, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+// playKubeReplicas creates the pods of the replicas of a Deployment or the
+// ordinals of a StatefulSet.  On --replace, the existing pods exceeding the
+// replica count are removed, the other pods are replaced when they are
+// created.  The volumes of a StatefulSet are kept, as they are in Kubernetes.
+func (ic *ContainerEngine) playKubeReplicas(ctx context.Context, document *kubeDocument, plans []kubePodPlan, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
-		daemonSetName string
-		podSpec       v1.PodTemplateSpec
 		report        entities.PlayKubeReport
+		notifyProxies []*notifyproxy.NotifyProxy
 	)
 
-	daemonSetName = daemonSetYAML.ObjectMeta.Name
-	if daemonSetName == "" {
-		return nil, nil, errors.New("daemonSet does not have a name")
+	if options.Replace {
+		replicaPods, err := ic.existingReplicaPods(document)
+		if err != nil {
+			return nil, nil, err
+		}
+		var surplus []string
+		for index, podName := range replicaPods {
+			if index >= int32(len(plans)) {
+				surplus = append(surplus, podName)
+			}
+		}
+		if _, err := ic.PodRm(ctx, surplus, entities.PodRmOptions{Force: true, Ignore: true}); err != nil {
+			if document.kind == "Deployment" {
+				return nil, nil, fmt.Errorf("replacing deployment %s: %w", document.deployment.ObjectMeta.Name, err)
+			}
+			return nil, nil, fmt.Errorf("replacing statefulSet %s: %w", document.statefulSet.ObjectMeta.Name, err)
+		}
 	}
-	podSpec = daemonSetYAML.Spec.Template
 
-	podName := fmt.Sprintf("%s-pod", daemonSetName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, daemonSetYAML.Annotations, configMaps, serviceContainer)
-	if err != nil {
-		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+	// The pods are created in order, so that the pod of a StatefulSet
+	// ordinal is only created once the previous one has been created
+	// successfully.
+	for _, plan := range plans {
+		podReport, proxies, err := ic.playKubePodPlan(ctx, plan, options, ipIndex, configMaps, serviceContainer, nil)
+		notifyProxies = append(notifyProxies, proxies...)
+		if err != nil {
+			for _, proxy := range notifyProxies {
+				_ = proxy.Close()
+			}
+			return nil, nil, err
+		}
+		report.Pods = append(report.Pods, podReport.Pods...)
+		report.Volumes = append(report.Volumes, podReport.Volumes...)
 	}
-	report.Pods = podReport.Pods
 
-	return &report, proxies, nil
+	return &report, notifyProxies, nil
 }
 
-func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAML *v1apps.Deployment, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
-	var (
-		deploymentName string
-		podSpec        v1.PodTemplateSpec
-		numReplicas    int32
-		report         entities.PlayKubeReport
-	)
-
-	deploymentName = deploymentYAML.ObjectMeta.Name
-	if deploymentName == "" {
-		return nil, nil, errors.New("deployment does not have a name")
-	}
-	numReplicas = 1
-	if deploymentYAML.Spec.Replicas != nil {
-		numReplicas = *deploymentYAML.Spec.Replicas
-	}
-	if numReplicas > 1 {
-		logrus.Warnf("Limiting replica count to 1, more than one replica is not supported by Podman")
-	}
-	podSpec = deploymentYAML.Spec.Template
+// playKubePodPlan creates a pod of an object of a kube YAML along with the
+// volumes it claims.
+func (ic *ContainerEngine) playKubePodPlan(ctx context.Context, plan kubePodPlan, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container, cronJob *define.PodCronJob) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+	var report entities.PlayKubeReport
 
-	podName := fmt.Sprintf("%s-pod", deploymentName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, deploymentYAML.Annotations, configMaps, serviceContainer)
-	if err != nil {
-		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+	if plan.index > 0 {
+		// Host ports passed on the command line cannot be shared,
+		// they are published by the first replica only.
+		options.PublishPorts = nil
+		options.PublishAllPorts = false
 	}
-	report.Pods = podReport.Pods
 
-	return &report, proxies, nil
-}
-
-func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1.Job, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
-	var (
-		jobName string
-		podSpec v1.PodTemplateSpec
-		report  entities.PlayKubeReport
-	)
-
-	jobName = jobYAML.ObjectMeta.Name
-	if jobName == "" {
-		return nil, nil, errors.New("job does not have a name")
+	for _, claim := range plan.claims {
+		volumeReport, err := ic.playKubePVC(ctx, "", &claim)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encountered while creating volume %s: %w", claim.Name, err)
+		}
+		report.Volumes = append(report.Volumes, volumeReport.Volumes...)
 	}
-	podSpec = jobYAML.Spec.Template
 
-	podName := fmt.Sprintf("%s-pod", jobName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, jobYAML.Annotations, configMaps, serviceContainer)
+	podReport, proxies, err := ic.playKubePod(ctx, plan.name, plan.template, options, ipIndex, plan.annotations, configMaps, serviceContainer, cronJob)
 	if err != nil {
-		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", plan.name, err)
 	}
 	report.Pods = podReport.Pods
-
 	return &report, proxies, nil
 }
 
-func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, serviceContainer *libpod.Container, cronJob *define.PodCronJob) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	cfg, err := ic.Libpod.GetConfigNoCopy()
 	if err != nil {
 		return nil, nil, err
@@ -648,6 +618,9 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		return nil, nil, fmt.Errorf("annotation %s without target volume is reserved for internal use", define.VolumesFromAnnotation)
 	}
 
+	// The options are modified below, remember the ones passed in.
+	playOptions := options
+
 	podOpt := entities.PodCreateOptions{
 		Infra:      true,
 		Net:        &entities.NetOptions{NoHosts: options.NoHosts, NoHostname: options.NoHostname},
@@ -777,19 +750,23 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		return nil, nil, err
 	}
 
-	volumes, err := kube.InitializeVolumes(podYAML.Spec.Volumes, configMaps, secretsManager, mountLabel)
+	volumes, err := kube.InitializeVolumes(podName, podYAML, configMaps, secretsManager, mountLabel)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Go through the volumes and create a podman volume for all volumes that have been
-	// defined by a configmap or secret
+	// defined by a configmap, a secret, the downward API or that are projected
 	for _, v := range volumes {
-		if (v.Type == kube.KubeVolumeTypeConfigMap || v.Type == kube.KubeVolumeTypeSecret) && !v.Optional {
+		podVolume := v.Type == kube.KubeVolumeTypeDownwardAPI || v.Type == kube.KubeVolumeTypeProjected
+		if (v.Type == kube.KubeVolumeTypeConfigMap || v.Type == kube.KubeVolumeTypeSecret || podVolume) && !v.Optional {
 			volumeOptions := []libpod.VolumeCreateOption{
 				libpod.WithVolumeName(v.Source),
 				libpod.WithVolumeMountLabel(mountLabel),
 			}
+			if podVolume {
+				volumeOptions = append(volumeOptions, libpod.WithVolumeLabels(map[string]string{define.KubePodVolumeLabel: podName}))
+			}
 			vol, err := ic.Libpod.NewVolume(ctx, volumeOptions...)
 			if err != nil {
 				if errors.Is(err, define.ErrVolumeExists) {
@@ -807,10 +784,21 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			if err != nil || mountPoint == "" {
 				return nil, nil, fmt.Errorf("unable to get mountpoint of volume %q: %w", vol.Name(), err)
 			}
+			// The files of downward API and projected volumes are generated
+			// for the pod, remove the ones of a previous play so that they
+			// are kept up to date on --replace.
+			if podVolume {
+				if err := removeDirContents(mountPoint); err != nil {
+					return nil, nil, fmt.Errorf("cleaning up volume %q: %w", vol.Name(), err)
+				}
+			}
 			defaultMode := v.DefaultMode
 			// Create files and add data to the volume mountpoint based on the Items in the volume
 			for k, v := range v.Items {
 				dataPath := filepath.Join(mountPoint, k)
+				if err := os.MkdirAll(filepath.Dir(dataPath), 0755); err != nil {
+					return nil, nil, fmt.Errorf("cannot create directory for file %q at volume mountpoint %q: %w", k, mountPoint, err)
+				}
 				f, err := os.Create(dataPath)
 				if err != nil {
 					return nil, nil, fmt.Errorf("cannot create file %q at volume mountpoint %q: %w", k, mountPoint, err)
@@ -882,6 +870,18 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		if err != nil {
 			return nil, nil, err
 		}
+
+		// Only the replicas of a Deployment can be scaled.
+		if podYAML.Labels[define.KubeOwnerKindLabel] == "Deployment" {
+			recorded, err := json.Marshal(newRecordedPlayOptions(playOptions))
+			if err != nil {
+				return nil, nil, fmt.Errorf("recording play options of pod %s: %w", podName, err)
+			}
+			if podSpec.PodSpecGen.InfraContainerSpec.Annotations == nil {
+				podSpec.PodSpecGen.InfraContainerSpec.Annotations = make(map[string]string)
+			}
+			podSpec.PodSpecGen.InfraContainerSpec.Annotations[define.KubePlayOptionsAnnotation] = string(recorded)
+		}
 	}
 
 	// Add the original container names from the kube yaml as aliases for it. This will allow network to work with
@@ -902,6 +902,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	if serviceContainer != nil {
 		podSpec.PodSpecGen.ServiceContainerID = serviceContainer.ID()
 	}
+	podSpec.PodSpecGen.CronJob = cronJob
 
 	if options.Replace {
 		if _, err := ic.PodRm(ctx, []string{podName}, entities.PodRmOptions{Force: true, Ignore: true}); err != nil {
@@ -1471,42 +1472,6 @@ func isSamePortProtocol(a, b string) bool {
 	return ret
 }
 
-func (ic *ContainerEngine) importVolume(ctx context.Context, vol *libpod.Volume, tarFile *os.File) error {
-	volumeConfig, err := vol.Config()
-	if err != nil {
-		return err
-	}
-
-	mountPoint := volumeConfig.MountPoint
-	if len(mountPoint) == 0 {
-		return errors.New("volume is not mounted anywhere on host")
-	}
-
-	driver := volumeConfig.Driver
-	volumeOptions := volumeConfig.Options
-	volumeMountStatus, err := ic.VolumeMounted(ctx, vol.Name())
-	if err != nil {
-		return err
-	}
-
-	// Check if volume needs a mount and export only if volume is mounted
-	if vol.NeedsMount() && !volumeMountStatus.Value {
-		return fmt.Errorf("volume needs to be mounted but is not mounted on %s", mountPoint)
-	}
-
-	// Check if volume is using `local` driver and has mount options type other than tmpfs
-	if len(driver) == 0 || driver == define.VolumeDriverLocal {
-		if mountOptionType, ok := volumeOptions["type"]; ok {
-			if mountOptionType != define.TypeTmpfs && !volumeMountStatus.Value {
-				return fmt.Errorf("volume is using a driver %s and volume is not mounted on %s", driver, mountPoint)
-			}
-		}
-	}
-
-	// dont care if volume is mounted or not we are gonna import everything to mountPoint
-	return utils.UntarToFileSystem(mountPoint, tarFile, nil)
-}
-
 // readConfigMapFromFile returns a kubernetes configMap obtained from --configmap flag
 func readConfigMapFromFile(r io.Reader) ([]v1.ConfigMap, error) {
 	configMaps := make([]v1.ConfigMap, 0)
@@ -1620,7 +1585,7 @@ func sortKubeKinds(documentList [][]byte) ([][]byte, error) {
 		}
 
 		switch kind {
-		case "Pod", "Deployment", "DaemonSet", "Job":
+		case "Pod", "Deployment", "DaemonSet", "StatefulSet", "Job", "CronJob":
 			sortedDocumentList = append(sortedDocumentList, document)
 		default:
 			sortedDocumentList = append([][]byte{document}, sortedDocumentList...)
@@ -1690,33 +1655,22 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 		return nil, err
 	}
 
-	// split yaml document
-	documentList, err := splitMultiDocYAML(content)
+	documents, err := readKubeDocuments(content)
 	if err != nil {
 		return nil, err
 	}
 
-	// sort kube kinds
-	documentList, err = sortKubeKinds(documentList)
-	if err != nil {
-		return nil, fmt.Errorf("unable to sort kube kinds: %w", err)
-	}
-
-	for _, document := range documentList {
-		kind, err := getKubeKind(document)
+	for _, document := range documents {
+		plans, err := document.podPlans(false)
 		if err != nil {
-			return nil, fmt.Errorf("unable to read as kube YAML: %w", err)
+			return nil, err
 		}
 
-		switch kind {
+		switch document.kind {
 		case "Pod":
-			var podYAML v1.Pod
-			if err := yaml.Unmarshal(document, &podYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Pod: %w", err)
-			}
-			podNames = append(podNames, podYAML.ObjectMeta.Name)
+			podNames = append(podNames, plans[0].name)
 
-			for _, vol := range podYAML.Spec.Volumes {
+			for _, vol := range document.pod.Spec.Volumes {
 				switch vs := vol.VolumeSource; {
 				case vs.PersistentVolumeClaim != nil:
 					volumeNames = append(volumeNames, vs.PersistentVolumeClaim.ClaimName)
@@ -1726,54 +1680,45 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 					volumeNames = append(volumeNames, vs.Secret.SecretName)
 				}
 			}
-		case "DaemonSet":
-			var daemonSetYAML v1apps.DaemonSet
-
-			if err := yaml.Unmarshal(document, &daemonSetYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube DaemonSet: %w", err)
-			}
-
-			podName := fmt.Sprintf("%s-pod", daemonSetYAML.Name)
-			podNames = append(podNames, podName)
-		case "Deployment":
-			var deploymentYAML v1apps.Deployment
-
-			if err := yaml.Unmarshal(document, &deploymentYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Deployment: %w", err)
-			}
-			var numReplicas int32 = 1
-			deploymentName := deploymentYAML.ObjectMeta.Name
-			if deploymentYAML.Spec.Replicas != nil {
-				numReplicas = *deploymentYAML.Spec.Replicas
+		case "DaemonSet", "Job":
+			podNames = append(podNames, plans[0].name)
+		case "CronJob":
+			// The timer of the CronJob is removed along with its pod.
+			podNames = append(podNames, plans[0].name)
+		case "Deployment", "StatefulSet":
+			// Remove the pods of all replicas, including those of a
+			// scaled Deployment or of a StatefulSet with a reduced
+			// replica count, along with the volumes they claim.
+			replicaPods, err := ic.existingReplicaPods(&document)
+			if err != nil {
+				return nil, err
 			}
-			if numReplicas > 1 {
-				logrus.Warnf("Limiting replica count to 1, more than one replica is not supported by Podman")
+			claims := make(map[int32][]v1.PersistentVolumeClaim, len(plans))
+			for _, plan := range plans {
+				if _, ok := replicaPods[plan.index]; !ok {
+					replicaPods[plan.index] = plan.name
+				}
+				claims[plan.index] = plan.claims
 			}
-			podName := fmt.Sprintf("%s-pod", deploymentName)
-			podNames = append(podNames, podName)
-		case "Job":
-			var jobYAML v1.Job
-
-			if err := yaml.Unmarshal(document, &jobYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Job: %w", err)
+			for _, index := range slices.Sorted(maps.Keys(replicaPods)) {
+				podNames = append(podNames, replicaPods[index])
+				if document.kind != "StatefulSet" {
+					continue
+				}
+				if _, ok := claims[index]; !ok {
+					// The claims of a surplus ordinal.
+					for _, claim := range document.statefulSet.Spec.VolumeClaimTemplates {
+						volumeNames = append(volumeNames, statefulSetClaimName(claim.Name, document.statefulSet.ObjectMeta.Name, index))
+					}
+				}
+				for _, claim := range claims[index] {
+					volumeNames = append(volumeNames, claim.Name)
+				}
 			}
-			jobName := jobYAML.ObjectMeta.Name
-			podName := fmt.Sprintf("%s-pod", jobName)
-			podNames = append(podNames, podName)
 		case "PersistentVolumeClaim":
-			var pvcYAML v1.PersistentVolumeClaim
-			if err := yaml.Unmarshal(document, &pvcYAML); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube PersistentVolumeClaim: %w", err)
-			}
-			volumeNames = append(volumeNames, pvcYAML.Name)
+			volumeNames = append(volumeNames, document.claim.Name)
 		case "Secret":
-			var secret v1.Secret
-			if err := yaml.Unmarshal(document, &secret); err != nil {
-				return nil, fmt.Errorf("unable to read YAML as Kube Secret: %w", err)
-			}
-			secretNames = append(secretNames, secret.Name)
-		default:
-			continue
+			secretNames = append(secretNames, document.secret.Name)
 		}
 	}
 
@@ -1797,6 +1742,21 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 		serviceCtrIDs = append(serviceCtrIDs, ctr.ID())
 	}
 
+	// The downwardAPI and projected volumes of the pods of all kinds are
+	// found by the pod they are labeled with.
+	if options.Force {
+		podVolumes, err := ic.Libpod.Volumes(func(v *libpod.Volume) bool {
+			podName, ok := v.Labels()[define.KubePodVolumeLabel]
+			return ok && slices.Contains(podNames, podName)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, vol := range podVolumes {
+			volumeNames = append(volumeNames, vol.Name())
+		}
+	}
+
 	// Add the reports
 	reports.StopReport, err = ic.PodStop(ctx, podNames, entities.PodStopOptions{
 		Ignore:  true,
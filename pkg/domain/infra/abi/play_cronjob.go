@@ -0,0 +1,246 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+)
+
+// cronMacros maps the predefined schedules of the Cron format to their
+// equivalent schedule.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes a field of a schedule in Cron format.
+type cronField struct {
+	name     string
+	min, max int
+	// names are the names of the values of the field starting at min.
+	names []string
+}
+
+var (
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	// Both 0 and 7 are Sunday.
+	cronDayOfWeek = cronField{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// systemdWeekdays are the weekday names of systemd calendar events indexed
+// by the Cron day of week.
+var systemdWeekdays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// parseValue parses a single value of the field, either a number or a name.
+func (f cronField) parseValue(value string) (int, error) {
+	if i := slices.Index(f.names, strings.ToLower(value)); i >= 0 {
+		return f.min + i, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid %s %q, must be between %d and %d", f.name, value, f.min, f.max)
+	}
+	return n, nil
+}
+
+// parse parses a comma separated list of values, ranges and steps and
+// returns the matching values.  A nil slice means any value matches.
+func (f cronField) parse(field string) ([]int, error) {
+	if field == "*" || field == "?" {
+		return nil, nil
+	}
+	var values []int
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s %q", stepPart, f.name, field)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rangePart == "*":
+			low, high = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = f.parseValue(lowPart); err != nil {
+				return nil, err
+			}
+			if high, err = f.parseValue(highPart); err != nil {
+				return nil, err
+			}
+			if low > high {
+				return nil, fmt.Errorf("invalid range %q in %s %q", rangePart, f.name, field)
+			}
+		default:
+			var err error
+			if low, err = f.parseValue(rangePart); err != nil {
+				return nil, err
+			}
+			high = low
+			// "N/step" starts at N and ends at the maximum.
+			if hasStep {
+				high = f.max
+			}
+		}
+		for v := low; v <= high; v += step {
+			values = append(values, v)
+		}
+	}
+	slices.Sort(values)
+	return slices.Compact(values), nil
+}
+
+// calendarComponent formats the values of a field of a systemd calendar
+// event.
+func calendarComponent(values []int) string {
+	if values == nil {
+		return "*"
+	}
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		items = append(items, fmt.Sprintf("%02d", v))
+	}
+	return strings.Join(items, ",")
+}
+
+// cronScheduleToOnCalendar converts a schedule in Cron format to a systemd
+// calendar event suitable for the OnCalendar= setting of a timer, see
+// systemd.time(7).
+func cronScheduleToOnCalendar(schedule string, timeZone *string) (string, error) {
+	expanded := strings.TrimSpace(schedule)
+	if strings.HasPrefix(expanded, "@") {
+		macro, ok := cronMacros[strings.ToLower(expanded)]
+		if !ok {
+			return "", fmt.Errorf("unsupported schedule %q", schedule)
+		}
+		expanded = macro
+	}
+	fields := strings.Fields(expanded)
+	if len(fields) != 5 {
+		return "", fmt.Errorf("invalid schedule %q, must have five fields", schedule)
+	}
+
+	minutes, err := cronMinute.parse(fields[0])
+	if err != nil {
+		return "", err
+	}
+	hours, err := cronHour.parse(fields[1])
+	if err != nil {
+		return "", err
+	}
+	daysOfMonth, err := cronDayOfMonth.parse(fields[2])
+	if err != nil {
+		return "", err
+	}
+	months, err := cronMonth.parse(fields[3])
+	if err != nil {
+		return "", err
+	}
+	daysOfWeek, err := cronDayOfWeek.parse(fields[4])
+	if err != nil {
+		return "", err
+	}
+	// Cron runs a job when either the day of month or the day of week
+	// matches, systemd requires both to match.
+	if daysOfMonth != nil && daysOfWeek != nil {
+		return "", fmt.Errorf("invalid schedule %q, restricting both the day of month and the day of week is not supported", schedule)
+	}
+
+	var calendar strings.Builder
+	if daysOfWeek != nil {
+		var weekdays []string
+		for _, day := range daysOfWeek {
+			weekday := systemdWeekdays[day%7]
+			if !slices.Contains(weekdays, weekday) {
+				weekdays = append(weekdays, weekday)
+			}
+		}
+		if len(weekdays) < len(systemdWeekdays) {
+			calendar.WriteString(strings.Join(weekdays, ",") + " ")
+		}
+	}
+	fmt.Fprintf(&calendar, "*-%s-%s %s:%s:00", calendarComponent(months), calendarComponent(daysOfMonth), calendarComponent(hours), calendarComponent(minutes))
+
+	if timeZone != nil && *timeZone != "" {
+		if _, err := time.LoadLocation(*timeZone); err != nil {
+			return "", fmt.Errorf("invalid time zone %q: %w", *timeZone, err)
+		}
+		calendar.WriteString(" " + *timeZone)
+	}
+	return calendar.String(), nil
+}
+
+// playKubeCronJob creates the pod of the job template of a CronJob and a
+// systemd timer starting it on schedule.  The pod is not started by kube
+// play, only by the timer.
+func (ic *ContainerEngine) playKubeCronJob(ctx context.Context, cronJobYAML *v1.CronJob, plan kubePodPlan, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap) (*entities.PlayKubeReport, error) {
+	cronJobName := cronJobYAML.ObjectMeta.Name
+	calendar, err := cronScheduleToOnCalendar(cronJobYAML.Spec.Schedule, cronJobYAML.Spec.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("cronJob %s: %w", cronJobName, err)
+	}
+	// A single pod runs the jobs, a job still running when the next
+	// one is due is either kept or restarted.  Jobs cannot run
+	// concurrently, Allow behaves as Forbid.
+	cronJob := &define.PodCronJob{Calendar: calendar}
+	switch cronJobYAML.Spec.ConcurrencyPolicy {
+	case "", v1.AllowConcurrent, v1.ForbidConcurrent:
+	case v1.ReplaceConcurrent:
+		cronJob.Restart = true
+	default:
+		return nil, fmt.Errorf("cronJob %s: invalid concurrencyPolicy %q", cronJobName, cronJobYAML.Spec.ConcurrencyPolicy)
+	}
+
+	// A suspended CronJob gets no timer, neither now nor after a reboot.
+	if cronJobYAML.Spec.Suspend != nil && *cronJobYAML.Spec.Suspend {
+		cronJob = nil
+	}
+
+	// Replacing the pod removes its timer.
+	options.Start = types.OptionalBoolFalse
+	report, _, err := ic.playKubePodPlan(ctx, plan, options, ipIndex, configMaps, nil, cronJob)
+	if err != nil {
+		return nil, err
+	}
+
+	if cronJob == nil {
+		return report, nil
+	}
+	for _, podReport := range report.Pods {
+		pod, err := ic.Libpod.LookupPod(podReport.ID)
+		if err == nil {
+			err = pod.StartCronJobTimer()
+		}
+		if err != nil {
+			if _, rmErr := ic.PodRm(ctx, []string{podReport.ID}, entities.PodRmOptions{Force: true, Ignore: true}); rmErr != nil {
+				err = fmt.Errorf("%v: removing pod %s: %w", err, plan.name, rmErr)
+			}
+			return nil, fmt.Errorf("cronJob %s: %w", cronJobName, err)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,51 @@
+//go:build !remote
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronScheduleToOnCalendar(t *testing.T) {
+	utc := "UTC"
+	tests := []struct {
+		schedule string
+		timeZone *string
+		calendar string
+		err      string
+	}{
+		{schedule: "* * * * *", calendar: "*-*-* *:*:00"},
+		{schedule: "30 2 * * *", calendar: "*-*-* 02:30:00"},
+		{schedule: "*/15 * * * *", calendar: "*-*-* *:00,15,30,45:00"},
+		{schedule: "0 9-17/4 * * *", calendar: "*-*-* 09,13,17:00:00"},
+		{schedule: "0 0 1,15 * *", calendar: "*-*-01,15 00:00:00"},
+		{schedule: "0 0 * jan-mar ?", calendar: "*-01,02,03-* 00:00:00"},
+		{schedule: "0 8 * * 1-5", calendar: "Mon,Tue,Wed,Thu,Fri *-*-* 08:00:00"},
+		{schedule: "0 8 * * 0,7,SAT", calendar: "Sun,Sat *-*-* 08:00:00"},
+		{schedule: "0 8 * * 0-7", calendar: "*-*-* 08:00:00"},
+		{schedule: "@weekly", calendar: "Sun *-*-* 00:00:00"},
+		{schedule: "@hourly", timeZone: &utc, calendar: "*-*-* *:00:00 UTC"},
+		{schedule: "@every 5m", err: `unsupported schedule "@every 5m"`},
+		{schedule: "* * * *", err: "must have five fields"},
+		{schedule: "60 * * * *", err: `invalid minute "60"`},
+		{schedule: "*/0 * * * *", err: `invalid step "0"`},
+		{schedule: "0 5-1 * * *", err: `invalid range "5-1"`},
+		{schedule: "0 0 1 * 1", err: "restricting both the day of month and the day of week is not supported"},
+	}
+	for _, test := range tests {
+		calendar, err := cronScheduleToOnCalendar(test.schedule, test.timeZone)
+		if test.err != "" {
+			assert.ErrorContains(t, err, test.err, test.schedule)
+			continue
+		}
+		if assert.NoError(t, err, test.schedule) {
+			assert.Equal(t, test.calendar, calendar, test.schedule)
+		}
+	}
+
+	bogus := "Bogus/Zone"
+	_, err := cronScheduleToOnCalendar("* * * * *", &bogus)
+	assert.ErrorContains(t, err, `invalid time zone "Bogus/Zone"`)
+}
@@ -0,0 +1,820 @@
+//go:build !remote
+
+package abi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/containers/common/pkg/secrets"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/containers/podman/v5/pkg/env"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v5/pkg/specgen/generate/kube"
+	"github.com/containers/podman/v5/pkg/util"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// kubeDiffMount is the source of a volume mount of a container as it shows
+// in the YAML generated for the container.
+type kubeDiffMount struct {
+	// source is either "hostPath:<path>" or "volume:<name>", it is empty
+	// for volumes without a stable source such as emptyDir volumes.
+	source   string
+	readOnly bool
+}
+
+func (m kubeDiffMount) String() string {
+	s := m.source
+	if s == "" {
+		s = "anonymous"
+	}
+	if m.readOnly {
+		s += ":ro"
+	}
+	return s
+}
+
+// KubeDiff compares the pods, containers, volumes and secrets described by
+// a kube YAML with the ones created by kube play, that is the changes
+// `kube play --replace` would make.  The existing objects are described by
+// the YAML generated for them.
+func (ic *ContainerEngine) KubeDiff(ctx context.Context, body io.Reader, options entities.KubeDiffOptions) (*entities.KubeDiffReport, error) {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := readKubeDocuments(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pods       []kubePodPlan
+		surplus    []string
+		claims     []v1.PersistentVolumeClaim
+		kubeSecret []v1.Secret
+		configMaps []v1.ConfigMap
+	)
+	for _, document := range documents {
+		plans, err := document.podPlans(false)
+		if err != nil {
+			return nil, err
+		}
+		for _, plan := range plans {
+			claims = append(claims, plan.claims...)
+		}
+		pods = append(pods, plans...)
+
+		// The pods of surplus replicas are removed.
+		replicaPods, err := ic.existingReplicaPods(&document)
+		if err != nil {
+			return nil, err
+		}
+		for _, index := range slices.Sorted(maps.Keys(replicaPods)) {
+			if index >= int32(len(plans)) {
+				surplus = append(surplus, replicaPods[index])
+			}
+		}
+
+		switch document.kind {
+		case "PersistentVolumeClaim":
+			if strings.TrimSpace(document.claim.Name) == "" {
+				return nil, fmt.Errorf("persistent volume claim name can not be empty")
+			}
+			claims = append(claims, *document.claim)
+		case "ConfigMap":
+			configMaps = append(configMaps, *document.configMap)
+		case "Secret":
+			kubeSecret = append(kubeSecret, *document.secret)
+		}
+	}
+
+	for _, p := range options.ConfigMaps {
+		f, err := os.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		cms, err := readConfigMapFromFile(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", p, err)
+		}
+		configMaps = append(configMaps, cms...)
+	}
+
+	secretsManager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.KubeDiffReport{}
+	for _, secret := range kubeSecret {
+		change, err := diffKubeSecret(secretsManager, &secret)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			report.Changes = append(report.Changes, *change)
+		}
+	}
+	for _, claim := range claims {
+		change, err := ic.diffKubePVC(&claim)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			report.Changes = append(report.Changes, *change)
+		}
+	}
+	// Volumes holding the files of configmaps and secrets may be shared
+	// by several pods, compare them once.
+	diffedVolumes := make(map[string]bool)
+	for _, pod := range pods {
+		changes, unchecked, err := ic.diffKubePod(ctx, pod, configMaps, secretsManager, diffedVolumes)
+		if err != nil {
+			return nil, err
+		}
+		report.Changes = append(report.Changes, changes...)
+		report.Unchecked = append(report.Unchecked, unchecked...)
+	}
+	for _, podName := range surplus {
+		report.Changes = append(report.Changes, entitiesTypes.KubeDiffChange{
+			Action: entitiesTypes.KubeDiffActionRemove,
+			Kind:   "Pod",
+			Name:   podName,
+		})
+	}
+	return report, nil
+}
+
+// diffKubeSecret compares a secret of a kube YAML with the stored secret.
+// The values of the secret are not part of the change.
+func diffKubeSecret(secretsManager *secrets.SecretsManager, secret *v1.Secret) (*entitiesTypes.KubeDiffChange, error) {
+	_, data, err := secretsManager.LookupSecretData(secret.Name)
+	if err != nil {
+		if errors.Is(err, secrets.ErrNoSuchSecret) {
+			return &entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionAdd, Kind: "Secret", Name: secret.Name}, nil
+		}
+		return nil, err
+	}
+	current := &v1.Secret{}
+	if err := yaml.Unmarshal(data, current); err != nil {
+		return nil, fmt.Errorf("secret %s was not created by kube play: %w", secret.Name, err)
+	}
+
+	fields := diffKubeData("data.", kubeSecretData(current), kubeSecretData(secret), true)
+	currentImmutable := current.Immutable != nil && *current.Immutable
+	desiredImmutable := secret.Immutable != nil && *secret.Immutable
+	fields = appendKubeDiffField(fields, "immutable", formatKubeDiffBool(currentImmutable), formatKubeDiffBool(desiredImmutable))
+	return kubeDiffChanged("Secret", secret.Name, fields), nil
+}
+
+// kubeSecretData returns the data of a secret, including its string data.
+func kubeSecretData(secret *v1.Secret) map[string][]byte {
+	data := make(map[string][]byte, len(secret.Data)+len(secret.StringData))
+	maps.Copy(data, secret.Data)
+	for key, value := range secret.StringData {
+		data[key] = []byte(value)
+	}
+	return data
+}
+
+// diffKubePVC compares a persistent volume claim of a kube YAML with the
+// existing volume.
+func (ic *ContainerEngine) diffKubePVC(claim *v1.PersistentVolumeClaim) (*entitiesTypes.KubeDiffChange, error) {
+	vol, err := ic.Libpod.GetVolume(claim.Name)
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchVolume) {
+			return &entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionAdd, Kind: "Volume", Name: claim.Name}, nil
+		}
+		return nil, err
+	}
+	generated := vol.GenerateForKube()
+
+	var fields []entitiesTypes.KubeDiffField
+	for _, annotation := range []string{util.VolumeDriverAnnotation, util.VolumeDeviceAnnotation, util.VolumeTypeAnnotation, util.VolumeUIDAnnotation, util.VolumeGIDAnnotation, util.VolumeMountOptsAnnotation} {
+		current, desired := generated.Annotations[annotation], claim.Annotations[annotation]
+		if annotation == util.VolumeDriverAnnotation && desired == "" {
+			desired = define.VolumeDriverLocal
+		}
+		fields = appendKubeDiffField(fields, "annotations."+annotation, formatKubeDiffString(current), formatKubeDiffString(desired))
+	}
+	fields = append(fields, diffKubeLabels(generated.Labels, claim.Labels)...)
+	return kubeDiffChanged("Volume", claim.Name, fields), nil
+}
+
+// diffKubePod compares a pod of a kube YAML with the existing pod and the
+// volumes holding generated files.  It returns the changes along with the
+// fields of the existing pod and its containers that are not compared.
+func (ic *ContainerEngine) diffKubePod(ctx context.Context, pod kubePodPlan, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, diffedVolumes map[string]bool) ([]entitiesTypes.KubeDiffChange, []entitiesTypes.KubeDiffUnchecked, error) {
+	var (
+		changes   []entitiesTypes.KubeDiffChange
+		unchecked []entitiesTypes.KubeDiffUnchecked
+	)
+
+	volumeMounts := make(map[string]*kubeDiffMount, len(pod.template.Spec.Volumes))
+	for _, volume := range pod.template.Spec.Volumes {
+		mount, kubeVolume, err := kubeDiffVolume(volume, configMaps, secretsManager, pod)
+		if err != nil {
+			return nil, nil, fmt.Errorf("pod %s: volume %q: %w", pod.name, volume.Name, err)
+		}
+		volumeMounts[volume.Name] = mount
+		if kubeVolume == nil || diffedVolumes[kubeVolume.Source] {
+			continue
+		}
+		diffedVolumes[kubeVolume.Source] = true
+		change, err := ic.diffKubeVolumeFiles(kubeVolume)
+		if err != nil {
+			return nil, nil, err
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+
+	libpodPod, err := ic.Libpod.LookupPod(pod.name)
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchPod) {
+			podChange := entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionAdd, Kind: "Pod", Name: pod.name}
+			return append([]entitiesTypes.KubeDiffChange{podChange}, changes...), nil, nil
+		}
+		return nil, nil, err
+	}
+	generated, _, err := libpodPod.GenerateForKube(ctx, false, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating kube YAML for pod %s: %w", pod.name, err)
+	}
+
+	var fields []entitiesTypes.KubeDiffField
+	fields = append(fields, diffKubeLabels(libpodPod.Labels(), pod.template.ObjectMeta.Labels)...)
+	restartPolicy := pod.template.Spec.RestartPolicy
+	if restartPolicy == "" {
+		restartPolicy = v1.RestartPolicyAlways
+	}
+	fields = appendKubeDiffField(fields, "restartPolicy", formatKubeDiffString(string(generated.Spec.RestartPolicy)), formatKubeDiffString(string(restartPolicy)))
+	fields = appendKubeDiffField(fields, "hostNetwork", formatKubeDiffBool(generated.Spec.HostNetwork), formatKubeDiffBool(pod.template.Spec.HostNetwork))
+	fields = appendKubeDiffField(fields, "ports", kubeDiffHostPorts(generated.Spec.Containers, generated.Spec.InitContainers), kubeDiffHostPorts(pod.template.Spec.Containers, pod.template.Spec.InitContainers))
+	if change := kubeDiffChanged("Pod", pod.name, fields); change != nil {
+		changes = append([]entitiesTypes.KubeDiffChange{*change}, changes...)
+	}
+	podUnchecked, err := kubeDiffUncheckedPodFields(pod)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(podUnchecked) > 0 {
+		unchecked = append(unchecked, entitiesTypes.KubeDiffUnchecked{Kind: "Pod", Name: pod.name, Fields: podUnchecked})
+	}
+
+	// The init containers are not compared, init containers of the "once"
+	// type are removed after they ran.
+	generatedContainers := make(map[string]v1.Container, len(generated.Spec.Containers))
+	for _, ctr := range generated.Spec.Containers {
+		generatedContainers[ctr.Name] = ctr
+	}
+	generatedMounts := kubeDiffGeneratedMounts(generated.Spec.Volumes)
+	for _, ctr := range pod.template.Spec.Containers {
+		ctrName := fmt.Sprintf("%s-%s", pod.name, ctr.Name)
+		// Underscores are removed from the names of generated
+		// containers.
+		generatedName := strings.ReplaceAll(ctrName, "_", "")
+		generatedCtr, ok := generatedContainers[generatedName]
+		if !ok {
+			changes = append(changes, entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionAdd, Kind: "Container", Name: ctrName})
+			continue
+		}
+		delete(generatedContainers, generatedName)
+
+		ctrFields, ctrUnchecked, err := ic.diffKubeContainer(ctx, ctr, generatedCtr, volumeMounts, generatedMounts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("container %s: %w", ctrName, err)
+		}
+		if change := kubeDiffChanged("Container", ctrName, ctrFields); change != nil {
+			changes = append(changes, *change)
+		}
+		if len(ctrUnchecked) > 0 {
+			unchecked = append(unchecked, entitiesTypes.KubeDiffUnchecked{Kind: "Container", Name: ctrName, Fields: ctrUnchecked})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(generatedContainers)) {
+		changes = append(changes, entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionRemove, Kind: "Container", Name: name})
+	}
+	return changes, unchecked, nil
+}
+
+// kubeDiffVolume returns how a volume of a pod is mounted, nil for volumes
+// that are not mounted into the containers such as missing optional
+// configmaps and image volumes.  For the volumes holding files generated by
+// kube play, the kube volume describing the files is returned as well.
+func kubeDiffVolume(volume v1.Volume, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, pod kubePodPlan) (*kubeDiffMount, *kube.KubeVolume, error) {
+	source := volume.VolumeSource
+	switch {
+	case source.HostPath != nil:
+		return &kubeDiffMount{source: "hostPath:" + source.HostPath.Path}, nil, nil
+	case source.PersistentVolumeClaim != nil:
+		return &kubeDiffMount{source: "volume:" + source.PersistentVolumeClaim.ClaimName}, nil, nil
+	case source.EmptyDir != nil:
+		return &kubeDiffMount{}, nil, nil
+	case source.ConfigMap != nil, source.Secret != nil, source.DownwardAPI != nil, source.Projected != nil:
+		// The same code as kube play generates the files.
+		kubeVolume, err := kube.VolumeFromSource(source, configMaps, secretsManager, pod.name, pod.template, volume.Name, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		if kubeVolume.Optional {
+			return nil, nil, nil
+		}
+		return &kubeDiffMount{source: "volume:" + kubeVolume.Source}, kubeVolume, nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// kubeDiffGeneratedMounts returns the sources of the volumes of a generated
+// pod by name.
+func kubeDiffGeneratedMounts(volumes []v1.Volume) map[string]kubeDiffMount {
+	mounts := make(map[string]kubeDiffMount, len(volumes))
+	for _, volume := range volumes {
+		switch {
+		case volume.HostPath != nil:
+			mounts[volume.Name] = kubeDiffMount{source: "hostPath:" + volume.HostPath.Path}
+		case volume.PersistentVolumeClaim != nil:
+			mounts[volume.Name] = kubeDiffMount{source: "volume:" + volume.PersistentVolumeClaim.ClaimName}
+		default:
+			mounts[volume.Name] = kubeDiffMount{}
+		}
+	}
+	return mounts
+}
+
+// diffKubeVolumeFiles compares the files of a configmap, secret, downwardAPI
+// or projected volume with the files in the existing volume.  The content of
+// files of secret and projected volumes is not part of the change.
+func (ic *ContainerEngine) diffKubeVolumeFiles(kubeVolume *kube.KubeVolume) (*entitiesTypes.KubeDiffChange, error) {
+	vol, err := ic.Libpod.GetVolume(kubeVolume.Source)
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchVolume) {
+			return &entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionAdd, Kind: "Volume", Name: kubeVolume.Source}, nil
+		}
+		return nil, err
+	}
+	mountPoint, err := vol.MountPoint()
+	if err != nil {
+		return nil, err
+	}
+	if mountPoint == "" {
+		// The files of volumes that are not mounted cannot be read.
+		return nil, nil
+	}
+
+	current := make(map[string][]byte)
+	err = filepath.WalkDir(mountPoint, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(mountPoint, path)
+		if err != nil {
+			return err
+		}
+		current[rel] = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading files of volume %s: %w", vol.Name(), err)
+	}
+	desired := make(map[string][]byte, len(kubeVolume.Items))
+	for path, data := range kubeVolume.Items {
+		desired[strings.TrimPrefix(filepath.Clean(path), "/")] = data
+	}
+
+	redact := kubeVolume.Type == kube.KubeVolumeTypeSecret || kubeVolume.Type == kube.KubeVolumeTypeProjected
+	return kubeDiffChanged("Volume", vol.Name(), diffKubeData("files.", current, desired, redact)), nil
+}
+
+// diffKubeContainer compares a container of a kube YAML with the YAML
+// generated for the existing container and returns the changed fields along
+// with the fields that are not compared.  The command, arguments,
+// environment and working directory are normalized the way kube play and
+// kube generate handle the defaults of the image, they are not compared if
+// the image of the YAML is not available locally.
+func (ic *ContainerEngine) diffKubeContainer(ctx context.Context, desired, generated v1.Container, volumeMounts map[string]*kubeDiffMount, generatedMounts map[string]kubeDiffMount) ([]entitiesTypes.KubeDiffField, []string, error) {
+	var fields []entitiesTypes.KubeDiffField
+	fields = appendKubeDiffField(fields, "image", formatKubeDiffString(generated.Image), formatKubeDiffString(desired.Image))
+
+	unchecked, err := kubeDiffUncheckedContainerFields(desired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	img, _, err := ic.Libpod.LibimageRuntime().LookupImage(desired.Image, nil)
+	if err == nil {
+		imgData, err := img.Inspect(ctx, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// As kube play does, the image's entrypoint and command
+		// are used unless set in the YAML ...
+		entrypoint, command := desired.Command, desired.Args
+		if len(desired.Command) == 0 {
+			entrypoint = imgData.Config.Entrypoint
+			if len(desired.Args) == 0 {
+				command = imgData.Config.Cmd
+			}
+		}
+		// ... and as kube generate does, they are omitted if they
+		// match the image.
+		var desiredCommand, desiredArgs []string
+		if len(entrypoint) > 0 {
+			desiredCommand, desiredArgs = entrypoint, command
+		} else {
+			desiredCommand = command
+		}
+		if slices.Equal(imgData.Config.Cmd, desiredCommand) || slices.Equal(imgData.Config.Entrypoint, desiredCommand) {
+			desiredCommand = nil
+		}
+		fields = appendKubeDiffField(fields, "command", formatKubeDiffStrings(generated.Command), formatKubeDiffStrings(desiredCommand))
+		fields = appendKubeDiffField(fields, "args", formatKubeDiffStrings(generated.Args), formatKubeDiffStrings(desiredArgs))
+
+		workingDir := desired.WorkingDir
+		if workingDir == "/" || workingDir == imgData.Config.WorkingDir {
+			workingDir = ""
+		}
+		fields = appendKubeDiffField(fields, "workingDir", formatKubeDiffString(generated.WorkingDir), formatKubeDiffString(workingDir))
+
+		fields = append(fields, diffKubeEnv(desired, generated, imgData.Config.Env)...)
+	} else {
+		logrus.Debugf("Not comparing the command and environment of image %s: %v", desired.Image, err)
+		imageFields, err := kubeDiffSetFields(desired, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, field := range imageFields {
+			if slices.Contains([]string{"args", "command", "env", "workingDir"}, field) {
+				unchecked = append(unchecked, field)
+			}
+		}
+		slices.Sort(unchecked)
+		unchecked = slices.Compact(unchecked)
+	}
+
+	fields = appendKubeDiffField(fields, "resources.limits.memory", formatKubeDiffQuantity(generated.Resources.Limits.Memory().Value()), formatKubeDiffQuantity(desired.Resources.Limits.Memory().Value()))
+	fields = appendKubeDiffField(fields, "resources.limits.cpu", formatKubeDiffMilli(generated.Resources.Limits.Cpu().MilliValue()), formatKubeDiffMilli(desired.Resources.Limits.Cpu().MilliValue()))
+
+	desiredMounts := make(map[string]kubeDiffMount, len(desired.VolumeMounts))
+	for _, volumeMount := range desired.VolumeMounts {
+		mount, ok := volumeMounts[volumeMount.Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("volume mount %s specified for container but not configured in volumes", volumeMount.Name)
+		}
+		if mount == nil {
+			continue
+		}
+		m := *mount
+		m.readOnly = volumeMount.ReadOnly
+		desiredMounts[filepath.Clean(volumeMount.MountPath)] = m
+	}
+	currentMounts := make(map[string]kubeDiffMount, len(generated.VolumeMounts))
+	for _, volumeMount := range generated.VolumeMounts {
+		m := generatedMounts[volumeMount.Name]
+		m.readOnly = volumeMount.ReadOnly
+		currentMounts[filepath.Clean(volumeMount.MountPath)] = m
+	}
+	for _, mountPath := range slices.Sorted(maps.Keys(desiredMounts)) {
+		desiredMount := desiredMounts[mountPath]
+		currentMount, ok := currentMounts[mountPath]
+		// Volumes without a stable source only need to be mounted.
+		if ok && desiredMount.source == "" {
+			currentMount.source = ""
+		}
+		var current *string
+		if ok {
+			current = formatKubeDiffString(currentMount.String())
+		}
+		fields = appendKubeDiffField(fields, "volumeMounts."+mountPath, current, formatKubeDiffString(desiredMount.String()))
+	}
+	for _, mountPath := range slices.Sorted(maps.Keys(currentMounts)) {
+		if _, ok := desiredMounts[mountPath]; !ok {
+			fields = appendKubeDiffField(fields, "volumeMounts."+mountPath, formatKubeDiffString(currentMounts[mountPath].String()), nil)
+		}
+	}
+	return fields, unchecked, nil
+}
+
+// kubeDiffComparedPodFields are the fields of the spec of a pod that are
+// compared, the containers and volumes one by one.
+var kubeDiffComparedPodFields = []string{"containers", "hostNetwork", "restartPolicy", "volumes"}
+
+// kubeDiffComparedContainerFields are the fields of a container that are
+// compared, in part for the environment, ports, resources and volume mounts.
+var kubeDiffComparedContainerFields = []string{"args", "command", "env", "image", "name", "ports", "resources", "volumeMounts", "workingDir"}
+
+// kubeDiffUncheckedPodFields returns the fields of a pod set in the YAML
+// that are not compared.  Init containers are not compared, init containers
+// of the "once" type are removed after they ran.
+func kubeDiffUncheckedPodFields(pod kubePodPlan) ([]string, error) {
+	fields, err := kubeDiffSetFields(pod.template.Spec, kubeDiffComparedPodFields)
+	if err != nil {
+		return nil, err
+	}
+	if len(pod.annotations) > 0 || len(pod.template.ObjectMeta.Annotations) > 0 {
+		fields = append(fields, "annotations")
+	}
+	slices.Sort(fields)
+	return fields, nil
+}
+
+// kubeDiffUncheckedContainerFields returns the fields of a container set in
+// the YAML that are not compared: the fields not compared at all, variables
+// whose value is taken from configmaps, secrets or the pod, ports that are
+// not published, resources other than the CPU and memory limits and the
+// sub paths and propagation of volume mounts.
+func kubeDiffUncheckedContainerFields(ctr v1.Container) ([]string, error) {
+	fields, err := kubeDiffSetFields(ctr, kubeDiffComparedContainerFields)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range ctr.Env {
+		if e.ValueFrom != nil {
+			fields = append(fields, "env."+e.Name)
+		}
+	}
+	if slices.ContainsFunc(ctr.Ports, func(port v1.ContainerPort) bool { return port.HostPort == 0 }) {
+		fields = append(fields, "ports")
+	}
+	for name := range ctr.Resources.Limits {
+		if name != v1.ResourceCPU && name != v1.ResourceMemory {
+			fields = append(fields, "resources.limits."+string(name))
+		}
+	}
+	if len(ctr.Resources.Requests) > 0 {
+		fields = append(fields, "resources.requests")
+	}
+	for _, volumeMount := range ctr.VolumeMounts {
+		mountPath := filepath.Clean(volumeMount.MountPath)
+		if volumeMount.SubPath != "" || volumeMount.SubPathExpr != "" {
+			fields = append(fields, "volumeMounts."+mountPath+".subPath")
+		}
+		if volumeMount.MountPropagation != nil {
+			fields = append(fields, "volumeMounts."+mountPath+".mountPropagation")
+		}
+	}
+	slices.Sort(fields)
+	return slices.Compact(fields), nil
+}
+
+// kubeDiffSetFields returns the names of the fields of obj set in the YAML,
+// that is not empty in its JSON encoding, except for the given fields.
+func kubeDiffSetFields(obj any, except []string) ([]string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	var fields []string
+	for name, value := range values {
+		if !slices.Contains(except, name) && !isEmptyKubeDiffValue(value) {
+			fields = append(fields, name)
+		}
+	}
+	slices.Sort(fields)
+	return fields, nil
+}
+
+// isEmptyKubeDiffValue returns whether a decoded JSON value is the zero
+// value of its type or an object of such values.
+func isEmptyKubeDiffValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		for _, e := range v {
+			if !isEmptyKubeDiffValue(e) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// diffKubeEnv compares the environment variables of a container.  Like kube
+// generate, variables set to the value of the image or of the defaults and
+// empty variables are ignored.  Variables whose value is taken from
+// configmaps, secrets or the pod are not compared.
+func diffKubeEnv(desired, generated v1.Container, imageEnv []string) []entitiesTypes.KubeDiffField {
+	defaults := env.DefaultEnvVariables()
+	for _, e := range imageEnv {
+		key, value, _ := strings.Cut(e, "=")
+		defaults[key] = value
+	}
+
+	desiredEnv := make(map[string]string)
+	valueFrom := make(map[string]bool)
+	for _, e := range desired.Env {
+		if e.ValueFrom != nil {
+			valueFrom[e.Name] = true
+			delete(desiredEnv, e.Name)
+			continue
+		}
+		delete(valueFrom, e.Name)
+		// As kube generate does, this drops empty values too.
+		if defaults[e.Name] == e.Value {
+			delete(desiredEnv, e.Name)
+			continue
+		}
+		desiredEnv[e.Name] = e.Value
+	}
+	currentEnv := make(map[string]string, len(generated.Env))
+	for _, e := range generated.Env {
+		if valueFrom[e.Name] {
+			continue
+		}
+		if _, ok := desiredEnv[e.Name]; !ok && len(desired.EnvFrom) > 0 {
+			continue
+		}
+		currentEnv[e.Name] = e.Value
+	}
+
+	var fields []entitiesTypes.KubeDiffField
+	names := make(map[string]bool, len(desiredEnv)+len(currentEnv))
+	for name := range desiredEnv {
+		names[name] = true
+	}
+	for name := range currentEnv {
+		names[name] = true
+	}
+	for _, name := range slices.Sorted(maps.Keys(names)) {
+		var current, desired *string
+		if value, ok := currentEnv[name]; ok {
+			current = formatKubeDiffString(value)
+		}
+		if value, ok := desiredEnv[name]; ok {
+			desired = formatKubeDiffString(value)
+		}
+		fields = appendKubeDiffField(fields, "env."+name, current, desired)
+	}
+	return fields
+}
+
+// kubeDiffHostPorts formats the ports of the containers of a pod published
+// on the host, nil if no port is published.
+func kubeDiffHostPorts(containers, initContainers []v1.Container) *string {
+	var ports []string
+	for _, ctr := range slices.Concat(containers, initContainers) {
+		for _, port := range ctr.Ports {
+			if port.HostPort == 0 {
+				continue
+			}
+			protocol := port.Protocol
+			if protocol == "" {
+				protocol = v1.ProtocolTCP
+			}
+			hostPort := fmt.Sprintf("%d", port.HostPort)
+			if port.HostIP != "" {
+				hostPort = port.HostIP + ":" + hostPort
+			}
+			ports = append(ports, fmt.Sprintf("%s:%d/%s", hostPort, port.ContainerPort, strings.ToLower(string(protocol))))
+		}
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+	slices.Sort(ports)
+	return formatKubeDiffString(strings.Join(slices.Compact(ports), ","))
+}
+
+// diffKubeLabels compares the labels of an object.
+func diffKubeLabels(current, desired map[string]string) []entitiesTypes.KubeDiffField {
+	var fields []entitiesTypes.KubeDiffField
+	for _, key := range slices.Sorted(maps.Keys(desired)) {
+		var currentValue *string
+		if value, ok := current[key]; ok {
+			currentValue = formatKubeDiffString(value)
+		}
+		fields = appendKubeDiffField(fields, "labels."+key, currentValue, formatKubeDiffString(desired[key]))
+	}
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := desired[key]; !ok {
+			fields = appendKubeDiffField(fields, "labels."+key, formatKubeDiffString(current[key]), nil)
+		}
+	}
+	return fields
+}
+
+// diffKubeData compares the data of a secret or the files of a volume by key.
+func diffKubeData(prefix string, current, desired map[string][]byte, redact bool) []entitiesTypes.KubeDiffField {
+	var fields []entitiesTypes.KubeDiffField
+	keys := slices.Sorted(maps.Keys(desired))
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := desired[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		currentValue, inCurrent := current[key]
+		desiredValue, inDesired := desired[key]
+		if inCurrent && inDesired && bytes.Equal(currentValue, desiredValue) {
+			continue
+		}
+		field := entitiesTypes.KubeDiffField{Field: prefix + key, Redacted: redact}
+		if inCurrent {
+			field.Current = formatKubeDiffString(string(currentValue))
+		}
+		if inDesired {
+			field.Desired = formatKubeDiffString(string(desiredValue))
+		}
+		if redact {
+			if field.Current != nil {
+				field.Current = formatKubeDiffString("")
+			}
+			if field.Desired != nil {
+				field.Desired = formatKubeDiffString("")
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// appendKubeDiffField appends the field to fields if its values differ.
+func appendKubeDiffField(fields []entitiesTypes.KubeDiffField, field string, current, desired *string) []entitiesTypes.KubeDiffField {
+	if current == nil && desired == nil || current != nil && desired != nil && *current == *desired {
+		return fields
+	}
+	return append(fields, entitiesTypes.KubeDiffField{Field: field, Current: current, Desired: desired})
+}
+
+// kubeDiffChanged returns the change of an object with the given changed
+// fields, nil if there are none.
+func kubeDiffChanged(kind, name string, fields []entitiesTypes.KubeDiffField) *entitiesTypes.KubeDiffChange {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &entitiesTypes.KubeDiffChange{Action: entitiesTypes.KubeDiffActionChange, Kind: kind, Name: name, Fields: fields}
+}
+
+func formatKubeDiffString(s string) *string {
+	return &s
+}
+
+// formatKubeDiffStrings formats a command or arguments, nil if empty.
+func formatKubeDiffStrings(s []string) *string {
+	if len(s) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return formatKubeDiffString(strings.Join(s, " "))
+	}
+	return formatKubeDiffString(string(b))
+}
+
+func formatKubeDiffBool(b bool) *string {
+	return formatKubeDiffString(fmt.Sprintf("%t", b))
+}
+
+// formatKubeDiffQuantity formats a memory limit in bytes, nil if unset.
+func formatKubeDiffQuantity(value int64) *string {
+	if value == 0 {
+		return nil
+	}
+	return formatKubeDiffString(fmt.Sprintf("%d", value))
+}
+
+// formatKubeDiffMilli formats a CPU limit in millicores, nil if unset.
+func formatKubeDiffMilli(value int64) *string {
+	if value == 0 {
+		return nil
+	}
+	return formatKubeDiffString(fmt.Sprintf("%dm", value))
+}
@@ -0,0 +1,131 @@
+//go:build !remote
+
+package abi
+
+import (
+	"testing"
+
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffKubeEnv(t *testing.T) {
+	imageEnv := []string{"PATH=/usr/bin", "LANG=C"}
+	tests := []struct {
+		name      string
+		desired   v1.Container
+		generated v1.Container
+		fields    []string
+	}{
+		{
+			name:      "image, default and empty values are ignored",
+			desired:   v1.Container{Env: []v1.EnvVar{{Name: "PATH", Value: "/usr/bin"}, {Name: "container", Value: "podman"}, {Name: "HOSTNAME"}, {Name: "FOO", Value: "bar"}}},
+			generated: v1.Container{Env: []v1.EnvVar{{Name: "FOO", Value: "bar"}}},
+		},
+		{
+			name:      "changed, added and removed",
+			desired:   v1.Container{Env: []v1.EnvVar{{Name: "FOO", Value: "baz"}, {Name: "NEW", Value: "1"}}},
+			generated: v1.Container{Env: []v1.EnvVar{{Name: "FOO", Value: "bar"}, {Name: "OLD", Value: "1"}}},
+			fields:    []string{"env.FOO", "env.NEW", "env.OLD"},
+		},
+		{
+			name:      "overridden image value",
+			desired:   v1.Container{Env: []v1.EnvVar{{Name: "LANG", Value: "C.UTF-8"}}},
+			generated: v1.Container{},
+			fields:    []string{"env.LANG"},
+		},
+		{
+			name:      "values from references are not compared",
+			desired:   v1.Container{Env: []v1.EnvVar{{Name: "REF", ValueFrom: &v1.EnvVarSource{}}}},
+			generated: v1.Container{Env: []v1.EnvVar{{Name: "REF", Value: "x"}}},
+		},
+		{
+			name:      "values from envFrom are not removed",
+			desired:   v1.Container{EnvFrom: []v1.EnvFromSource{{}}, Env: []v1.EnvVar{{Name: "FOO", Value: "baz"}}},
+			generated: v1.Container{Env: []v1.EnvVar{{Name: "FOO", Value: "bar"}, {Name: "FROM", Value: "x"}}},
+			fields:    []string{"env.FOO"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fields []string
+			for _, field := range diffKubeEnv(test.desired, test.generated, imageEnv) {
+				fields = append(fields, field.Field)
+			}
+			assert.Equal(t, test.fields, fields)
+		})
+	}
+}
+
+func TestDiffKubeData(t *testing.T) {
+	current := map[string][]byte{"same": []byte("1"), "changed": []byte("old"), "removed": []byte("x")}
+	desired := map[string][]byte{"same": []byte("1"), "changed": []byte("new"), "added": []byte("y")}
+	old, newValue, x, y, empty := "old", "new", "x", "y", ""
+
+	fields := diffKubeData("files.", current, desired, false)
+	assert.Equal(t, []entitiesTypes.KubeDiffField{
+		{Field: "files.added", Desired: &y},
+		{Field: "files.changed", Current: &old, Desired: &newValue},
+		{Field: "files.removed", Current: &x},
+	}, fields)
+
+	fields = diffKubeData("data.", current, desired, true)
+	assert.Equal(t, []entitiesTypes.KubeDiffField{
+		{Field: "data.added", Desired: &empty, Redacted: true},
+		{Field: "data.changed", Current: &empty, Desired: &empty, Redacted: true},
+		{Field: "data.removed", Current: &empty, Redacted: true},
+	}, fields)
+}
+
+func TestKubeDiffHostPorts(t *testing.T) {
+	assert.Nil(t, kubeDiffHostPorts([]v1.Container{{Ports: []v1.ContainerPort{{ContainerPort: 80}}}}, nil))
+
+	ports := kubeDiffHostPorts([]v1.Container{
+		{Ports: []v1.ContainerPort{{ContainerPort: 80, HostPort: 8080}, {ContainerPort: 53, HostPort: 53, Protocol: v1.ProtocolUDP}}},
+		{Ports: []v1.ContainerPort{{ContainerPort: 443, HostPort: 8443, HostIP: "127.0.0.1"}}},
+	}, nil)
+	assert.Equal(t, "127.0.0.1:8443:443/tcp,53:53/udp,8080:80/tcp", *ports)
+}
+
+func TestKubeDiffUncheckedFields(t *testing.T) {
+	propagation := v1.MountPropagationHostToContainer
+	ctr := v1.Container{
+		Name:            "ctr",
+		Image:           "alpine",
+		ImagePullPolicy: v1.PullAlways,
+		Env:             []v1.EnvVar{{Name: "FOO", Value: "bar"}, {Name: "REF", ValueFrom: &v1.EnvVarSource{}}},
+		Ports:           []v1.ContainerPort{{ContainerPort: 80, HostPort: 8080}},
+		LivenessProbe:   &v1.Probe{},
+		VolumeMounts: []v1.VolumeMount{
+			{Name: "data", MountPath: "/data/", SubPath: "sub"},
+			{Name: "conf", MountPath: "/conf", MountPropagation: &propagation},
+		},
+	}
+	fields, err := kubeDiffUncheckedContainerFields(ctr)
+	require.NoError(t, err)
+	// The empty probe is not set.
+	assert.Equal(t, []string{"env.REF", "imagePullPolicy", "volumeMounts./conf.mountPropagation", "volumeMounts./data.subPath"}, fields)
+
+	// Only published ports are compared.
+	fields, err = kubeDiffUncheckedContainerFields(v1.Container{Name: "ctr", Image: "alpine", Ports: []v1.ContainerPort{{ContainerPort: 80}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ports"}, fields)
+
+	pod := kubePodPlan{
+		name: "pod",
+		template: &v1.PodTemplateSpec{
+			Spec: v1.PodSpec{
+				Containers:     []v1.Container{ctr},
+				InitContainers: []v1.Container{ctr},
+				RestartPolicy:  v1.RestartPolicyNever,
+				Hostname:       "host",
+			},
+		},
+		annotations: map[string]string{"io.containers.sdnotify": "container"},
+	}
+	fields, err = kubeDiffUncheckedPodFields(pod)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"annotations", "hostname", "initContainers"}, fields)
+}
@@ -0,0 +1,235 @@
+//go:build !remote
+
+package abi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v5/libpod/define"
+	v1apps "github.com/containers/podman/v5/pkg/k8s.io/api/apps/v1"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// kubeDocument is a document of a kube YAML read as the object of its kind,
+// exactly one of the objects is set.
+type kubeDocument struct {
+	kind        string
+	pod         *v1.Pod
+	daemonSet   *v1apps.DaemonSet
+	deployment  *v1apps.Deployment
+	statefulSet *v1apps.StatefulSet
+	job         *v1.Job
+	cronJob     *v1.CronJob
+	claim       *v1.PersistentVolumeClaim
+	configMap   *v1.ConfigMap
+	secret      *v1.Secret
+}
+
+// kubePodPlan is a pod kube play creates for an object of a kube YAML.
+type kubePodPlan struct {
+	// name is the name of the pod.
+	name string
+	// index is the replica of a Deployment or the ordinal of a
+	// StatefulSet the pod is created for, 0 for the other kinds.
+	index int32
+	// template is the template of the pod, labeled with its owner.
+	template *v1.PodTemplateSpec
+	// annotations are the annotations of the object.
+	annotations map[string]string
+	// claims are the volumes claimed by the pod of a StatefulSet.
+	claims []v1.PersistentVolumeClaim
+}
+
+// readKubeDocuments reads the documents of a kube YAML in the order kube play
+// handles them.  Documents of unsupported kinds are skipped.
+func readKubeDocuments(content []byte) ([]kubeDocument, error) {
+	documentList, err := splitMultiDocYAML(content)
+	if err != nil {
+		return nil, err
+	}
+	documentList, err = sortKubeKinds(documentList)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sort kube kinds: %w", err)
+	}
+
+	documents := make([]kubeDocument, 0, len(documentList))
+	for _, document := range documentList {
+		kind, err := getKubeKind(document)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read kube YAML: %w", err)
+		}
+
+		doc := kubeDocument{kind: kind}
+		var object any
+		switch kind {
+		case "Pod":
+			doc.pod = &v1.Pod{}
+			object = doc.pod
+		case "DaemonSet":
+			doc.daemonSet = &v1apps.DaemonSet{}
+			object = doc.daemonSet
+		case "Deployment":
+			doc.deployment = &v1apps.Deployment{}
+			object = doc.deployment
+		case "StatefulSet":
+			doc.statefulSet = &v1apps.StatefulSet{}
+			object = doc.statefulSet
+		case "Job":
+			doc.job = &v1.Job{}
+			object = doc.job
+		case "CronJob":
+			doc.cronJob = &v1.CronJob{}
+			object = doc.cronJob
+		case "PersistentVolumeClaim":
+			doc.claim = &v1.PersistentVolumeClaim{}
+			object = doc.claim
+		case "ConfigMap":
+			doc.configMap = &v1.ConfigMap{}
+			object = doc.configMap
+		case "Secret":
+			doc.secret = &v1.Secret{}
+			object = doc.secret
+		default:
+			logrus.Infof("Kube kind %s not supported", kind)
+			continue
+		}
+		if err := yaml.Unmarshal(document, object); err != nil {
+			return nil, fmt.Errorf("unable to read YAML as Kube %s: %w", kind, err)
+		}
+		documents = append(documents, doc)
+	}
+	return documents, nil
+}
+
+// podPlans returns the pods kube play creates for the object of the
+// document, none for kinds without pods.  publishAll publishes the container
+// ports of all replicas as --publish-all does.
+func (d *kubeDocument) podPlans(publishAll bool) ([]kubePodPlan, error) {
+	switch d.kind {
+	case "Pod":
+		if d.pod.ObjectMeta.Name == "" {
+			return nil, errors.New("pod does not have a name")
+		}
+		template := &v1.PodTemplateSpec{ObjectMeta: d.pod.ObjectMeta, Spec: d.pod.Spec}
+		return []kubePodPlan{{
+			name:        d.pod.ObjectMeta.Name,
+			template:    ownedPodTemplate(template, "Pod", d.pod.ObjectMeta.Name),
+			annotations: d.pod.Annotations,
+		}}, nil
+	case "DaemonSet":
+		return singlePodPlan(d.daemonSet.ObjectMeta, &d.daemonSet.Spec.Template, "daemonSet", "DaemonSet")
+	case "Job":
+		return singlePodPlan(d.job.ObjectMeta, &d.job.Spec.Template, "job", "Job")
+	case "CronJob":
+		return singlePodPlan(d.cronJob.ObjectMeta, &d.cronJob.Spec.JobTemplate.Spec.Template, "cronJob", "CronJob")
+	case "Deployment":
+		return deploymentPodPlans(d.deployment, publishAll)
+	case "StatefulSet":
+		return statefulSetPodPlans(d.statefulSet, publishAll)
+	default:
+		return nil, nil
+	}
+}
+
+// singlePodPlan returns the plan of the single pod `<name>-pod` of a
+// DaemonSet, Job or CronJob.
+func singlePodPlan(meta metav1.ObjectMeta, template *v1.PodTemplateSpec, errorKind, kind string) ([]kubePodPlan, error) {
+	if meta.Name == "" {
+		return nil, fmt.Errorf("%s does not have a name", errorKind)
+	}
+	return []kubePodPlan{{
+		name:        fmt.Sprintf("%s-pod", meta.Name),
+		template:    ownedPodTemplate(template, kind, meta.Name),
+		annotations: meta.Annotations,
+	}}, nil
+}
+
+// deploymentPodPlans returns the pods of the replicas of a Deployment.
+func deploymentPodPlans(deploymentYAML *v1apps.Deployment, publishAll bool) ([]kubePodPlan, error) {
+	deploymentName := deploymentYAML.ObjectMeta.Name
+	if deploymentName == "" {
+		return nil, errors.New("deployment does not have a name")
+	}
+	numReplicas := int32(1)
+	if deploymentYAML.Spec.Replicas != nil {
+		numReplicas = *deploymentYAML.Spec.Replicas
+	}
+	if numReplicas < 0 {
+		return nil, fmt.Errorf("deployment %s has a negative replica count %d", deploymentName, numReplicas)
+	}
+
+	plans := make([]kubePodPlan, 0, numReplicas)
+	for replica := int32(0); replica < numReplicas; replica++ {
+		template, err := replicaPodTemplate(&deploymentYAML.Spec.Template, replica, deploymentYAML.Annotations[define.KubeReplicaHostPortsAnnotation], publishAll)
+		if err != nil {
+			return nil, fmt.Errorf("deployment %s: %w", deploymentName, err)
+		}
+		plans = append(plans, kubePodPlan{
+			name:        replicaPodName(deploymentName, replica),
+			index:       replica,
+			template:    ownedPodTemplate(template, "Deployment", deploymentName),
+			annotations: deploymentYAML.Annotations,
+		})
+	}
+	return plans, nil
+}
+
+// statefulSetPodPlans returns the pods of the ordinals of a StatefulSet
+// along with the volumes they claim.
+func statefulSetPodPlans(statefulSetYAML *v1apps.StatefulSet, publishAll bool) ([]kubePodPlan, error) {
+	statefulSetName := statefulSetYAML.ObjectMeta.Name
+	if statefulSetName == "" {
+		return nil, errors.New("statefulSet does not have a name")
+	}
+	numReplicas := int32(1)
+	if statefulSetYAML.Spec.Replicas != nil {
+		numReplicas = *statefulSetYAML.Spec.Replicas
+	}
+	if numReplicas < 0 {
+		return nil, fmt.Errorf("statefulSet %s has a negative replica count %d", statefulSetName, numReplicas)
+	}
+	for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+		if claim.Name == "" {
+			return nil, fmt.Errorf("statefulSet %s has a volume claim template without a name", statefulSetName)
+		}
+	}
+
+	plans := make([]kubePodPlan, 0, numReplicas)
+	for ordinal := int32(0); ordinal < numReplicas; ordinal++ {
+		template, err := statefulSetPodTemplate(statefulSetYAML, ordinal, publishAll)
+		if err != nil {
+			return nil, fmt.Errorf("statefulSet %s: %w", statefulSetName, err)
+		}
+		claims := make([]v1.PersistentVolumeClaim, 0, len(statefulSetYAML.Spec.VolumeClaimTemplates))
+		for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+			claim.Name = statefulSetClaimName(claim.Name, statefulSetName, ordinal)
+			claims = append(claims, claim)
+		}
+		plans = append(plans, kubePodPlan{
+			name:        statefulSetPodName(statefulSetName, ordinal),
+			index:       ordinal,
+			template:    ownedPodTemplate(template, "StatefulSet", statefulSetName),
+			annotations: statefulSetYAML.Annotations,
+			claims:      claims,
+		})
+	}
+	return plans, nil
+}
+
+// existingReplicaPods returns the names of the existing pods of the replicas
+// of a Deployment or the ordinals of a StatefulSet of the document, indexed
+// by replica or ordinal.  It returns nil for the other kinds.
+func (ic *ContainerEngine) existingReplicaPods(d *kubeDocument) (map[int32]string, error) {
+	switch d.kind {
+	case "Deployment":
+		return ic.deploymentReplicaPods(d.deployment.ObjectMeta.Name)
+	case "StatefulSet":
+		return ic.statefulSetPods(d.statefulSet.ObjectMeta.Name)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,83 @@
+//go:build !remote
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubePodPlans(t *testing.T) {
+	content := []byte(`
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: ctr
+        image: alpine
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: backup
+spec:
+  schedule: "0 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: ctr
+            image: alpine
+`)
+	documents, err := readKubeDocuments(content)
+	require.NoError(t, err)
+	// The ConfigMap comes first, the Service is not supported.
+	require.Len(t, documents, 3)
+	assert.Equal(t, "ConfigMap", documents[0].kind)
+	assert.Equal(t, "cm", documents[0].configMap.Name)
+
+	plans, err := documents[1].podPlans(false)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+	assert.Equal(t, "db-1", plans[1].name)
+	assert.Equal(t, int32(1), plans[1].index)
+	assert.Equal(t, "data-db-1", plans[1].claims[0].Name)
+	assert.Equal(t, "StatefulSet", plans[1].template.Labels[define.KubeOwnerKindLabel])
+
+	plans, err = documents[2].podPlans(false)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	assert.Equal(t, "backup-pod", plans[0].name)
+	assert.Equal(t, "backup", plans[0].template.Labels[define.KubeOwnerNameLabel])
+
+	plans, err = documents[0].podPlans(false)
+	require.NoError(t, err)
+	assert.Empty(t, plans)
+
+	documents, err = readKubeDocuments([]byte("apiVersion: apps/v1\nkind: Deployment\nspec:\n  replicas: 1\n"))
+	require.NoError(t, err)
+	_, err = documents[0].podPlans(false)
+	assert.EqualError(t, err, "deployment does not have a name")
+}
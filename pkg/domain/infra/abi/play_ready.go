@@ -0,0 +1,59 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+)
+
+// readyPollInterval is the interval at which the readiness of containers is
+// polled by `kube play --wait-ready`.
+const readyPollInterval = 250 * time.Millisecond
+
+// waitForPodsReady waits until all started containers of the pods are ready.
+// It fails if a container stops before becoming ready or if the containers
+// are not ready within the timeout.  A timeout of 0 waits forever.
+func (ic *ContainerEngine) waitForPodsReady(ctx context.Context, pods []entities.PlayKubePod, timeout time.Duration) error {
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for _, pod := range pods {
+		for _, ctrID := range pod.Containers {
+			ctr, err := ic.Libpod.LookupContainer(ctrID)
+			if err != nil {
+				return err
+			}
+			state, err := ctr.State()
+			if err != nil {
+				return err
+			}
+			// Containers that have not been started, for instance
+			// the ones of CronJobs, are not waited for.
+			if state == define.ContainerStateConfigured || state == define.ContainerStateCreated {
+				continue
+			}
+
+			if _, err := ctr.WaitForConditionWithInterval(waitCtx, readyPollInterval, define.ReadinessCheckReady); err != nil {
+				switch {
+				case errors.Is(waitCtx.Err(), context.DeadlineExceeded):
+					return fmt.Errorf("timed out after %s waiting for container %s to become ready", timeout, ctr.Name())
+				case errors.Is(err, define.ErrCtrStopped):
+					return fmt.Errorf("container %s stopped before becoming ready", ctr.Name())
+				default:
+					return fmt.Errorf("waiting for container %s to become ready: %w", ctr.Name(), err)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,432 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	nettypes "github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	v1apps "github.com/containers/podman/v5/pkg/k8s.io/api/apps/v1"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// Values of the define.KubeReplicaHostPortsAnnotation annotation.
+const (
+	// replicaHostPortsFirst publishes the host ports on the first
+	// replica only, the other replicas are reachable on the network.
+	replicaHostPortsFirst = "first"
+	// replicaHostPortsIncrement publishes the host ports of replica N
+	// on the host ports incremented by N.
+	replicaHostPortsIncrement = "increment"
+)
+
+// recordedPlayOptions are the options of kube play that affect the pods
+// created.  They are recorded on the infra container of the replicas of a
+// Deployment, so that kube scale creates new replicas with the options the
+// existing replicas were played with.  Config maps are not recorded, kube
+// scale reads them from the YAML and --configmap as kube play does.
+type recordedPlayOptions struct {
+	Annotations        map[string]string `json:",omitempty"`
+	LogDriver          string            `json:",omitempty"`
+	LogOptions         []string          `json:",omitempty"`
+	NoHostname         bool              `json:",omitempty"`
+	NoHosts            bool              `json:",omitempty"`
+	PublishAllPorts    bool              `json:",omitempty"`
+	PublishPorts       []string          `json:",omitempty"`
+	SeccompProfileRoot string            `json:",omitempty"`
+	Userns             string            `json:",omitempty"`
+}
+
+// newRecordedPlayOptions returns the options to record of options.
+func newRecordedPlayOptions(options entities.PlayKubeOptions) recordedPlayOptions {
+	return recordedPlayOptions{
+		Annotations:        options.Annotations,
+		LogDriver:          options.LogDriver,
+		LogOptions:         options.LogOptions,
+		NoHostname:         options.NoHostname,
+		NoHosts:            options.NoHosts,
+		PublishAllPorts:    options.PublishAllPorts,
+		PublishPorts:       options.PublishPorts,
+		SeccompProfileRoot: options.SeccompProfileRoot,
+		Userns:             options.Userns,
+	}
+}
+
+// apply sets the recorded options on options.
+func (r *recordedPlayOptions) apply(options *entities.PlayKubeOptions) {
+	options.Annotations = r.Annotations
+	options.LogDriver = r.LogDriver
+	options.LogOptions = r.LogOptions
+	options.NoHostname = r.NoHostname
+	options.NoHosts = r.NoHosts
+	options.PublishAllPorts = r.PublishAllPorts
+	options.PublishPorts = r.PublishPorts
+	options.SeccompProfileRoot = r.SeccompProfileRoot
+	options.Userns = r.Userns
+}
+
+// replicaPodName returns the name of the pod of the given replica of a
+// Deployment.  The first replica keeps the name of single replica
+// deployments, so scaling never renames it.
+func replicaPodName(deploymentName string, replica int32) string {
+	if replica == 0 {
+		return deploymentName + "-pod"
+	}
+	return fmt.Sprintf("%s-pod-%d", deploymentName, replica)
+}
+
+// replicaIndex returns the replica of the Deployment podName is the pod of.
+func replicaIndex(deploymentName, podName string) (int32, bool) {
+	base := replicaPodName(deploymentName, 0)
+	if podName == base {
+		return 0, true
+	}
+	suffix, ok := strings.CutPrefix(podName, base+"-")
+	if !ok {
+		return 0, false
+	}
+	replica, err := strconv.ParseInt(suffix, 10, 32)
+	// Reject non-canonical suffixes such as "01" or "+1".
+	if err != nil || replica < 1 || strconv.FormatInt(replica, 10) != suffix {
+		return 0, false
+	}
+	return int32(replica), true
+}
+
+// statefulSetPodName returns the name of the pod of the given ordinal of a
+// StatefulSet.
+func statefulSetPodName(statefulSetName string, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", statefulSetName, ordinal)
+}
+
+// statefulSetOrdinal returns the ordinal of the StatefulSet podName is the
+// pod of.
+func statefulSetOrdinal(statefulSetName, podName string) (int32, bool) {
+	suffix, ok := strings.CutPrefix(podName, statefulSetName+"-")
+	if !ok {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(suffix, 10, 32)
+	// Reject non-canonical suffixes such as "01" or "+1".
+	if err != nil || ordinal < 0 || strconv.FormatInt(ordinal, 10) != suffix {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
+
+// ownedPodTemplate returns a copy of template labeled with the kind and name
+// of the object of the YAML the pod is created for.  The labels select the
+// pods of an object without relying on their names.
+func ownedPodTemplate(template *v1.PodTemplateSpec, kind, name string) *v1.PodTemplateSpec {
+	owned := *template
+	owned.Labels = maps.Clone(template.Labels)
+	if owned.Labels == nil {
+		owned.Labels = make(map[string]string)
+	}
+	owned.Labels[define.KubeOwnerKindLabel] = kind
+	owned.Labels[define.KubeOwnerNameLabel] = name
+	return &owned
+}
+
+// deploymentReplicaPods returns the names of the existing pods of the
+// Deployment indexed by replica.
+func (ic *ContainerEngine) deploymentReplicaPods(deploymentName string) (map[int32]string, error) {
+	return ic.ownedPods("Deployment", deploymentName, func(podName string) (int32, bool) {
+		return replicaIndex(deploymentName, podName)
+	})
+}
+
+// statefulSetPods returns the names of the existing pods of the StatefulSet
+// indexed by ordinal.
+func (ic *ContainerEngine) statefulSetPods(statefulSetName string) (map[int32]string, error) {
+	return ic.ownedPods("StatefulSet", statefulSetName, func(podName string) (int32, bool) {
+		return statefulSetOrdinal(statefulSetName, podName)
+	})
+}
+
+// ownedPods returns the names of the existing pods created for the object of
+// the given kind and name, indexed by the index returned by index.
+func (ic *ContainerEngine) ownedPods(kind, name string, index func(podName string) (int32, bool)) (map[int32]string, error) {
+	pods, err := ic.Libpod.GetAllPods()
+	if err != nil {
+		return nil, err
+	}
+	indexed := make(map[int32]string)
+	for _, pod := range pods {
+		labels := pod.Labels()
+		if labels[define.KubeOwnerKindLabel] != kind || labels[define.KubeOwnerNameLabel] != name {
+			continue
+		}
+		if i, ok := index(pod.Name()); ok {
+			indexed[i] = pod.Name()
+		}
+	}
+	return indexed, nil
+}
+
+// statefulSetClaimName returns the name of the volume claimed by the pod of
+// the given ordinal of a StatefulSet from a volume claim template.
+func statefulSetClaimName(templateName, statefulSetName string, ordinal int32) string {
+	return templateName + "-" + statefulSetPodName(statefulSetName, ordinal)
+}
+
+// statefulSetPodTemplate returns the pod template of the given ordinal of a
+// StatefulSet.  The volumes of the volume claim templates are added to the
+// template, claiming the volumes of the ordinal.
+func statefulSetPodTemplate(statefulSetYAML *v1apps.StatefulSet, ordinal int32, publishAll bool) (*v1.PodTemplateSpec, error) {
+	template, err := replicaPodTemplate(&statefulSetYAML.Spec.Template, ordinal, statefulSetYAML.Annotations[define.KubeReplicaHostPortsAnnotation], publishAll)
+	if err != nil {
+		return nil, err
+	}
+	if len(statefulSetYAML.Spec.VolumeClaimTemplates) == 0 {
+		return template, nil
+	}
+
+	ordinalTemplate := *template
+	ordinalTemplate.Spec.Volumes = make([]v1.Volume, 0, len(template.Spec.Volumes)+len(statefulSetYAML.Spec.VolumeClaimTemplates))
+	for _, volume := range template.Spec.Volumes {
+		// The claim templates take precedence over the volumes of
+		// the pod template with the same name.
+		if !slices.ContainsFunc(statefulSetYAML.Spec.VolumeClaimTemplates, func(claim v1.PersistentVolumeClaim) bool {
+			return claim.Name == volume.Name
+		}) {
+			ordinalTemplate.Spec.Volumes = append(ordinalTemplate.Spec.Volumes, volume)
+		}
+	}
+	for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+		ordinalTemplate.Spec.Volumes = append(ordinalTemplate.Spec.Volumes, v1.Volume{
+			Name: claim.Name,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: statefulSetClaimName(claim.Name, statefulSetYAML.Name, ordinal),
+				},
+			},
+		})
+	}
+	return &ordinalTemplate, nil
+}
+
+// replicaPodTemplate returns the pod template of the given replica.  Host
+// ports cannot be shared between pods, hence they are either published by
+// the first replica only or incremented by the replica number, depending on
+// hostPortsMode.
+func replicaPodTemplate(template *v1.PodTemplateSpec, replica int32, hostPortsMode string, publishAll bool) (*v1.PodTemplateSpec, error) {
+	switch hostPortsMode {
+	case "", replicaHostPortsFirst, replicaHostPortsIncrement:
+	default:
+		return nil, fmt.Errorf("invalid value %q for annotation %s, must be %q or %q", hostPortsMode, define.KubeReplicaHostPortsAnnotation, replicaHostPortsFirst, replicaHostPortsIncrement)
+	}
+	if replica == 0 {
+		return template, nil
+	}
+
+	replicaTemplate := *template
+	replicaTemplate.Spec.Containers = make([]v1.Container, 0, len(template.Spec.Containers))
+	for _, container := range template.Spec.Containers {
+		ports := make([]v1.ContainerPort, 0, len(container.Ports))
+		for _, port := range container.Ports {
+			hostPort := port.HostPort
+			if hostPort == 0 && publishAll {
+				hostPort = port.ContainerPort
+			}
+			if port.ContainerPort == 0 {
+				port.ContainerPort = hostPort
+			}
+			switch {
+			case hostPort == 0:
+				// Not published.
+			case hostPortsMode == replicaHostPortsIncrement:
+				if int64(hostPort)+int64(replica) > math.MaxUint16 {
+					return nil, fmt.Errorf("host port %d of replica %d exceeds %d", hostPort, replica, math.MaxUint16)
+				}
+				port.HostPort = hostPort + replica
+			default:
+				port.HostPort = 0
+			}
+			ports = append(ports, port)
+		}
+		container.Ports = ports
+		replicaTemplate.Spec.Containers = append(replicaTemplate.Spec.Containers, container)
+	}
+	return &replicaTemplate, nil
+}
+
+// KubeScale sets the replica count of the Deployments in the YAML.  Missing
+// replicas are created and surplus replicas are removed, the other replicas
+// are left untouched.
+func (ic *ContainerEngine) KubeScale(ctx context.Context, body io.Reader, options entities.KubeScaleOptions) (*entities.PlayKubeReport, error) {
+	if options.Replicas < 0 {
+		return nil, fmt.Errorf("replica count %d must not be negative: %w", options.Replicas, define.ErrInvalidArg)
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := readKubeDocuments(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		configMaps  []v1.ConfigMap
+		deployments []kubeDocument
+	)
+	for _, document := range documents {
+		switch document.kind {
+		case "ConfigMap":
+			configMaps = append(configMaps, *document.configMap)
+		case "Deployment":
+			if document.deployment.ObjectMeta.Name == "" {
+				return nil, errors.New("deployment does not have a name")
+			}
+			deployments = append(deployments, document)
+		}
+	}
+	if len(deployments) == 0 {
+		return nil, errors.New("YAML document does not contain any Deployment")
+	}
+
+	for _, p := range options.ConfigMaps {
+		f, err := os.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		cms, err := readConfigMapFromFile(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", p, err)
+		}
+		configMaps = append(configMaps, cms...)
+	}
+
+	report := &entities.PlayKubeReport{}
+	var surplus []string
+	for _, document := range deployments {
+		replicaPods, err := ic.existingReplicaPods(&document)
+		if err != nil {
+			return nil, err
+		}
+		for _, replica := range slices.Sorted(maps.Keys(replicaPods)) {
+			if replica >= options.Replicas {
+				surplus = append(surplus, replicaPods[replica])
+			}
+		}
+
+		playOptions, serviceContainer, err := ic.replicaPlayOptions(ctx, replicaPods)
+		if err != nil {
+			return nil, err
+		}
+		// The replicas are planned as if the Deployment had the new
+		// replica count.
+		document.deployment.Spec.Replicas = &options.Replicas
+		plans, err := document.podPlans(playOptions.PublishAllPorts)
+		if err != nil {
+			return nil, err
+		}
+		ipIndex := 0
+		for _, plan := range plans {
+			if _, ok := replicaPods[plan.index]; ok {
+				continue
+			}
+			r, proxies, err := ic.playKubePodPlan(ctx, plan, playOptions, &ipIndex, configMaps, serviceContainer, nil)
+			// The containers sent their READY message when
+			// the pod was started, the proxies are not needed.
+			for _, proxy := range proxies {
+				if err := proxy.Close(); err != nil {
+					logrus.Errorf("Closing notify proxy %q: %v", proxy.SocketPath(), err)
+				}
+			}
+			if err != nil {
+				// Remove the partially created replica, so that
+				// it is created again by the next kube scale.
+				if _, rmErr := ic.PodRm(ctx, []string{plan.name}, entities.PodRmOptions{Ignore: true, Force: true}); rmErr != nil {
+					logrus.Errorf("Removing pod %s: %v", plan.name, rmErr)
+				}
+				return nil, err
+			}
+			report.Pods = append(report.Pods, r.Pods...)
+		}
+	}
+
+	if len(surplus) > 0 {
+		report.StopReport, err = ic.PodStop(ctx, surplus, entities.PodStopOptions{
+			Ignore:  true,
+			Timeout: -1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		report.RmReport, err = ic.PodRm(ctx, surplus, entities.PodRmOptions{Ignore: true, Force: true})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// replicaPlayOptions returns the options to create new replicas of a
+// Deployment with.  New replicas are created with the recorded play options
+// of an existing replica and join its networks and service container.
+func (ic *ContainerEngine) replicaPlayOptions(ctx context.Context, replicaPods map[int32]string) (entities.PlayKubeOptions, *libpod.Container, error) {
+	options := entities.PlayKubeOptions{Quiet: true}
+
+	var serviceContainer *libpod.Container
+	if len(replicaPods) > 0 {
+		first := slices.Min(slices.Collect(maps.Keys(replicaPods)))
+		pod, err := ic.Libpod.LookupPod(replicaPods[first])
+		if err != nil {
+			return options, nil, err
+		}
+		infra, err := pod.InfraContainer()
+		if err != nil {
+			return options, nil, err
+		}
+		if value, ok := infra.Spec().Annotations[define.KubePlayOptionsAnnotation]; ok {
+			var recorded recordedPlayOptions
+			if err := json.Unmarshal([]byte(value), &recorded); err != nil {
+				return options, nil, fmt.Errorf("reading play options of pod %s: %w", pod.Name(), err)
+			}
+			recorded.apply(&options)
+		}
+		options.Networks, err = infra.Networks()
+		if err != nil {
+			return options, nil, err
+		}
+		serviceContainer, err = pod.ServiceContainer()
+		if err != nil && !errors.Is(err, define.ErrNoSuchCtr) {
+			return options, nil, err
+		}
+	}
+
+	if len(options.Networks) == 0 {
+		_, err := ic.NetworkCreate(
+			ctx,
+			nettypes.Network{
+				Name:       kubeDefaultNetwork,
+				DNSEnabled: true,
+			},
+			&nettypes.NetworkCreateOptions{
+				IgnoreIfExists: true,
+			},
+		)
+		if err != nil {
+			return options, nil, err
+		}
+	}
+	return options, serviceContainer, nil
+}
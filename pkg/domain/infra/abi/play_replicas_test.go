@@ -0,0 +1,219 @@
+//go:build !remote
+
+package abi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	v1apps "github.com/containers/podman/v5/pkg/k8s.io/api/apps/v1"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaIndex(t *testing.T) {
+	tests := []struct {
+		podName string
+		replica int32
+		ok      bool
+	}{
+		{"web-pod", 0, true},
+		{"web-pod-1", 1, true},
+		{"web-pod-12", 12, true},
+		{"web-pod-0", 0, false},
+		{"web-pod-01", 0, false},
+		{"web-pod-+1", 0, false},
+		{"web-pod-x", 0, false},
+		{"web-pod-1-pod", 0, false},
+		{"web", 0, false},
+		{"other-pod-1", 0, false},
+	}
+	for _, test := range tests {
+		replica, ok := replicaIndex("web", test.podName)
+		assert.Equal(t, test.ok, ok, test.podName)
+		assert.Equal(t, test.replica, replica, test.podName)
+		if ok {
+			assert.Equal(t, test.podName, replicaPodName("web", replica))
+		}
+	}
+}
+
+func TestReplicaPodTemplate(t *testing.T) {
+	template := &v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "ctr",
+				Ports: []v1.ContainerPort{
+					{ContainerPort: 80, HostPort: 8080},
+					{ContainerPort: 443},
+					{HostPort: 53, Protocol: v1.ProtocolUDP},
+				},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		replica    int32
+		mode       string
+		publishAll bool
+		ports      []v1.ContainerPort
+	}{
+		{
+			name:    "first replica is unchanged",
+			replica: 0,
+			ports:   template.Spec.Containers[0].Ports,
+		},
+		{
+			name:    "host ports on first replica only",
+			replica: 2,
+			mode:    replicaHostPortsFirst,
+			ports: []v1.ContainerPort{
+				{ContainerPort: 80},
+				{ContainerPort: 443},
+				{ContainerPort: 53, Protocol: v1.ProtocolUDP},
+			},
+		},
+		{
+			name:    "incremented host ports",
+			replica: 2,
+			mode:    replicaHostPortsIncrement,
+			ports: []v1.ContainerPort{
+				{ContainerPort: 80, HostPort: 8082},
+				{ContainerPort: 443},
+				{ContainerPort: 53, HostPort: 55, Protocol: v1.ProtocolUDP},
+			},
+		},
+		{
+			name:       "incremented host ports with publish all",
+			replica:    1,
+			mode:       replicaHostPortsIncrement,
+			publishAll: true,
+			ports: []v1.ContainerPort{
+				{ContainerPort: 80, HostPort: 8081},
+				{ContainerPort: 443, HostPort: 444},
+				{ContainerPort: 53, HostPort: 54, Protocol: v1.ProtocolUDP},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replicaTemplate, err := replicaPodTemplate(template, test.replica, test.mode, test.publishAll)
+			require.NoError(t, err)
+			assert.Equal(t, test.ports, replicaTemplate.Spec.Containers[0].Ports)
+		})
+	}
+	// The template of the deployment must not be modified.
+	assert.Equal(t, int32(8080), template.Spec.Containers[0].Ports[0].HostPort)
+
+	_, err := replicaPodTemplate(template, 0, "bogus", false)
+	assert.ErrorContains(t, err, `invalid value "bogus"`)
+
+	template.Spec.Containers[0].Ports = []v1.ContainerPort{{ContainerPort: 80, HostPort: 65535}}
+	_, err = replicaPodTemplate(template, 1, replicaHostPortsIncrement, false)
+	assert.ErrorContains(t, err, "host port 65535 of replica 1 exceeds 65535")
+}
+
+func TestStatefulSetOrdinal(t *testing.T) {
+	tests := []struct {
+		podName string
+		ordinal int32
+		ok      bool
+	}{
+		{"db-0", 0, true},
+		{"db-1", 1, true},
+		{"db-12", 12, true},
+		{"db-01", 0, false},
+		{"db--1", 0, false},
+		{"db-pod", 0, false},
+		{"db", 0, false},
+		{"other-0", 0, false},
+	}
+	for _, test := range tests {
+		ordinal, ok := statefulSetOrdinal("db", test.podName)
+		assert.Equal(t, test.ok, ok, test.podName)
+		assert.Equal(t, test.ordinal, ordinal, test.podName)
+		if ok {
+			assert.Equal(t, test.podName, statefulSetPodName("db", ordinal))
+		}
+	}
+}
+
+func TestStatefulSetPodTemplate(t *testing.T) {
+	statefulSet := &v1apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec: v1apps.StatefulSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:  "ctr",
+						Ports: []v1.ContainerPort{{ContainerPort: 5432, HostPort: 5432}},
+					}},
+					Volumes: []v1.Volume{
+						{Name: "config", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+						{Name: "data", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+		},
+	}
+
+	template, err := statefulSetPodTemplate(statefulSet, 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.ContainerPort{{ContainerPort: 5432}}, template.Spec.Containers[0].Ports)
+	assert.Equal(t, []v1.Volume{
+		{Name: "config", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+		{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data-db-1"}}},
+	}, template.Spec.Volumes)
+
+	// The template of the statefulSet must not be modified.
+	assert.Len(t, statefulSet.Spec.Template.Spec.Volumes, 2)
+	assert.NotNil(t, statefulSet.Spec.Template.Spec.Volumes[1].EmptyDir)
+}
+
+func TestRecordedPlayOptions(t *testing.T) {
+	options := entities.PlayKubeOptions{
+		Annotations:  map[string]string{"key": "value"},
+		ConfigMaps:   []string{"/tmp/configmap.yml"},
+		LogDriver:    "k8s-file",
+		LogOptions:   []string{"max-size=1mb"},
+		NoHosts:      true,
+		PublishPorts: []string{"8080:80"},
+		Userns:       "auto",
+		Replace:      true,
+	}
+
+	value, err := json.Marshal(newRecordedPlayOptions(options))
+	require.NoError(t, err)
+	// Config maps are read again by kube scale, neither their paths nor
+	// their content are recorded.
+	assert.NotContains(t, string(value), "configmap")
+	var recorded recordedPlayOptions
+	require.NoError(t, json.Unmarshal(value, &recorded))
+
+	scaleOptions := entities.PlayKubeOptions{Quiet: true}
+	recorded.apply(&scaleOptions)
+	options.ConfigMaps = nil
+	options.Replace = false
+	options.Quiet = true
+	assert.Equal(t, options, scaleOptions)
+}
+
+func TestOwnedPodTemplate(t *testing.T) {
+	template := &v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}}}
+	owned := ownedPodTemplate(template, "Deployment", "web")
+	assert.Equal(t, map[string]string{
+		"app":                     "web",
+		define.KubeOwnerKindLabel: "Deployment",
+		define.KubeOwnerNameLabel: "web",
+	}, owned.Labels)
+	assert.Equal(t, map[string]string{"app": "web"}, template.Labels)
+
+	owned = ownedPodTemplate(&v1.PodTemplateSpec{}, "Job", "job")
+	assert.Equal(t, "Job", owned.Labels[define.KubeOwnerKindLabel])
+}
@@ -0,0 +1,92 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/util"
+)
+
+func (ic *ContainerEngine) PluginInspect(ctx context.Context, names []string) ([]*entities.PluginReport, []error, error) {
+	errs := make([]error, 0, len(names))
+	reports := make([]*entities.PluginReport, 0, len(names))
+	for _, name := range names {
+		plugin, err := ic.Libpod.VolumePlugin(name)
+		if err != nil {
+			if errors.Is(err, define.ErrMissingPlugin) {
+				errs = append(errs, err)
+				continue
+			}
+			return nil, nil, fmt.Errorf("inspecting plugin %s: %w", name, err)
+		}
+		reports = append(reports, plugin)
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) PluginList(ctx context.Context, options entities.PluginListOptions) ([]*entities.PluginReport, error) {
+	pluginFilters, err := generatePluginFilters(options.Filters)
+	if err != nil {
+		return nil, err
+	}
+	plugins, err := ic.Libpod.VolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.PluginReport, 0, len(plugins))
+	for _, plugin := range plugins {
+		match := true
+		for _, filter := range pluginFilters {
+			if !filter(plugin) {
+				match = false
+				break
+			}
+		}
+		if match {
+			reports = append(reports, plugin)
+		}
+	}
+	return reports, nil
+}
+
+// generatePluginFilters returns the functions matching the plugins for the
+// given filters.  Multiple values of a filter match if any of them matches.
+func generatePluginFilters(filters map[string][]string) ([]func(*entities.PluginReport) bool, error) {
+	pluginFilters := make([]func(*entities.PluginReport) bool, 0, len(filters))
+	for key, values := range filters {
+		switch key {
+		case "name":
+			pluginFilters = append(pluginFilters, func(plugin *entities.PluginReport) bool {
+				return util.StringMatchRegexSlice(plugin.Name, values)
+			})
+		case "enabled", "running":
+			wants := make([]bool, 0, len(values))
+			for _, value := range values {
+				want, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s filter value %q: %w", key, value, err)
+				}
+				wants = append(wants, want)
+			}
+			if key == "running" {
+				pluginFilters = append(pluginFilters, func(plugin *entities.PluginReport) bool {
+					return slices.Contains(wants, plugin.Running)
+				})
+			} else {
+				pluginFilters = append(pluginFilters, func(plugin *entities.PluginReport) bool {
+					return slices.Contains(wants, plugin.Enabled)
+				})
+			}
+		default:
+			return nil, fmt.Errorf("%q is an invalid plugin filter", key)
+		}
+	}
+	return pluginFilters, nil
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
@@ -13,6 +14,9 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/domain/filters"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi/parse"
+	"github.com/containers/podman/v5/utils"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/sirupsen/logrus"
 )
 
 func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.VolumeCreateOptions) (*entities.IDOrNameResponse, error) {
@@ -203,6 +207,81 @@ func (ic *ContainerEngine) VolumeMounted(ctx context.Context, nameOrID string) (
 	return &entities.BoolReport{Value: false}, nil
 }
 
+// VolumeExport writes the content of the volume to the output as a tar stream.
+func (ic *ContainerEngine) VolumeExport(ctx context.Context, nameOrID string, options entities.VolumeExportOptions) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	mountPoint, err := ic.volumeMountPoint(ctx, vol)
+	if err != nil {
+		return err
+	}
+
+	logrus.Debugf("Exporting volume data from %s", mountPoint)
+	tarStream, err := utils.TarWithChroot(mountPoint)
+	if err != nil {
+		return err
+	}
+	defer tarStream.Close()
+	_, err = io.Copy(options.Output, tarStream)
+	return err
+}
+
+// VolumeImport extracts the tar stream of the input into the volume.
+func (ic *ContainerEngine) VolumeImport(ctx context.Context, nameOrID string, options entities.VolumeImportOptions) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	return ic.importVolume(ctx, vol, options.Input)
+}
+
+func (ic *ContainerEngine) importVolume(ctx context.Context, vol *libpod.Volume, tarFile io.Reader) error {
+	mountPoint, err := ic.volumeMountPoint(ctx, vol)
+	if err != nil {
+		return err
+	}
+
+	// dont care if volume is mounted or not we are gonna import everything to mountPoint
+	logrus.Debugf("Importing volume data into %s", mountPoint)
+	return archive.Untar(tarFile, mountPoint, nil)
+}
+
+// volumeMountPoint returns the mount point of the volume.  It fails if the
+// volume needs to be mounted for its content to be accessible but is not.
+func (ic *ContainerEngine) volumeMountPoint(ctx context.Context, vol *libpod.Volume) (string, error) {
+	mountPoint, err := vol.MountPoint()
+	if err != nil {
+		return "", err
+	}
+	if len(mountPoint) == 0 {
+		return "", errors.New("volume is not mounted anywhere on host")
+	}
+
+	driver := vol.Driver()
+	volumeOptions := vol.Options()
+	volumeMountStatus, err := ic.VolumeMounted(ctx, vol.Name())
+	if err != nil {
+		return "", err
+	}
+
+	// Check if volume needs a mount and export only if volume is mounted
+	if vol.NeedsMount() && !volumeMountStatus.Value {
+		return "", fmt.Errorf("volume needs to be mounted but is not mounted on %s", mountPoint)
+	}
+
+	// Check if volume is using `local` driver and has mount options type other than tmpfs
+	if len(driver) == 0 || driver == define.VolumeDriverLocal {
+		if mountOptionType, ok := volumeOptions["type"]; ok {
+			if mountOptionType != define.TypeTmpfs && !volumeMountStatus.Value {
+				return "", fmt.Errorf("volume is using a driver %s and volume is not mounted on %s", driver, mountPoint)
+			}
+		}
+	}
+	return mountPoint, nil
+}
+
 func (ic *ContainerEngine) VolumeMount(ctx context.Context, nameOrIDs []string) ([]*entities.VolumeMountReport, error) {
 	reports := []*entities.VolumeMountReport{}
 	for _, name := range nameOrIDs {
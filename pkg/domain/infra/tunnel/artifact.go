@@ -1,43 +1,265 @@
 package tunnel
 
 import (
+	"archive/tar"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
 
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/pkg/bindings/artifacts"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/opencontainers/go-digest"
+	"github.com/sirupsen/logrus"
 )
 
-// TODO For now, no remote support has been added. We need the API to firm up first.
-
 func (ir *ImageEngine) ArtifactExtract(ctx context.Context, name string, target string, opts *entities.ArtifactExtractOptions) error {
-	return fmt.Errorf("not implemented")
+	options := extractOptions(opts)
+
+	// check if dest is a dir to know if we can copy more than one blob
+	destIsFile := true
+	stat, err := os.Stat(target)
+	if err == nil {
+		destIsFile = !stat.IsDir()
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if destIsFile && !options.Changed("Digest") && !options.Changed("Title") {
+		// Only a single blob can be written to a file.  Select it by
+		// digest, so its title does not matter.
+		report, err := artifacts.Inspect(ir.ClientCtx, name, nil)
+		if err != nil {
+			return err
+		}
+		if len(report.Manifest.Layers) > 1 {
+			return fmt.Errorf("the artifact consists of several blobs and the target %q is not a directory and neither digest or title was specified to only copy a single blob", target)
+		}
+		if len(report.Manifest.Layers) == 1 {
+			options.WithDigest(report.Manifest.Layers[0].Digest.String())
+		}
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(artifacts.Extract(ir.ClientCtx, name, pw, options))
+	}()
+
+	if destIsFile {
+		return extractArtifactBlobToFile(pr, target)
+	}
+	return extractArtifactBlobsToDir(pr, target)
+}
+
+// extractArtifactBlobToFile writes the single blob of the tar stream to the
+// target file.
+func extractArtifactBlobToFile(r io.Reader, target string) error {
+	tr := tar.NewReader(r)
+	if _, err := tr.Next(); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("the artifact does not contain any blob")
+		}
+		return err
+	}
+	if err := writeArtifactBlob(tr, target); err != nil {
+		return err
+	}
+	if _, err := tr.Next(); !errors.Is(err, io.EOF) {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("more than one blob to extract to the target %q", target)
+	}
+	return nil
+}
+
+// extractArtifactBlobsToDir writes each blob of the tar stream to a file of
+// the same name in the target directory.
+func extractArtifactBlobsToDir(r io.Reader, target string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return fmt.Errorf("invalid blob %q: not a regular file", hdr.Name)
+		}
+		// The names come from the server: never let them point outside of
+		// the target directory.
+		for i := 0; i < len(hdr.Name); i++ {
+			if os.IsPathSeparator(hdr.Name[i]) {
+				return fmt.Errorf("invalid name: %q cannot contain %c", hdr.Name, hdr.Name[i])
+			}
+		}
+		if hdr.Name == "" || hdr.Name == "." || hdr.Name == ".." {
+			return fmt.Errorf("invalid name: %q", hdr.Name)
+		}
+		if err := writeArtifactBlob(tr, filepath.Join(target, hdr.Name)); err != nil {
+			return err
+		}
+	}
+}
+
+func writeArtifactBlob(r io.Reader, target string) error {
+	dest, err := os.Create(target)
+	if err != nil {
+		return fmt.Errorf("failed to create target file: %w", err)
+	}
+	defer dest.Close()
+	if _, err := io.Copy(dest, r); err != nil {
+		return err
+	}
+	return dest.Close()
+}
+
+func extractOptions(opts *entities.ArtifactExtractOptions) *artifacts.ExtractOptions {
+	options := new(artifacts.ExtractOptions)
+	if opts == nil {
+		return options
+	}
+	if opts.Digest != "" {
+		options.WithDigest(opts.Digest)
+	}
+	if opts.Title != "" {
+		options.WithTitle(opts.Title)
+	}
+	return options
 }
 
 func (ir *ImageEngine) ArtifactExtractTarStream(ctx context.Context, w io.Writer, name string, opts *entities.ArtifactExtractOptions) error {
-	return fmt.Errorf("not implemented")
+	return artifacts.Extract(ir.ClientCtx, name, w, extractOptions(opts))
 }
 
 func (ir *ImageEngine) ArtifactInspect(ctx context.Context, name string, opts entities.ArtifactInspectOptions) (*entities.ArtifactInspectReport, error) {
-	return nil, fmt.Errorf("not implemented")
+	return artifacts.Inspect(ir.ClientCtx, name, nil)
 }
 
 func (ir *ImageEngine) ArtifactList(ctx context.Context, opts entities.ArtifactListOptions) ([]*entities.ArtifactListReport, error) {
-	return nil, fmt.Errorf("not implemented")
+	return artifacts.List(ir.ClientCtx, nil)
 }
 
 func (ir *ImageEngine) ArtifactPull(ctx context.Context, name string, opts entities.ArtifactPullOptions) (*entities.ArtifactPullReport, error) {
-	return nil, fmt.Errorf("not implemented")
+	if opts.OciDecryptConfig != nil {
+		return nil, fmt.Errorf("decryption is not supported for remote clients")
+	}
+
+	options := new(artifacts.PullOptions)
+	options.WithAuthfile(opts.AuthFilePath).WithUsername(opts.Username).WithPassword(opts.Password)
+	if s := opts.InsecureSkipTLSVerify; s != types.OptionalBoolUndefined {
+		options.WithSkipTLSVerify(s == types.OptionalBoolTrue)
+	}
+	if opts.MaxRetries != nil {
+		options.WithRetry(*opts.MaxRetries)
+	}
+	if opts.RetryDelay != "" {
+		options.WithRetryDelay(opts.RetryDelay)
+	}
+	return artifacts.Pull(ir.ClientCtx, name, options)
 }
 
 func (ir *ImageEngine) ArtifactRm(ctx context.Context, name string, opts entities.ArtifactRemoveOptions) (*entities.ArtifactRemoveReport, error) {
-	return nil, fmt.Errorf("not implemented")
+	var namesOrDigests []string
+	if opts.All {
+		allArtifacts, err := artifacts.List(ir.ClientCtx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, art := range allArtifacts {
+			// Using the digest here instead of name to protect against
+			// an artifact that lacks a name
+			manifestDigest, err := art.GetDigest()
+			if err != nil {
+				return nil, err
+			}
+			namesOrDigests = append(namesOrDigests, manifestDigest.Encoded())
+		}
+	}
+
+	if name != "" {
+		namesOrDigests = append(namesOrDigests, name)
+	}
+
+	artifactDigests := make([]*digest.Digest, 0, len(namesOrDigests))
+	for _, nameOrDigest := range namesOrDigests {
+		report, err := artifacts.Remove(ir.ClientCtx, nameOrDigest, nil)
+		if err != nil {
+			return nil, err
+		}
+		artifactDigests = append(artifactDigests, report.ArtifactDigests...)
+	}
+	return &entities.ArtifactRemoveReport{
+		ArtifactDigests: artifactDigests,
+	}, nil
 }
 
 func (ir *ImageEngine) ArtifactPush(ctx context.Context, name string, opts entities.ArtifactPushOptions) (*entities.ArtifactPushReport, error) {
-	return nil, fmt.Errorf("not implemented")
+	options := new(artifacts.PushOptions)
+	options.WithAuthfile(opts.Authfile).WithUsername(opts.Username).WithPassword(opts.Password)
+	if s := opts.SkipTLSVerify; s != types.OptionalBoolUndefined {
+		options.WithSkipTLSVerify(s == types.OptionalBoolTrue)
+	}
+	if opts.Retry != nil {
+		options.WithRetry(*opts.Retry)
+	}
+	if opts.RetryDelay != "" {
+		options.WithRetryDelay(opts.RetryDelay)
+	}
+	return artifacts.Push(ir.ClientCtx, name, options)
+}
+
+func (ir *ImageEngine) ArtifactAdd(ctx context.Context, name string, artifactBlobs []entities.ArtifactBlob, opts *entities.ArtifactAddOptions) (*entities.ArtifactAddReport, error) {
+	annotations := make([]string, 0, len(opts.Annotations))
+	for k, v := range opts.Annotations {
+		annotations = append(annotations, k+"="+v)
+	}
+	sort.Strings(annotations)
+
+	var report *entities.ArtifactAddReport
+	// The service adds a single file per request, so the following files
+	// are appended to the artifact created or extended by the first one.
+	for i, blob := range artifactBlobs {
+		options := new(artifacts.AddOptions).WithAnnotations(annotations).WithAppend(opts.Append || i > 0)
+		if opts.FileType != "" {
+			options.WithFileMIMEType(opts.FileType)
+		}
+		if opts.ArtifactType != "" && i == 0 {
+			options.WithArtifactMIMEType(opts.ArtifactType)
+		}
+
+		var err error
+		report, err = addArtifactBlob(ir.ClientCtx, name, blob, options)
+		if err != nil {
+			if i > 0 && !opts.Append {
+				// Do not leave a partially added artifact behind.
+				if _, rmErr := artifacts.Remove(ir.ClientCtx, name, nil); rmErr != nil {
+					logrus.Errorf("Removing partially added artifact %s: %v", name, rmErr)
+				}
+			}
+			return nil, err
+		}
+	}
+	return report, nil
 }
 
-func (ir *ImageEngine) ArtifactAdd(ctx context.Context, name string, artifactBlob []entities.ArtifactBlob, opts *entities.ArtifactAddOptions) (*entities.ArtifactAddReport, error) {
-	return nil, fmt.Errorf("not implemented")
+func addArtifactBlob(ctx context.Context, name string, blob entities.ArtifactBlob, options *artifacts.AddOptions) (*entities.ArtifactAddReport, error) {
+	r := blob.BlobReader
+	if r == nil {
+		f, err := os.Open(blob.BlobFilePath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	return artifacts.Add(ctx, name, blob.FileName, r, options)
 }
@@ -4,9 +4,30 @@ import (
 	"context"
 	"errors"
 
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/pkg/bindings/system"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 )
 
 func (ic *ContainerEngine) AutoUpdate(ctx context.Context, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
-	return nil, []error{errors.New("not implemented")}
+	opts := new(system.AutoUpdateOptions).WithAuthfile(options.Authfile).WithDryRun(options.DryRun).WithRollback(options.Rollback)
+	if len(options.Images) > 0 {
+		opts.WithImages(options.Images)
+	}
+	if options.Staged {
+		opts.WithStaged(true).WithStagedTimeout(options.StagedTimeout.String())
+	}
+	if s := options.InsecureSkipTLSVerify; s != types.OptionalBoolUndefined {
+		opts.WithSkipTLSVerify(s == types.OptionalBoolTrue)
+	}
+
+	response, err := system.AutoUpdate(ic.ClientCtx, opts)
+	if err != nil {
+		return nil, []error{err}
+	}
+	var errs []error
+	for _, e := range response.Errors {
+		errs = append(errs, errors.New(e))
+	}
+	return response.Reports, errs
 }
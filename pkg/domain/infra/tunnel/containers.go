@@ -14,6 +14,7 @@ import (
 
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/docker/reference"
+	imageTypes "github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/api/handlers"
 	"github.com/containers/podman/v5/pkg/bindings"
@@ -21,15 +22,83 @@ import (
 	"github.com/containers/podman/v5/pkg/bindings/images"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
+	domainUtils "github.com/containers/podman/v5/pkg/domain/utils"
 	"github.com/containers/podman/v5/pkg/errorhandling"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/util"
+	"github.com/containers/podman/v5/utils"
 	"github.com/containers/storage/types"
 	"github.com/sirupsen/logrus"
 )
 
 func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string, image string, args []string, options entities.ContainerRunlabelOptions) error {
-	return errors.New("not implemented")
+	report, err := ic.ContainerRunlabelCommand(ctx, label, image, args, options)
+	if err != nil {
+		return err
+	}
+
+	// The command is executed on the client, so podman refers to the
+	// client itself.
+	podmanPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	cmd, env, err := domainUtils.RunlabelCommand(report.Command, podmanPath, options)
+	if err != nil {
+		return err
+	}
+
+	if options.Display {
+		fmt.Printf("command: %s\n", strings.Join(append([]string{os.Args[0]}, cmd[1:]...), " "))
+		return nil
+	}
+
+	stdErr := os.Stderr
+	stdOut := os.Stdout
+	stdIn := os.Stdin
+	if options.Quiet {
+		stdErr = nil
+		stdOut = nil
+		stdIn = nil
+	}
+
+	// If container already exists && --replace given -- Nuke it
+	if options.Replace {
+		for i, entry := range cmd {
+			if entry == "--name" {
+				name := cmd[i+1]
+				logrus.Debugf("Runlabel --replace option given. Container %s will be deleted", name)
+				if _, err := containers.Remove(ic.ClientCtx, name, new(containers.RemoveOptions).WithForce(true)); err != nil && !errorhandling.Contains(err, define.ErrNoSuchCtr) {
+					return err
+				}
+				break
+			}
+		}
+	}
+
+	return utils.ExecCmdWithStdStreams(stdIn, stdOut, stdErr, env, cmd[0], cmd[1:]...)
+}
+
+func (ic *ContainerEngine) ContainerRunlabelCommand(ctx context.Context, label string, image string, args []string, options entities.ContainerRunlabelOptions) (*entities.ContainerRunlabelReport, error) {
+	if options.SignaturePolicy != "" {
+		return nil, errors.New("--signature-policy is not supported for remote clients")
+	}
+
+	runlabelOptions := new(containers.RunlabelOptions).WithArgs(args).WithAuthfile(options.Authfile).WithPull(options.Pull)
+	if options.Name != "" {
+		runlabelOptions.WithName(options.Name)
+	}
+	if s := options.SkipTLSVerify; s != imageTypes.OptionalBoolUndefined {
+		runlabelOptions.WithSkipTLSVerify(s == imageTypes.OptionalBoolTrue)
+	}
+	if options.Credentials != "" {
+		creds, err := util.ParseRegistryCreds(options.Credentials)
+		if err != nil {
+			return nil, err
+		}
+		runlabelOptions.WithUsername(creds.Username).WithPassword(creds.Password)
+	}
+	return containers.Runlabel(ic.ClientCtx, label, image, runlabelOptions)
 }
 
 func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (*entities.BoolReport, error) {
@@ -978,6 +1047,14 @@ func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []st
 	return nil, errors.New("not implemented")
 }
 
+func (ic *ContainerEngine) ContainerLogRotate(ctx context.Context, nameOrID string) error {
+	return errors.New("not implemented")
+}
+
+func (ic *ContainerEngine) ContainerSyslog(ctx context.Context, nameOrID string, options entities.ContainerSyslogOptions) error {
+	return errors.New("not implemented")
+}
+
 func (ic *ContainerEngine) ContainerInit(ctx context.Context, namesOrIds []string, options entities.ContainerInitOptions) ([]*entities.ContainerInitReport, error) {
 	ctrs, rawInputs, err := getContainersAndInputByContext(ic.ClientCtx, options.All, false, namesOrIds, nil)
 	if err != nil {
@@ -1079,7 +1156,14 @@ func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string,
 }
 
 func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts entities.ContainerCloneOptions) (*entities.ContainerCreateReport, error) {
-	return nil, errors.New("cloning a container is not supported on the remote client")
+	response, err := containers.Clone(ic.ClientCtx, &ctrCloneOpts, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range response.Warnings {
+		fmt.Fprintf(os.Stderr, "%s\n", w)
+	}
+	return &entities.ContainerCreateReport{Id: response.ID}, nil
 }
 
 // ContainerUpdate finds and updates the given container's cgroup config with the specified options
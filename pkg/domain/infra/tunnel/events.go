@@ -3,7 +3,6 @@ package tunnel
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/bindings/system"
@@ -14,11 +13,15 @@ func (ic *ContainerEngine) Events(ctx context.Context, opts entities.EventsOptio
 	filters := make(map[string][]string)
 	if len(opts.Filter) > 0 {
 		for _, filter := range opts.Filter {
-			split := strings.Split(filter, "=")
-			if len(split) < 2 {
+			key, op, value, err := events.ParseFilter(filter)
+			if err != nil {
 				return fmt.Errorf("invalid filter %q", filter)
 			}
-			filters[split[0]] = append(filters[split[0]], strings.Join(split[1:], "="))
+			// The server accepts operators as prefix of the value.
+			if op != "=" {
+				value = op + value
+			}
+			filters[key] = append(filters[key], value)
 		}
 	}
 	binChan := make(chan entities.Event)
@@ -2,6 +2,7 @@ package tunnel
 
 import (
 	"context"
+	"errors"
 
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
@@ -9,5 +10,9 @@ import (
 )
 
 func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, options entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
-	return containers.RunHealthCheck(ic.ClientCtx, nameOrID, nil)
+	return containers.RunHealthCheck(ic.ClientCtx, nameOrID, new(containers.HealthCheckOptions).WithReadiness(options.Readiness))
+}
+
+func (ic *ContainerEngine) HealthCheckScheduler(ctx context.Context, nameOrID string, options entities.HealthCheckSchedulerOptions) error {
+	return errors.New("not implemented")
 }
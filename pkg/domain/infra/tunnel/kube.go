@@ -46,7 +46,7 @@ func (ic *ContainerEngine) GenerateSystemd(ctx context.Context, nameOrID string,
 //
 // Note: Caller is responsible for closing returned Reader
 func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string, opts entities.GenerateKubeOptions) (*entities.GenerateKubeReport, error) {
-	options := new(generate.KubeOptions).WithService(opts.Service).WithType(opts.Type).WithReplicas(opts.Replicas).WithNoTrunc(opts.UseLongAnnotations).WithPodmanOnly(opts.PodmanOnly)
+	options := new(generate.KubeOptions).WithService(opts.Service).WithType(opts.Type).WithReplicas(opts.Replicas).WithSchedule(opts.Schedule).WithNoTrunc(opts.UseLongAnnotations).WithPodmanOnly(opts.PodmanOnly)
 	return generate.Kube(ic.ClientCtx, nameOrIDs, options)
 }
 
@@ -75,6 +75,9 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, opts en
 	options.WithPublishPorts(opts.PublishPorts)
 	options.WithPublishAllPorts(opts.PublishAllPorts)
 	options.WithNoTrunc(opts.UseLongAnnotations)
+	if opts.WaitReady {
+		options.WithWaitReady(true).WithWaitReadyTimeout(opts.WaitReadyTimeout.String())
+	}
 	return play.KubeWithBody(ic.ClientCtx, body, options)
 }
 
@@ -86,3 +89,13 @@ func (ic *ContainerEngine) KubeApply(ctx context.Context, body io.Reader, opts e
 	options := new(kube.ApplyOptions).WithKubeconfig(opts.Kubeconfig).WithCACertFile(opts.CACertFile).WithNamespace(opts.Namespace)
 	return kube.ApplyWithBody(ic.ClientCtx, body, options)
 }
+
+func (ic *ContainerEngine) KubeScale(ctx context.Context, body io.Reader, opts entities.KubeScaleOptions) (*entities.PlayKubeReport, error) {
+	options := new(kube.ScaleOptions).WithReplicas(opts.Replicas).WithConfigMaps(opts.ConfigMaps)
+	return kube.ScaleWithBody(ic.ClientCtx, body, options)
+}
+
+func (ic *ContainerEngine) KubeDiff(ctx context.Context, body io.Reader, opts entities.KubeDiffOptions) (*entities.KubeDiffReport, error) {
+	options := new(kube.DiffOptions).WithConfigMaps(opts.ConfigMaps)
+	return kube.DiffWithBody(ic.ClientCtx, body, options)
+}
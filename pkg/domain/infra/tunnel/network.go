@@ -2,7 +2,6 @@ package tunnel
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/containers/common/libnetwork/types"
@@ -47,7 +46,25 @@ func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []stri
 }
 
 func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, opts entities.NetworkReloadOptions) ([]*entities.NetworkReloadReport, error) {
-	return nil, errors.New("not implemented")
+	ctrs, err := getContainersByContext(ic.ClientCtx, opts.All, false, names)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*entities.NetworkReloadReport, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		report, err := network.Reload(ic.ClientCtx, ctr.ID, nil)
+		if err != nil {
+			// ignore errors for invalid ctr state and network mode when --all is used
+			if opts.All && (errorhandling.Contains(err, define.ErrCtrStateInvalid) ||
+				errorhandling.Contains(err, define.ErrNetworkModeInvalid)) {
+				continue
+			}
+			report = &entities.NetworkReloadReport{Id: ctr.ID, Err: err}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
 }
 
 func (ic *ContainerEngine) NetworkRm(ctx context.Context, namesOrIds []string, opts entities.NetworkRmOptions) ([]*entities.NetworkRmReport, error) {
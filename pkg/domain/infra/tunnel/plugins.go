@@ -0,0 +1,37 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v5/pkg/bindings/plugins"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/errorhandling"
+)
+
+func (ic *ContainerEngine) PluginInspect(ctx context.Context, names []string) ([]*entities.PluginReport, []error, error) {
+	var (
+		reports = make([]*entities.PluginReport, 0, len(names))
+		errs    = []error{}
+	)
+	for _, name := range names {
+		data, err := plugins.Inspect(ic.ClientCtx, name, nil)
+		if err != nil {
+			errModel, ok := err.(*errorhandling.ErrorModel)
+			if !ok {
+				return nil, nil, err
+			}
+			if errModel.ResponseCode == 404 {
+				errs = append(errs, fmt.Errorf("no volume plugin with name %s available", name))
+				continue
+			}
+			return nil, nil, err
+		}
+		reports = append(reports, data)
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) PluginList(ctx context.Context, options entities.PluginListOptions) ([]*entities.PluginReport, error) {
+	return plugins.List(ic.ClientCtx, new(plugins.ListOptions).WithFilters(options.Filters))
+}
@@ -2,15 +2,30 @@ package tunnel
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
+	"github.com/containers/podman/v5/pkg/bindings/images"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 )
 
 func (ir *ImageEngine) ShowTrust(ctx context.Context, args []string, options entities.ShowTrustOptions) (*entities.ShowTrustReport, error) {
-	return nil, errors.New("not implemented")
+	opts := new(images.ShowTrustOptions).WithRaw(options.Raw)
+	if options.PolicyPath != "" {
+		opts.WithPolicyPath(options.PolicyPath)
+	}
+	if options.RegistryPath != "" {
+		opts.WithRegistryPath(options.RegistryPath)
+	}
+	return images.ShowTrust(ir.ClientCtx, opts)
 }
 
 func (ir *ImageEngine) SetTrust(ctx context.Context, args []string, options entities.SetTrustOptions) error {
-	return errors.New("not implemented")
+	if len(args) != 1 {
+		return fmt.Errorf("SetTrust called with unexpected %d args", len(args))
+	}
+	opts := new(images.SetTrustOptions).WithType(options.Type).WithPubKeysFile(options.PubKeysFile)
+	if options.PolicyPath != "" {
+		opts.WithPolicyPath(options.PolicyPath)
+	}
+	return images.SetTrust(ir.ClientCtx, args[0], opts)
 }
@@ -99,7 +99,19 @@ func (ic *ContainerEngine) VolumeExists(ctx context.Context, nameOrID string) (*
 // Volumemounted check if a given volume using plugin or filesystem is mounted or not.
 // TODO: Not used and exposed to tunnel. Will be used by `export` command which is unavailable to `podman-remote`
 func (ic *ContainerEngine) VolumeMounted(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
-	return nil, errors.New("not implemented")
+	response, err := volumes.Inspect(ic.ClientCtx, nameOrID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BoolReport{Value: response.MountCount > 0}, nil
+}
+
+func (ic *ContainerEngine) VolumeExport(ctx context.Context, nameOrID string, options entities.VolumeExportOptions) error {
+	return volumes.Export(ic.ClientCtx, nameOrID, options.Output, nil)
+}
+
+func (ic *ContainerEngine) VolumeImport(ctx context.Context, nameOrID string, options entities.VolumeImportOptions) error {
+	return volumes.Import(ic.ClientCtx, nameOrID, options.Input, nil)
 }
 
 func (ic *ContainerEngine) VolumeMount(ctx context.Context, nameOrIDs []string) ([]*entities.VolumeMountReport, error) {
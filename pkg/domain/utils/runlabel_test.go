@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunlabelCommand(t *testing.T) {
+	options := entities.ContainerRunlabelOptions{Optional1: "--rm", Optional3: "arg"}
+
+	cmd, env, err := RunlabelCommand([]string{"podman", "run", "${OPT1}", "image", "$OPT2", "${OPT3}"}, "/bin/sh", options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/sh", "run", "--rm", "image", "arg"}, cmd)
+	assert.Contains(t, env, "OPT1=--rm")
+	assert.Contains(t, env, "PODMAN_RUNLABEL_NESTED=1")
+
+	cmd, _, err = RunlabelCommand([]string{"/usr/bin/docker", "ps"}, "/bin/sh", options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/sh", "ps"}, cmd)
+
+	cmd, _, err = RunlabelCommand([]string{"ls", "-la"}, "/bin/sh", options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ls", "-la"}, cmd)
+
+	_, _, err = RunlabelCommand([]string{"/nonexistent/install.sh"}, "/bin/sh", options)
+	assert.Error(t, err)
+
+	_, _, err = RunlabelCommand([]string{"$OPT2"}, "/bin/sh", options)
+	assert.ErrorContains(t, err, "does not contain a command")
+}
@@ -153,6 +153,63 @@ func (as ArtifactStore) Pull(ctx context.Context, name string, opts libimage.Cop
 	return digest.FromBytes(artifactBytes), nil
 }
 
+// ManifestDigest returns the digest of the manifest the artifact with the given
+// name points to in the local store.  Note that, unlike Artifact.GetDigest, the
+// digest is taken from the store's index and hence matches the digest of the
+// manifest in the registry the artifact was pulled from.
+func (as ArtifactStore) ManifestDigest(_ context.Context, name string) (digest.Digest, error) {
+	if len(name) == 0 {
+		return "", ErrEmptyArtifactName
+	}
+	lrs, err := layout.List(as.storePath)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range lrs {
+		if l.ManifestDescriptor.Annotations[specV1.AnnotationRefName] == name {
+			return l.ManifestDescriptor.Digest, nil
+		}
+	}
+	return "", fmt.Errorf("%s: %w", name, libartTypes.ErrArtifactNotExist)
+}
+
+// Tag points name to the manifest with the given digest.  The manifest must
+// still be present in the local store, which is the case for an artifact that
+// has been replaced by pulling a newer version under the same name.  If name
+// is already in use by another artifact, that artifact is untagged.
+func (as ArtifactStore) Tag(ctx context.Context, manifestDigest digest.Digest, name string) error {
+	if len(name) == 0 {
+		return ErrEmptyArtifactName
+	}
+	lrs, err := layout.List(as.storePath)
+	if err != nil {
+		return err
+	}
+	var srcRef types.ImageReference
+	for _, l := range lrs {
+		if l.ManifestDescriptor.Digest == manifestDigest {
+			srcRef = l.Reference
+			break
+		}
+	}
+	if srcRef == nil {
+		return fmt.Errorf("%s: %w", manifestDigest, libartTypes.ErrArtifactNotExist)
+	}
+	destRef, err := layout.NewReference(as.storePath, name)
+	if err != nil {
+		return err
+	}
+	copyer, err := libimage.NewCopier(&libimage.CopyOptions{}, as.SystemContext)
+	if err != nil {
+		return err
+	}
+	if _, err := copyer.Copy(ctx, srcRef, destRef); err != nil {
+		_ = copyer.Close()
+		return err
+	}
+	return copyer.Close()
+}
+
 // Push an artifact to an image registry
 func (as ArtifactStore) Push(ctx context.Context, src, dest string, opts libimage.CopyOptions) (digest.Digest, error) {
 	if len(dest) == 0 {
@@ -512,10 +569,13 @@ func (as ArtifactStore) ExtractTarStream(ctx context.Context, w io.Writer, nameO
 	}
 	defer imgSrc.Close()
 
-	tw := tar.NewWriter(w)
-	defer tw.Close()
-
-	// Return early if only a single blob is requested via title or digest
+	// Resolve all blobs before writing anything, so errors are reported
+	// before the tar stream starts.
+	type blob struct {
+		digest   digest.Digest
+		filename string
+	}
+	var blobs []blob
 	if len(options.Digest) > 0 || len(options.Title) > 0 {
 		digest, err := findDigest(arty, &options.FilterBlobOptions)
 		if err != nil {
@@ -530,23 +590,23 @@ func (as ArtifactStore) ExtractTarStream(ctx context.Context, w io.Writer, nameO
 		if err != nil {
 			return err
 		}
-
-		err = copyTrustedImageBlobToTarStream(ctx, imgSrc, digest, filename, tw)
-		if err != nil {
-			return err
+		blobs = append(blobs, blob{digest: digest, filename: filename})
+	} else {
+		for _, l := range arty.Manifest.Layers {
+			title := l.Annotations[specV1.AnnotationTitle]
+			filename, err := generateArtifactBlobName(title, l.Digest)
+			if err != nil {
+				return err
+			}
+			blobs = append(blobs, blob{digest: l.Digest, filename: filename})
 		}
-
-		return nil
 	}
 
-	for _, l := range arty.Manifest.Layers {
-		title := l.Annotations[specV1.AnnotationTitle]
-		filename, err := generateArtifactBlobName(title, l.Digest)
-		if err != nil {
-			return err
-		}
-		err = copyTrustedImageBlobToTarStream(ctx, imgSrc, l.Digest, filename, tw)
-		if err != nil {
+	tw := tar.NewWriter(w)
+	defer tw.Close()
+
+	for _, b := range blobs {
+		if err := copyTrustedImageBlobToTarStream(ctx, imgSrc, b.digest, b.filename, tw); err != nil {
 			return err
 		}
 	}
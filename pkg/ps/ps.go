@@ -186,6 +186,7 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		portMappings                            []libnetworkTypes.PortMapping
 		networks                                []string
 		healthStatus                            string
+		readiness                               string
 		restartCount                            uint
 		podName                                 string
 	)
@@ -236,6 +237,11 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 			return err
 		}
 
+		readiness, err = c.ReadinessStatus()
+		if err != nil {
+			return err
+		}
+
 		restartCount, err = c.RestartCount()
 		if err != nil {
 			return err
@@ -307,6 +313,7 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		StartedAt:    startedTime.Unix(),
 		State:        conState.String(),
 		Status:       healthStatus,
+		Readiness:    readiness,
 	}
 
 	if opts.Namespace {
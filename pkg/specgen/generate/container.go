@@ -511,6 +511,7 @@ func ConfigToSpec(rt *libpod.Runtime, specg *specgen.SpecGenerator, containerID
 
 	specg.HealthConfig = conf.HealthCheckConfig
 	specg.StartupHealthConfig = conf.StartupHealthCheckConfig
+	specg.ReadinessConfig = conf.ReadinessCheckConfig
 	specg.HealthCheckOnFailureAction = conf.HealthCheckOnFailureAction
 
 	specg.IDMappings = &conf.IDMappings
@@ -560,6 +560,15 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		if len(s.LogConfiguration.Options) > 0 && s.LogConfiguration.Options["tag"] != "" {
 			options = append(options, libpod.WithLogTag(s.LogConfiguration.Options["tag"]))
 		}
+		logOptions := make(map[string]string)
+		for key, val := range s.LogConfiguration.Options {
+			if key != "tag" {
+				logOptions[key] = val
+			}
+		}
+		if len(logOptions) > 0 {
+			options = append(options, libpod.WithLogOptions(logOptions))
+		}
 
 		if len(s.LogConfiguration.Driver) > 0 {
 			options = append(options, libpod.WithLogDriver(s.LogConfiguration.Driver))
@@ -650,6 +659,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		options = append(options, libpod.WithStartupHealthcheck(s.ContainerHealthCheckConfig.StartupHealthConfig))
 		healthCheckSet = true
 	}
+	if s.ContainerHealthCheckConfig.ReadinessConfig != nil {
+		options = append(options, libpod.WithReadinessCheck(s.ContainerHealthCheckConfig.ReadinessConfig))
+	}
 
 	if s.ContainerHealthCheckConfig.HealthCheckOnFailureAction != define.HealthCheckOnFailureActionNone {
 		options = append(options, libpod.WithHealthCheckOnFailureAction(s.ContainerHealthCheckConfig.HealthCheckOnFailureAction))
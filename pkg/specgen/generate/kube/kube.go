@@ -271,6 +271,10 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure startupProbe: %w", err)
 	}
+	err = setupReadinessProbe(s, opts.Container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure readinessProbe: %w", err)
+	}
 
 	// Since we prefix the container name with pod name to work-around the uniqueness requirement,
 	// the seccomp profile should reference the actual container name from the YAML
@@ -574,6 +578,15 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 				SubPath: volume.SubPath,
 			}
 			s.Volumes = append(s.Volumes, &secretVolume)
+		case KubeVolumeTypeDownwardAPI, KubeVolumeTypeProjected:
+			// the files are generated in a named volume of the pod
+			podVolume := specgen.NamedVolume{
+				Dest:    volume.MountPath,
+				Name:    volumeSource.Source,
+				Options: options,
+				SubPath: volume.SubPath,
+			}
+			s.Volumes = append(s.Volumes, &podVolume)
 		case KubeVolumeTypeEmptyDir:
 			emptyDirVolume := specgen.NamedVolume{
 				Dest:        volume.MountPath,
@@ -696,7 +709,6 @@ func parseMountPath(mountPath string, readOnly bool, propagationMode *v1.MountPr
 
 func probeToHealthConfig(probe *v1.Probe, containerPorts []v1.ContainerPort) (*manifest.Schema2HealthConfig, error) {
 	var commandString string
-	failureCmd := "exit 1"
 	probeHandler := probe.Handler
 	host := "localhost" // Kubernetes default is host IP, but with Podman currently we run inside the container
 
@@ -726,7 +738,13 @@ func probeToHealthConfig(probe *v1.Probe, containerPorts []v1.ContainerPort) (*m
 		if err != nil {
 			return nil, err
 		}
-		commandString = fmt.Sprintf("curl -f %s://%s:%d%s || %s", uriScheme, host, portNum, path, failureCmd)
+		// Podman performs the probe itself, so the image does not need
+		// to ship an HTTP client.
+		test := []string{define.HealthConfigTestHTTP, fmt.Sprintf("%s://%s%s", strings.ToLower(string(uriScheme)), net.JoinHostPort(host, strconv.Itoa(portNum)), path)}
+		for _, header := range probeHandler.HTTPGet.HTTPHeaders {
+			test = append(test, header.Name+": "+header.Value)
+		}
+		return makeHealthCheckFromTest(test, probe.PeriodSeconds, probe.FailureThreshold, probe.TimeoutSeconds, probe.InitialDelaySeconds)
 	case probeHandler.TCPSocket != nil:
 		portNum, err := getPortNumber(probeHandler.TCPSocket.Port, containerPorts)
 		if err != nil {
@@ -735,7 +753,14 @@ func probeToHealthConfig(probe *v1.Probe, containerPorts []v1.ContainerPort) (*m
 		if probeHandler.TCPSocket.Host != "" {
 			host = probeHandler.TCPSocket.Host
 		}
-		commandString = fmt.Sprintf("nc -z -v %s %d || %s", host, portNum, failureCmd)
+		test := []string{define.HealthConfigTestTCP, net.JoinHostPort(host, strconv.Itoa(portNum))}
+		return makeHealthCheckFromTest(test, probe.PeriodSeconds, probe.FailureThreshold, probe.TimeoutSeconds, probe.InitialDelaySeconds)
+	case probeHandler.GRPC != nil:
+		test := []string{define.HealthConfigTestGRPC, net.JoinHostPort(host, strconv.Itoa(int(probeHandler.GRPC.Port)))}
+		if probeHandler.GRPC.Service != nil && *probeHandler.GRPC.Service != "" {
+			test = append(test, *probeHandler.GRPC.Service)
+		}
+		return makeHealthCheckFromTest(test, probe.PeriodSeconds, probe.FailureThreshold, probe.TimeoutSeconds, probe.InitialDelaySeconds)
 	}
 	return makeHealthCheck(commandString, probe.PeriodSeconds, probe.FailureThreshold, probe.TimeoutSeconds, probe.InitialDelaySeconds)
 }
@@ -806,6 +831,24 @@ func setupStartupProbe(s *specgen.SpecGenerator, containerYAML v1.Container, res
 	return nil
 }
 
+func setupReadinessProbe(s *specgen.SpecGenerator, containerYAML v1.Container) error {
+	if containerYAML.ReadinessProbe == nil {
+		return nil
+	}
+	emptyHandler := v1.Handler{}
+	if containerYAML.ReadinessProbe.Handler != emptyHandler {
+		healthConfig, err := probeToHealthConfig(containerYAML.ReadinessProbe, containerYAML.Ports)
+		if err != nil {
+			return err
+		}
+		s.ReadinessConfig = &define.ReadinessCheck{
+			Schema2HealthConfig: *healthConfig,
+			Successes:           int(containerYAML.ReadinessProbe.SuccessThreshold),
+		}
+	}
+	return nil
+}
+
 func makeHealthCheck(inCmd string, interval int32, retries int32, timeout int32, startPeriod int32) (*manifest.Schema2HealthConfig, error) {
 	// Every healthcheck requires a command
 	if len(inCmd) == 0 {
@@ -827,8 +870,19 @@ func makeHealthCheck(inCmd string, interval int32, retries int32, timeout int32,
 			cmd = append([]string{define.HealthConfigTestCmd}, cmd...)
 		}
 	}
+	return makeHealthCheckFromTest(cmd, interval, retries, timeout, startPeriod)
+}
+
+// makeHealthCheckFromTest creates a healthcheck with the specified test and
+// the timings of a probe, applying the defaults of Kubernetes.
+func makeHealthCheckFromTest(test []string, interval int32, retries int32, timeout int32, startPeriod int32) (*manifest.Schema2HealthConfig, error) {
+	if define.IsHealthCheckProbe(test) {
+		if err := define.ValidateHealthCheckProbe(test); err != nil {
+			return nil, err
+		}
+	}
 	hc := manifest.Schema2HealthConfig{
-		Test: cmd,
+		Test: test,
 	}
 
 	if interval < 1 {
@@ -1142,52 +1196,66 @@ func envVarValue(env v1.EnvVar, opts *CtrSpecGenOptions) (*string, error) {
 	return &env.Value, nil
 }
 
-func envVarValueFieldRef(env v1.EnvVar, opts *CtrSpecGenOptions) (*string, error) {
-	fieldRef := env.ValueFrom.FieldRef
+var (
+	fieldPathLabelRegex      = regexp.MustCompile(`^metadata.labels\['(.+)'\]$`)
+	fieldPathAnnotationRegex = regexp.MustCompile(`^metadata.annotations\['(.+)'\]$`)
+)
 
-	fieldPathLabelPattern := `^metadata.labels\['(.+)'\]$`
-	fieldPathLabelRegex := regexp.MustCompile(fieldPathLabelPattern)
-	fieldPathAnnotationPattern := `^metadata.annotations\['(.+)'\]$`
-	fieldPathAnnotationRegex := regexp.MustCompile(fieldPathAnnotationPattern)
+func envVarValueFieldRef(env v1.EnvVar, opts *CtrSpecGenOptions) (*string, error) {
+	fieldPath := env.ValueFrom.FieldRef.FieldPath
+	if value, ok := podFieldRefValue(fieldPath, opts.PodName, opts.PodID, opts.Labels, opts.Annotations); ok {
+		return &value, nil
+	}
 
-	fieldPath := fieldRef.FieldPath
+	return nil, fmt.Errorf(
+		"can not set env %v. Reason: fieldPath %v is either not valid or not supported",
+		env.Name, fieldPath,
+	)
+}
 
+// podFieldRefValue returns the value of the field of the pod selected by
+// fieldPath.  It returns false if the field is not valid or not supported.
+func podFieldRefValue(fieldPath, podName, podID string, labels, annotations map[string]string) (string, bool) {
 	if fieldPath == "metadata.name" {
-		return &opts.PodName, nil
+		return podName, true
 	}
 	if fieldPath == "metadata.uid" {
-		return &opts.PodID, nil
+		return podID, true
 	}
 	fieldPathMatches := fieldPathLabelRegex.FindStringSubmatch(fieldPath)
 	if len(fieldPathMatches) == 2 { // 1 for entire regex and 1 for subexp
-		labelValue := opts.Labels[fieldPathMatches[1]] // not existent label is OK
-		return &labelValue, nil
+		return labels[fieldPathMatches[1]], true // not existent label is OK
 	}
 	fieldPathMatches = fieldPathAnnotationRegex.FindStringSubmatch(fieldPath)
 	if len(fieldPathMatches) == 2 { // 1 for entire regex and 1 for subexp
-		annotationValue := opts.Annotations[fieldPathMatches[1]] // not existent annotation is OK
-		return &annotationValue, nil
+		return annotations[fieldPathMatches[1]], true // not existent annotation is OK
 	}
-
-	return nil, fmt.Errorf(
-		"can not set env %v. Reason: fieldPath %v is either not valid or not supported",
-		env.Name, fieldPath,
-	)
+	return "", false
 }
 
 func envVarValueResourceFieldRef(env v1.EnvVar, opts *CtrSpecGenOptions) (*string, error) {
-	divisor := env.ValueFrom.ResourceFieldRef.Divisor
+	value, err := containerResourceFieldRefValue(env.ValueFrom.ResourceFieldRef, opts.Container)
+	if err != nil {
+		return nil, fmt.Errorf("can not set env %v. Reason: %w", env.Name, err)
+	}
+	return &value, nil
+}
+
+// containerResourceFieldRefValue returns the value of the resource of the
+// container selected by ref.
+func containerResourceFieldRefValue(ref *v1.ResourceFieldSelector, container v1.Container) (string, error) {
+	divisor := ref.Divisor
 	if divisor.IsZero() { // divisor not set, use default
 		divisor.Set(1)
 	}
 
-	resources, err := getContainerResources(opts.Container)
+	resources, err := getContainerResources(container)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	var value *resource.Quantity
-	resourceName := env.ValueFrom.ResourceFieldRef.Resource
+	resourceName := ref.Resource
 	var isValidDivisor bool
 
 	switch resourceName {
@@ -1204,24 +1272,16 @@ func envVarValueResourceFieldRef(env v1.EnvVar, opts *CtrSpecGenOptions) (*strin
 		value = resources.Requests.Cpu()
 		isValidDivisor = isCPUDivisor(divisor)
 	default:
-		return nil, fmt.Errorf(
-			"can not set env %v. Reason: resource %v is either not valid or not supported",
-			env.Name, resourceName,
-		)
+		return "", fmt.Errorf("resource %v is either not valid or not supported", resourceName)
 	}
 
 	if !isValidDivisor {
-		return nil, fmt.Errorf(
-			"can not set env %s. Reason: divisor value %s is not valid",
-			env.Name, divisor.String(),
-		)
+		return "", fmt.Errorf("divisor value %s is not valid", divisor.String())
 	}
 
 	// k8s rounds up the result to the nearest integer
 	intValue := int64(math.Ceil(value.AsApproximateFloat64() / divisor.AsApproximateFloat64()))
-	stringValue := strconv.FormatInt(intValue, 10)
-
-	return &stringValue, nil
+	return strconv.FormatInt(intValue, 10), nil
 }
 
 func isMemoryDivisor(divisor resource.Quantity) bool {
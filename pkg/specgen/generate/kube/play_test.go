@@ -4,9 +4,11 @@ package kube
 
 import (
 	"math"
+	"net"
 	"runtime"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/containers/common/pkg/secrets"
 	"github.com/containers/podman/v5/libpod/define"
@@ -238,6 +240,259 @@ func TestConfigMapVolumes(t *testing.T) {
 	}
 }
 
+func TestDownwardAPIVolumes(t *testing.T) {
+	podYAML := &v1.PodTemplateSpec{
+		ObjectMeta: v12.ObjectMeta{
+			Labels:      map[string]string{"app": "web", "tier": "front"},
+			Annotations: map[string]string{"note": "a \"quoted\" value"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "ctr",
+				Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("64Mi")},
+				},
+			}},
+		},
+	}
+	fieldRef := func(path, fieldPath string) v1.DownwardAPIVolumeFile {
+		return v1.DownwardAPIVolumeFile{Path: path, FieldRef: &v1.ObjectFieldSelector{FieldPath: fieldPath}}
+	}
+
+	tests := []struct {
+		name          string
+		items         []v1.DownwardAPIVolumeFile
+		errorMessage  string
+		expectedItems map[string][]byte
+	}{
+		{
+			"Metadata",
+			[]v1.DownwardAPIVolumeFile{
+				fieldRef("name", "metadata.name"),
+				fieldRef("namespace", "metadata.namespace"),
+				fieldRef("labels", "metadata.labels"),
+				fieldRef("annotations", "metadata.annotations"),
+				fieldRef("meta/app", "metadata.labels['app']"),
+				fieldRef("meta/missing", "metadata.annotations['missing']"),
+			},
+			"",
+			map[string][]byte{
+				"name":         []byte("mypod"),
+				"namespace":    []byte("default"),
+				"labels":       []byte("app=\"web\"\ntier=\"front\""),
+				"annotations":  []byte(`note="a \"quoted\" value"`),
+				"meta/app":     []byte("web"),
+				"meta/missing": []byte(""),
+			},
+		},
+		{
+			"ResourceFieldRef",
+			[]v1.DownwardAPIVolumeFile{{
+				Path: "memory",
+				ResourceFieldRef: &v1.ResourceFieldSelector{
+					ContainerName: "ctr",
+					Resource:      "limits.memory",
+					Divisor:       resource.MustParse("1Mi"),
+				},
+			}},
+			"",
+			map[string][]byte{"memory": []byte("64")},
+		},
+		{
+			"ResourceFieldRefNoSuchContainer",
+			[]v1.DownwardAPIVolumeFile{{
+				Path:             "memory",
+				ResourceFieldRef: &v1.ResourceFieldSelector{ContainerName: "other", Resource: "limits.memory"},
+			}},
+			`file "memory": no such container "other"`,
+			nil,
+		},
+		{
+			"UnsupportedFieldPath",
+			[]v1.DownwardAPIVolumeFile{fieldRef("ip", "status.podIP")},
+			`file "ip": fieldPath status.podIP is either not valid or not supported`,
+			nil,
+		},
+		{
+			"PodUID",
+			[]v1.DownwardAPIVolumeFile{fieldRef("uid", "metadata.uid")},
+			`file "uid": fieldPath metadata.uid is not supported in volumes`,
+			nil,
+		},
+		{
+			"InvalidPath",
+			[]v1.DownwardAPIVolumeFile{fieldRef("../name", "metadata.name")},
+			`invalid path "../name": must not contain '..'`,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source := &v1.DownwardAPIVolumeSource{Items: test.items}
+			result, err := VolumeFromDownwardAPI(source, "mypod", podYAML, "podinfo")
+			if test.errorMessage == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, KubeVolumeTypeDownwardAPI, result.Type)
+				assert.Equal(t, "mypod_podinfo", result.Source)
+				assert.Equal(t, test.expectedItems, result.Items)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, test.errorMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestDownwardAPIVolumeOwnerLabels(t *testing.T) {
+	podYAML := &v1.PodTemplateSpec{
+		ObjectMeta: v12.ObjectMeta{
+			Labels: map[string]string{
+				"app":                     "web",
+				define.KubeOwnerKindLabel: "Deployment",
+				define.KubeOwnerNameLabel: "web",
+			},
+		},
+	}
+	source := &v1.DownwardAPIVolumeSource{Items: []v1.DownwardAPIVolumeFile{
+		{Path: "labels", FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+		{Path: "kind", FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels['" + define.KubeOwnerKindLabel + "']"}},
+	}}
+	result, err := VolumeFromDownwardAPI(source, "web-pod-0", podYAML, "podinfo")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"labels": []byte(`app="web"`), "kind": []byte("")}, result.Items)
+	// The labels of the pod itself are left alone.
+	assert.Len(t, podYAML.Labels, 3)
+}
+
+func TestProjectedVolumes(t *testing.T) {
+	d := t.TempDir()
+	secretsManager := createSecrets(t, d)
+	yes := true
+	mode := int32(0400)
+	podYAML := &v1.PodTemplateSpec{
+		ObjectMeta: v12.ObjectMeta{
+			Labels: map[string]string{"app": "web"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		source        v1.ProjectedVolumeSource
+		errorMessage  string
+		expectedItems map[string][]byte
+	}{
+		{
+			"AllSources",
+			v1.ProjectedVolumeSource{
+				DefaultMode: &mode,
+				Sources: []v1.VolumeProjection{
+					{ConfigMap: &v1.ConfigMapProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "multi-item"},
+						Items:                []v1.KeyToPath{{Key: "foo", Path: "config/foo"}},
+					}},
+					{Secret: &v1.SecretProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "foo"},
+						Items:                []v1.KeyToPath{{Key: "myvar", Path: "secret/myvar"}},
+					}},
+					{DownwardAPI: &v1.DownwardAPIProjection{
+						Items: []v1.DownwardAPIVolumeFile{{Path: "labels", FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels"}}},
+					}},
+				},
+			},
+			"",
+			map[string][]byte{
+				"config/foo":   []byte("bar"),
+				"secret/myvar": []byte("foo"),
+				"labels":       []byte(`app="web"`),
+			},
+		},
+		{
+			"OptionalMissing",
+			v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{ConfigMap: &v1.ConfigMapProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "fizz"},
+						Optional:             &yes,
+					}},
+					{Secret: &v1.SecretProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "fizz"},
+						Optional:             &yes,
+					}},
+					{ConfigMap: &v1.ConfigMapProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "bar"},
+					}},
+				},
+			},
+			"",
+			map[string][]byte{"myvar": []byte("bar")},
+		},
+		{
+			"Missing",
+			v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{ConfigMap: &v1.ConfigMapProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "fizz"},
+					}},
+				},
+			},
+			`no such ConfigMap "fizz"`,
+			nil,
+		},
+		{
+			"Conflict",
+			v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{ConfigMap: &v1.ConfigMapProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "bar"},
+					}},
+					{Secret: &v1.SecretProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: "bar"},
+					}},
+				},
+			},
+			`conflicting projections of file "myvar"`,
+			nil,
+		},
+		{
+			"ServiceAccountToken",
+			v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{ServiceAccountToken: &v1.ServiceAccountTokenProjection{Path: "token"}},
+				},
+			},
+			"serviceAccountToken projections are not supported",
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := VolumeFromProjected(&test.source, configMapList, secretsManager, "mypod", podYAML, "all")
+			if test.errorMessage == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, KubeVolumeTypeProjected, result.Type)
+				assert.Equal(t, "mypod_all", result.Source)
+				assert.Equal(t, test.expectedItems, result.Items)
+				if test.source.DefaultMode != nil {
+					assert.Equal(t, *test.source.DefaultMode, result.DefaultMode)
+				} else {
+					assert.Equal(t, v1.ProjectedVolumeSourceDefaultMode, result.DefaultMode)
+				}
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, test.errorMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestPodVolumeName(t *testing.T) {
+	// The volumes of different pods must not collide.
+	assert.NotEqual(t, PodVolumeName("a-b", "c"), PodVolumeName("a", "b-c"))
+	assert.Equal(t, "mypod_podinfo", PodVolumeName("mypod", "podinfo"))
+}
+
 func TestEnvVarsFrom(t *testing.T) {
 	d := t.TempDir()
 	secretsManager := createSecrets(t, d)
@@ -1395,13 +1650,95 @@ func TestTCPLivenessProbe(t *testing.T) {
 			assert.Equal(t, err == nil, test.succeed)
 			if err == nil {
 				assert.Equal(t, int(test.specGenerator.ContainerHealthCheckConfig.HealthCheckOnFailureAction), define.HealthCheckOnFailureActionRestart)
-				assert.Contains(t, test.specGenerator.ContainerHealthCheckConfig.HealthConfig.Test, test.expectedHost)
-				assert.Contains(t, test.specGenerator.ContainerHealthCheckConfig.HealthConfig.Test, test.expectedPort)
+				assert.Equal(t, []string{define.HealthConfigTestTCP, net.JoinHostPort(test.expectedHost, test.expectedPort)}, test.specGenerator.ContainerHealthCheckConfig.HealthConfig.Test)
 			}
 		})
 	}
 }
 
+func TestHTTPLivenessProbe(t *testing.T) {
+	specGenerator := specgen.SpecGenerator{}
+	container := v1.Container{
+		LivenessProbe: &v1.Probe{
+			Handler: v1.Handler{
+				HTTPGet: &v1.HTTPGetAction{
+					Scheme: v1.URISchemeHTTPS,
+					Port:   intstr.FromInt(8443),
+					Path:   "/healthz",
+					HTTPHeaders: []v1.HTTPHeader{
+						{Name: "X-Probe", Value: "yes"},
+					},
+				},
+			},
+		},
+	}
+	err := setupLivenessProbe(&specGenerator, container, "always")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{define.HealthConfigTestHTTP, "https://localhost:8443/healthz", "X-Probe: yes"}, specGenerator.ContainerHealthCheckConfig.HealthConfig.Test)
+}
+
+func TestGRPCLivenessProbe(t *testing.T) {
+	service := "my.Service"
+	tests := []struct {
+		name     string
+		action   v1.GRPCAction
+		expected []string
+	}{
+		{
+			"GRPCLivenessProbe",
+			v1.GRPCAction{Port: 9000},
+			[]string{define.HealthConfigTestGRPC, "localhost:9000"},
+		},
+		{
+			"GRPCLivenessProbeWithService",
+			v1.GRPCAction{Port: 9000, Service: &service},
+			[]string{define.HealthConfigTestGRPC, "localhost:9000", service},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			specGenerator := specgen.SpecGenerator{}
+			container := v1.Container{
+				LivenessProbe: &v1.Probe{
+					Handler: v1.Handler{GRPC: &test.action},
+				},
+			}
+			err := setupLivenessProbe(&specGenerator, container, "always")
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, specGenerator.ContainerHealthCheckConfig.HealthConfig.Test)
+		})
+	}
+}
+
+func TestReadinessProbe(t *testing.T) {
+	specGenerator := specgen.SpecGenerator{}
+	container := v1.Container{
+		Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+		ReadinessProbe: &v1.Probe{
+			Handler: v1.Handler{
+				HTTPGet: &v1.HTTPGetAction{
+					Port: intstr.FromString("http"),
+					Path: "/ready",
+				},
+			},
+			PeriodSeconds:    5,
+			SuccessThreshold: 2,
+		},
+	}
+	err := setupReadinessProbe(&specGenerator, container)
+	assert.NoError(t, err)
+	readiness := specGenerator.ContainerHealthCheckConfig.ReadinessConfig
+	assert.NotNil(t, readiness)
+	assert.Equal(t, []string{define.HealthConfigTestHTTP, "http://localhost:8080/ready"}, readiness.Test)
+	assert.Equal(t, 5*time.Second, readiness.Interval)
+	assert.Equal(t, 3, readiness.Retries)
+	assert.Equal(t, 2, readiness.Successes)
+	// A readiness probe does not define a healthcheck.
+	assert.Nil(t, specGenerator.ContainerHealthCheckConfig.HealthConfig)
+	assert.Nil(t, specGenerator.ContainerHealthCheckConfig.StartupHealthConfig)
+}
+
 func TestDeviceResource(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -6,11 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/containers/common/pkg/parse"
 	"github.com/containers/common/pkg/secrets"
 	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/define"
 	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
 	"github.com/containers/storage/pkg/fileutils"
 
@@ -37,6 +42,8 @@ const (
 	KubeVolumeTypeEmptyDir
 	KubeVolumeTypeEmptyDirTmpfs
 	KubeVolumeTypeImage
+	KubeVolumeTypeDownwardAPI
+	KubeVolumeTypeProjected
 )
 
 type KubeVolume struct {
@@ -45,7 +52,8 @@ type KubeVolume struct {
 	// Path for bind mount, volume name for named volume or image name for image volume
 	Source string
 	// Items to add to a named volume created where the key is the file name and the value is the data
-	// This is only used when there are volumes in the yaml that refer to a configmap
+	// This is only used when there are volumes in the yaml that refer to a configmap, a secret, the
+	// downward API or that are projected
 	// Example: if configmap has data "SPECIAL_LEVEL: very" then the file name is "SPECIAL_LEVEL" and the
 	// data in that file is "very".
 	Items map[string][]byte
@@ -288,8 +296,204 @@ func VolumeFromImage(imageVolumeSource *v1.ImageVolumeSource, name string) (*Kub
 	}, nil
 }
 
+// PodVolumeName returns the name of the named volume holding the files of a
+// downwardAPI or projected volume of a pod.  Kubernetes names cannot contain
+// an underscore, which keeps the names of different pods apart.
+func PodVolumeName(podName, volName string) string {
+	return podName + "_" + volName
+}
+
+// formatDownwardAPIMap formats labels or annotations as Kubernetes does in
+// downward API files, one key="value" pair per line sorted by key.
+func formatDownwardAPIMap(m map[string]string) string {
+	lines := make([]string, 0, len(m))
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		lines = append(lines, fmt.Sprintf("%v=%q", key, m[key]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// templateLabels returns the labels of the pod template without the labels
+// kube play adds to the pods of Deployments and StatefulSets.
+func templateLabels(labels map[string]string) map[string]string {
+	if _, ok := labels[define.KubeOwnerKindLabel]; !ok {
+		return labels
+	}
+	labels = maps.Clone(labels)
+	delete(labels, define.KubeOwnerKindLabel)
+	delete(labels, define.KubeOwnerNameLabel)
+	return labels
+}
+
+// validateVolumeItemPath makes sure the path of a file of a volume stays
+// within the volume.
+func validateVolumeItemPath(path string) error {
+	if path == "" || filepath.IsAbs(path) {
+		return fmt.Errorf("invalid path %q: must be a relative path", path)
+	}
+	if slices.Contains(strings.Split(path, "/"), "..") || strings.HasPrefix(path, "..") {
+		return fmt.Errorf("invalid path %q: must not contain '..'", path)
+	}
+	return nil
+}
+
+// downwardAPIFileData returns the data of a file of a downward API volume.
+func downwardAPIFileData(item v1.DownwardAPIVolumeFile, podName string, podYAML *v1.PodTemplateSpec) ([]byte, error) {
+	switch {
+	case item.FieldRef != nil && item.ResourceFieldRef != nil:
+		return nil, fmt.Errorf("file %q: fieldRef and resourceFieldRef are mutually exclusive", item.Path)
+	case item.FieldRef != nil:
+		labels := templateLabels(podYAML.ObjectMeta.Labels)
+		annotations := podYAML.ObjectMeta.Annotations
+		switch fieldPath := item.FieldRef.FieldPath; fieldPath {
+		case "metadata.labels":
+			return []byte(formatDownwardAPIMap(labels)), nil
+		case "metadata.annotations":
+			return []byte(formatDownwardAPIMap(annotations)), nil
+		case "metadata.namespace":
+			namespace := podYAML.ObjectMeta.Namespace
+			if namespace == "" {
+				namespace = "default"
+			}
+			return []byte(namespace), nil
+		case "metadata.uid":
+			// The volume is populated before the pod is created.
+			return nil, fmt.Errorf("file %q: fieldPath %v is not supported in volumes", item.Path, fieldPath)
+		default:
+			value, ok := podFieldRefValue(fieldPath, podName, "", labels, annotations)
+			if !ok {
+				return nil, fmt.Errorf("file %q: fieldPath %v is either not valid or not supported", item.Path, fieldPath)
+			}
+			return []byte(value), nil
+		}
+	case item.ResourceFieldRef != nil:
+		containerName := item.ResourceFieldRef.ContainerName
+		if containerName == "" {
+			return nil, fmt.Errorf("file %q: resourceFieldRef requires a containerName", item.Path)
+		}
+		for _, container := range slices.Concat(podYAML.Spec.InitContainers, podYAML.Spec.Containers) {
+			if container.Name != containerName {
+				continue
+			}
+			value, err := containerResourceFieldRefValue(item.ResourceFieldRef, container)
+			if err != nil {
+				return nil, fmt.Errorf("file %q: %w", item.Path, err)
+			}
+			return []byte(value), nil
+		}
+		return nil, fmt.Errorf("file %q: no such container %q", item.Path, containerName)
+	default:
+		return nil, fmt.Errorf("file %q: either fieldRef or resourceFieldRef must be set", item.Path)
+	}
+}
+
+// downwardAPIItems returns the files of a downward API volume.
+func downwardAPIItems(items []v1.DownwardAPIVolumeFile, podName string, podYAML *v1.PodTemplateSpec) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(items))
+	for _, item := range items {
+		if err := validateVolumeItemPath(item.Path); err != nil {
+			return nil, err
+		}
+		data, err := downwardAPIFileData(item, podName, podYAML)
+		if err != nil {
+			return nil, err
+		}
+		files[item.Path] = data
+	}
+	return files, nil
+}
+
+// VolumeFromDownwardAPI creates a kube volume exposing information about the
+// pod as files.  The volume is specific to the pod, its files are generated
+// whenever the pod is played.
+func VolumeFromDownwardAPI(downwardAPIVolumeSource *v1.DownwardAPIVolumeSource, podName string, podYAML *v1.PodTemplateSpec, volName string) (*KubeVolume, error) {
+	kv := &KubeVolume{
+		Type:        KubeVolumeTypeDownwardAPI,
+		Source:      PodVolumeName(podName, volName),
+		DefaultMode: v1.DownwardAPIVolumeSourceDefaultMode,
+	}
+	validMode, err := isValidDefaultMode(downwardAPIVolumeSource.DefaultMode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DefaultMode for downwardAPI volume: %w", err)
+	}
+	if validMode {
+		kv.DefaultMode = *downwardAPIVolumeSource.DefaultMode
+	}
+
+	kv.Items, err = downwardAPIItems(downwardAPIVolumeSource.Items, podName, podYAML)
+	if err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+// VolumeFromProjected creates a kube volume combining the files of configmaps,
+// secrets and the downward API.  The volume is specific to the pod, its files
+// are generated whenever the pod is played.
+func VolumeFromProjected(projectedVolumeSource *v1.ProjectedVolumeSource, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, podName string, podYAML *v1.PodTemplateSpec, volName string) (*KubeVolume, error) {
+	kv := &KubeVolume{
+		Type:        KubeVolumeTypeProjected,
+		Source:      PodVolumeName(podName, volName),
+		Items:       map[string][]byte{},
+		DefaultMode: v1.ProjectedVolumeSourceDefaultMode,
+	}
+	validMode, err := isValidDefaultMode(projectedVolumeSource.DefaultMode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DefaultMode for projected volume: %w", err)
+	}
+	if validMode {
+		kv.DefaultMode = *projectedVolumeSource.DefaultMode
+	}
+
+	for _, source := range projectedVolumeSource.Sources {
+		var items map[string][]byte
+		switch {
+		case source.ConfigMap != nil:
+			cmVolume, err := VolumeFromConfigMap(&v1.ConfigMapVolumeSource{
+				LocalObjectReference: source.ConfigMap.LocalObjectReference,
+				Items:                source.ConfigMap.Items,
+				Optional:             source.ConfigMap.Optional,
+			}, configMaps)
+			if err != nil {
+				return nil, err
+			}
+			items = cmVolume.Items
+		case source.Secret != nil:
+			secretVolume, err := VolumeFromSecret(&v1.SecretVolumeSource{
+				SecretName: source.Secret.Name,
+				Items:      source.Secret.Items,
+				Optional:   source.Secret.Optional,
+			}, secretsManager)
+			if err != nil {
+				return nil, err
+			}
+			items = secretVolume.Items
+		case source.DownwardAPI != nil:
+			items, err = downwardAPIItems(source.DownwardAPI.Items, podName, podYAML)
+			if err != nil {
+				return nil, err
+			}
+		case source.ServiceAccountToken != nil:
+			return nil, errors.New("serviceAccountToken projections are not supported")
+		default:
+			return nil, errors.New("projection without a source")
+		}
+
+		for path, data := range items {
+			if err := validateVolumeItemPath(path); err != nil {
+				return nil, err
+			}
+			if _, ok := kv.Items[path]; ok {
+				return nil, fmt.Errorf("conflicting projections of file %q", path)
+			}
+			kv.Items[path] = data
+		}
+	}
+	return kv, nil
+}
+
 // Create a KubeVolume from one of the supported VolumeSource
-func VolumeFromSource(volumeSource v1.VolumeSource, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, volName, mountLabel string) (*KubeVolume, error) {
+func VolumeFromSource(volumeSource v1.VolumeSource, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, podName string, podYAML *v1.PodTemplateSpec, volName, mountLabel string) (*KubeVolume, error) {
 	switch {
 	case volumeSource.HostPath != nil:
 		return VolumeFromHostPath(volumeSource.HostPath, mountLabel)
@@ -303,17 +507,21 @@ func VolumeFromSource(volumeSource v1.VolumeSource, configMaps []v1.ConfigMap, s
 		return VolumeFromEmptyDir(volumeSource.EmptyDir, volName)
 	case volumeSource.Image != nil:
 		return VolumeFromImage(volumeSource.Image, volName)
+	case volumeSource.DownwardAPI != nil:
+		return VolumeFromDownwardAPI(volumeSource.DownwardAPI, podName, podYAML, volName)
+	case volumeSource.Projected != nil:
+		return VolumeFromProjected(volumeSource.Projected, configMaps, secretsManager, podName, podYAML, volName)
 	default:
-		return nil, errors.New("HostPath, ConfigMap, EmptyDir, Secret, and PersistentVolumeClaim are currently the only supported VolumeSource")
+		return nil, errors.New("HostPath, ConfigMap, EmptyDir, Secret, PersistentVolumeClaim, Image, DownwardAPI and Projected are currently the only supported VolumeSource")
 	}
 }
 
-// Create a map of volume name to KubeVolume
-func InitializeVolumes(specVolumes []v1.Volume, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, mountLabel string) (map[string]*KubeVolume, error) {
+// Create a map of volume name to KubeVolume for the volumes of a pod
+func InitializeVolumes(podName string, podYAML *v1.PodTemplateSpec, configMaps []v1.ConfigMap, secretsManager *secrets.SecretsManager, mountLabel string) (map[string]*KubeVolume, error) {
 	volumes := make(map[string]*KubeVolume)
 
-	for _, specVolume := range specVolumes {
-		volume, err := VolumeFromSource(specVolume.VolumeSource, configMaps, secretsManager, specVolume.Name, mountLabel)
+	for _, specVolume := range podYAML.Spec.Volumes {
+		volume, err := VolumeFromSource(specVolume.VolumeSource, configMaps, secretsManager, podName, podYAML, specVolume.Name, mountLabel)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create volume %q: %w", specVolume.Name, err)
 		}
@@ -139,6 +139,10 @@ func createPodOptions(p *specgen.PodSpecGenerator) ([]libpod.PodCreateOption, er
 		options = append(options, libpod.WithServiceContainer(p.ServiceContainerID))
 	}
 
+	if p.CronJob != nil {
+		options = append(options, libpod.WithPodCronJob(p.CronJob))
+	}
+
 	if len(p.CgroupParent) > 0 {
 		options = append(options, libpod.WithPodCgroupParent(p.CgroupParent))
 	}
@@ -4,6 +4,7 @@ import (
 	"net"
 
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
 	storageTypes "github.com/containers/storage/types"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -243,6 +244,8 @@ type PodSpecGenerator struct {
 
 	// The ID of the pod's service container.
 	ServiceContainerID string `json:"serviceContainerID,omitempty"`
+	// The schedule of the pod if it runs a Kubernetes CronJob.
+	CronJob *define.PodCronJob `json:"cronJob,omitempty"`
 }
 
 type PodResourceConfig struct {
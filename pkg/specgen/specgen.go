@@ -604,6 +604,9 @@ type ContainerHealthCheckConfig struct {
 	// Requires that HealthConfig be set.
 	// Optional.
 	StartupHealthConfig *define.StartupHealthCheck `json:"startupHealthConfig,omitempty"`
+	// Readiness check for a container.
+	// Optional.
+	ReadinessConfig *define.ReadinessCheck `json:"readinessConfig,omitempty"`
 	// HealthLogDestination defines the destination where the log is stored.
 	// TODO (6.0): In next major release convert it to pointer and use omitempty
 	HealthLogDestination string `json:"healthLogDestination"`
@@ -350,11 +350,23 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		}
 	}
 
-	if len(c.HealthCmd) > 0 {
+	healthCmd := c.HealthCmd
+	probeCmd, err := MakeHealthCheckProbeCmd(c.HealthHTTP, c.HealthTCP, c.HealthGRPC)
+	if err != nil {
+		return err
+	}
+	if probeCmd != "" {
+		if len(healthCmd) > 0 {
+			return errors.New("cannot specify both --health-cmd and --health-http, --health-tcp or --health-grpc")
+		}
+		healthCmd = probeCmd
+	}
+
+	if len(healthCmd) > 0 {
 		if c.NoHealthCheck {
 			return errors.New("cannot specify both --no-healthcheck and --health-cmd")
 		}
-		s.HealthConfig, err = MakeHealthCheckFromCli(c.HealthCmd, c.HealthInterval, c.HealthRetries, c.HealthTimeout, c.HealthStartPeriod, false)
+		s.HealthConfig, err = MakeHealthCheckFromCli(healthCmd, c.HealthInterval, c.HealthRetries, c.HealthTimeout, c.HealthStartPeriod, false)
 		if err != nil {
 			return err
 		}
@@ -971,7 +983,14 @@ func MakeHealthCheckFromCli(inCmd, interval string, retries uint, timeout, start
 	}
 
 	var concat string
-	if strings.ToUpper(cmdArr[0]) == define.HealthConfigTestCmd || strings.ToUpper(cmdArr[0]) == define.HealthConfigTestNone { // this is for compat, we are already split properly for most compat cases
+	if define.IsHealthCheckProbe(cmdArr) { // probes performed by Podman, see MakeHealthCheckProbeCmd
+		if !isArr {
+			cmdArr = strings.Fields(inCmd)
+		}
+		if err := define.ValidateHealthCheckProbe(cmdArr); err != nil {
+			return nil, err
+		}
+	} else if strings.ToUpper(cmdArr[0]) == define.HealthConfigTestCmd || strings.ToUpper(cmdArr[0]) == define.HealthConfigTestNone { // this is for compat, we are already split properly for most compat cases
 		cmdArr = strings.Fields(inCmd)
 	} else if strings.ToUpper(cmdArr[0]) != define.HealthConfigTestCmdShell { // this is for podman side of things, won't contain the keywords
 		if isArr && len(cmdArr) > 1 { // an array of consecutive commands
@@ -1029,6 +1048,56 @@ func MakeHealthCheckFromCli(inCmd, interval string, retries uint, timeout, start
 	return &hc, nil
 }
 
+// MakeHealthCheckProbeCmd returns the healthcheck command of the probe
+// specified via --health-http, --health-tcp or --health-grpc in the form
+// accepted by MakeHealthCheckFromCli.  An empty string is returned if no probe
+// is specified.
+func MakeHealthCheckProbeCmd(httpURL, tcpAddr, grpcAddr string) (string, error) {
+	var test []string
+	set := 0
+	if httpURL != "" {
+		test = []string{define.HealthConfigTestHTTP, httpURL}
+		set++
+	}
+	if tcpAddr != "" {
+		test = []string{define.HealthConfigTestTCP, probeAddress(tcpAddr)}
+		set++
+	}
+	if grpcAddr != "" {
+		addr, service, _ := strings.Cut(grpcAddr, "/")
+		test = []string{define.HealthConfigTestGRPC, probeAddress(addr)}
+		if service != "" {
+			test = append(test, service)
+		}
+		set++
+	}
+	switch set {
+	case 0:
+		return "", nil
+	case 1:
+	default:
+		return "", errors.New("--health-http, --health-tcp and --health-grpc are mutually exclusive")
+	}
+
+	if err := define.ValidateHealthCheckProbe(test); err != nil {
+		return "", err
+	}
+	cmd, err := json.Marshal(test)
+	if err != nil {
+		return "", err
+	}
+	return string(cmd), nil
+}
+
+// probeAddress defaults the host of a probe address to localhost, so that a
+// port is sufficient to probe the container.
+func probeAddress(addr string) string {
+	if !strings.Contains(addr, ":") {
+		return "localhost:" + addr
+	}
+	return addr
+}
+
 func parseWeightDevices(weightDevs []string) (map[string]specs.LinuxWeightDevice, error) {
 	wd := make(map[string]specs.LinuxWeightDevice)
 	for _, dev := range weightDevs {
@@ -230,3 +230,52 @@ func TestFillOutSpecGenRecorsUserNs(t *testing.T) {
 	assert.True(t, ok, "UserNsAnnotation is set")
 	assert.Equal(t, "keep-id", v, "UserNsAnnotation is keep-id")
 }
+
+func TestMakeHealthCheckProbeCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		http    string
+		tcp     string
+		grpc    string
+		cmd     string
+		wantErr string
+	}{
+		{name: "none"},
+		{name: "http", http: "http://localhost:8080/healthz", cmd: `["HTTP","http://localhost:8080/healthz"]`},
+		{name: "http invalid scheme", http: "ftp://localhost/", wantErr: "scheme must be http or https"},
+		{name: "tcp port only", tcp: "5432", cmd: `["TCP","localhost:5432"]`},
+		{name: "tcp host and port", tcp: "127.0.0.1:5432", cmd: `["TCP","127.0.0.1:5432"]`},
+		{name: "grpc", grpc: "9000", cmd: `["GRPC","localhost:9000"]`},
+		{name: "grpc service", grpc: "localhost:9000/my.Service", cmd: `["GRPC","localhost:9000","my.Service"]`},
+		{name: "mutually exclusive", http: "http://localhost/", tcp: "80", wantErr: "mutually exclusive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := MakeHealthCheckProbeCmd(tt.http, tt.tcp, tt.grpc)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.cmd, cmd)
+		})
+	}
+}
+
+func TestMakeHealthCheckFromCliProbe(t *testing.T) {
+	hc, err := MakeHealthCheckFromCli(`["HTTP","http://localhost:8080/","X-Probe: yes"]`, "30s", 3, "30s", "0s", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"HTTP", "http://localhost:8080/", "X-Probe: yes"}, hc.Test)
+
+	hc, err = MakeHealthCheckFromCli("TCP localhost:5432", "30s", 3, "30s", "0s", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TCP", "localhost:5432"}, hc.Test)
+
+	_, err = MakeHealthCheckFromCli(`["TCP","5432"]`, "30s", 3, "30s", "0s", false)
+	assert.ErrorContains(t, err, "invalid TCP healthcheck address")
+
+	// Lowercase keywords are commands, not probes
+	hc, err = MakeHealthCheckFromCli("tcp localhost:5432", "30s", 3, "30s", "0s", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{define.HealthConfigTestCmdShell, "tcp localhost:5432"}, hc.Test)
+}
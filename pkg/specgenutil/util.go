@@ -267,7 +267,37 @@ func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *conf
 	// user of the API.
 	// As such, provide a way to specify a path to Podman, so we can
 	// still invoke a cleanup process.
+	command, err := CreatePodmanCommandArgs(storageConfig, config, syslog)
+	if err != nil {
+		return nil, err
+	}
+
+	// --stopped-only is used to ensure we only cleanup stopped containers and do not race
+	// against other processes that did a cleanup() + init() again before we had the chance to run
+	command = append(command, []string{"container", "cleanup", "--stopped-only"}...)
+
+	if rm {
+		command = append(command, "--rm")
+	}
+
+	if rmi {
+		command = append(command, "--rmi")
+	}
+
+	// This has to be absolutely last, to ensure that the exec session ID
+	// will be added after it by Libpod.
+	if exec {
+		command = append(command, "--exec")
+	}
 
+	return command, nil
+}
+
+// CreatePodmanCommandArgs returns the path to the Podman binary along with
+// the global options needed for a Podman process spawned by Libpod (e.g., the
+// cleanup process) to use the same storage and configuration as the caller.
+// The arguments of the subcommand must be appended by the caller.
+func CreatePodmanCommandArgs(storageConfig storageTypes.StoreOptions, config *config.Config, syslog bool) ([]string, error) {
 	podmanPath, err := os.Executable()
 	if err != nil {
 		return nil, err
@@ -314,23 +344,5 @@ func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *conf
 		command = append(command, "--module", module)
 	}
 
-	// --stopped-only is used to ensure we only cleanup stopped containers and do not race
-	// against other processes that did a cleanup() + init() again before we had the chance to run
-	command = append(command, []string{"container", "cleanup", "--stopped-only"}...)
-
-	if rm {
-		command = append(command, "--rm")
-	}
-
-	if rmi {
-		command = append(command, "--rmi")
-	}
-
-	// This has to be absolutely last, to ensure that the exec session ID
-	// will be added after it by Libpod.
-	if exec {
-		command = append(command, "--exec")
-	}
-
 	return command, nil
 }
@@ -38,6 +38,7 @@ const (
 	VolumeGroup     = "Volume"
 	ImageGroup      = "Image"
 	BuildGroup      = "Build"
+	ArtifactGroup   = "Artifact"
 	QuadletGroup    = "Quadlet"
 	XContainerGroup = "X-Container"
 	XKubeGroup      = "X-Kube"
@@ -46,6 +47,7 @@ const (
 	XVolumeGroup    = "X-Volume"
 	XImageGroup     = "X-Image"
 	XBuildGroup     = "X-Build"
+	XArtifactGroup  = "X-Artifact"
 	XQuadletGroup   = "X-Quadlet"
 )
 
@@ -62,6 +64,7 @@ const (
 	KeyAllTags               = "AllTags"
 	KeyAnnotation            = "Annotation"
 	KeyArch                  = "Arch"
+	KeyArtifact              = "Artifact"
 	KeyAuthFile              = "AuthFile"
 	KeyAutoUpdate            = "AutoUpdate"
 	KeyCertDir               = "CertDir"
@@ -95,6 +98,8 @@ const (
 	KeyGroup                 = "Group"
 	KeyGroupAdd              = "GroupAdd"
 	KeyHealthCmd             = "HealthCmd"
+	KeyHealthGrpc            = "HealthGrpc"
+	KeyHealthHttp            = "HealthHttp"
 	KeyHealthInterval        = "HealthInterval"
 	KeyHealthLogDestination  = "HealthLogDestination"
 	KeyHealthMaxLogCount     = "HealthMaxLogCount"
@@ -107,6 +112,7 @@ const (
 	KeyHealthStartupRetries  = "HealthStartupRetries"
 	KeyHealthStartupSuccess  = "HealthStartupSuccess"
 	KeyHealthStartupTimeout  = "HealthStartupTimeout"
+	KeyHealthTcp             = "HealthTcp"
 	KeyHealthTimeout         = "HealthTimeout"
 	KeyHostName              = "HostName"
 	KeyImage                 = "Image"
@@ -217,6 +223,7 @@ var (
 		".image":     1,
 		".build":     3,
 		".pod":       5,
+		".artifact":  1,
 	}
 
 	URL            = regexp.Delayed(`^((https?)|(git)://)|(github\.com/).+$`)
@@ -268,6 +275,8 @@ var (
 				KeyGroup:                 true,
 				KeyGroupAdd:              true,
 				KeyHealthCmd:             true,
+				KeyHealthGrpc:            true,
+				KeyHealthHttp:            true,
 				KeyHealthInterval:        true,
 				KeyHealthOnFailure:       true,
 				KeyHealthLogDestination:  true,
@@ -280,6 +289,7 @@ var (
 				KeyHealthStartupRetries:  true,
 				KeyHealthStartupSuccess:  true,
 				KeyHealthStartupTimeout:  true,
+				KeyHealthTcp:             true,
 				KeyHealthTimeout:         true,
 				KeyHostName:              true,
 				KeyIP6:                   true,
@@ -463,6 +473,24 @@ var (
 				KeyVolume:               true,
 			},
 		},
+		ArtifactGroup: {
+			GroupName:  ArtifactGroup,
+			XGroupName: XArtifactGroup,
+			SupportedKeys: map[string]bool{
+				KeyArtifact:             true,
+				KeyAuthFile:             true,
+				KeyCertDir:              true,
+				KeyContainersConfModule: true,
+				KeyCreds:                true,
+				KeyDecryptionKey:        true,
+				KeyGlobalArgs:           true,
+				KeyPodmanArgs:           true,
+				KeyRetry:                true,
+				KeyRetryDelay:           true,
+				KeyServiceName:          true,
+				KeyTLSVerify:            true,
+			},
+		},
 		PodGroup: {
 			GroupName:  PodGroup,
 			XGroupName: XPodGroup,
@@ -1332,6 +1360,54 @@ func ConvertImage(image *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isU
 	return service, nil
 }
 
+func ConvertArtifact(artifact *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUser bool) (*parser.UnitFile, error) {
+	service, unitInfo, err := initServiceUnitFile(artifact, isUser, unitsInfoMap, ArtifactGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactName, ok := artifact.Lookup(ArtifactGroup, KeyArtifact)
+	if !ok || len(artifactName) == 0 {
+		return nil, fmt.Errorf("no Artifact key specified")
+	}
+
+	podman := createBasePodmanCommand(artifact, ArtifactGroup)
+
+	podman.add("artifact", "pull")
+
+	stringKeys := map[string]string{
+		KeyAuthFile:   "--authfile",
+		KeyCertDir:    "--cert-dir",
+		KeyCreds:      "--creds",
+		KeyRetry:      "--retry",
+		KeyRetryDelay: "--retry-delay",
+	}
+	lookupAndAddString(artifact, ArtifactGroup, stringKeys, podman)
+
+	allStringKeys := map[string]string{
+		KeyDecryptionKey: "--decryption-key",
+	}
+	lookupAndAddAllStrings(artifact, ArtifactGroup, allStringKeys, podman)
+
+	boolKeys := map[string]string{
+		KeyTLSVerify: "--tls-verify",
+	}
+	lookupAndAddBoolean(artifact, ArtifactGroup, boolKeys, podman)
+
+	handlePodmanArgs(artifact, ArtifactGroup, podman)
+
+	podman.add(artifactName)
+
+	service.AddCmdline(ServiceGroup, "ExecStart", podman.Args)
+
+	defaultOneshotServiceGroup(service, true)
+
+	// Store the name of the created resource
+	unitInfo.ResourceName = artifactName
+
+	return service, nil
+}
+
 func ConvertBuild(build *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUser bool) (*parser.UnitFile, error, error) {
 	var warn, warnings error
 
@@ -1470,6 +1546,10 @@ func GetBuildServiceName(podUnit *parser.UnitFile) string {
 	return getServiceName(podUnit, BuildGroup, "-build")
 }
 
+func GetArtifactServiceName(podUnit *parser.UnitFile) string {
+	return getServiceName(podUnit, ArtifactGroup, "-artifact")
+}
+
 func GetPodServiceName(podUnit *parser.UnitFile) string {
 	return getServiceName(podUnit, PodGroup, "-pod")
 }
@@ -1849,6 +1929,9 @@ func handleStorageSource(quadletUnitFile, serviceUnitFile *parser.UnitFile, sour
 func handleHealth(unitFile *parser.UnitFile, groupName string, podman *PodmanCmdline) {
 	keyArgMap := [][2]string{
 		{KeyHealthCmd, "cmd"},
+		{KeyHealthGrpc, "grpc"},
+		{KeyHealthHttp, "http"},
+		{KeyHealthTcp, "tcp"},
 		{KeyHealthInterval, "interval"},
 		{KeyHealthOnFailure, "on-failure"},
 		{KeyHealthLogDestination, "log-destination"},
@@ -1990,6 +2073,26 @@ func handleImageSource(quadletImageName string, serviceUnitFile *parser.UnitFile
 	return quadletImageName, nil
 }
 
+func handleArtifactSource(quadletArtifactName string, serviceUnitFile *parser.UnitFile, unitsInfoMap map[string]*UnitInfo) (string, error) {
+	if !strings.HasSuffix(quadletArtifactName, ".artifact") {
+		return quadletArtifactName, nil
+	}
+
+	// since there is no default name conversion, the actual artifact name must exist in the names map
+	unitInfo, ok := unitsInfoMap[quadletArtifactName]
+	if !ok {
+		return "", fmt.Errorf("requested Quadlet artifact %s was not found", quadletArtifactName)
+	}
+
+	// the systemd unit name is $name-artifact.service
+	artifactServiceName := unitInfo.ServiceFileName()
+
+	serviceUnitFile.Add(UnitGroup, "Requires", artifactServiceName)
+	serviceUnitFile.Add(UnitGroup, "After", artifactServiceName)
+
+	return unitInfo.ResourceName, nil
+}
+
 func resolveContainerMountParams(containerUnitFile, serviceUnitFile *parser.UnitFile, mount string, unitsInfoMap map[string]*UnitInfo) (string, error) {
 	mountType, tokens, err := specgenutilexternal.FindMountType(mount)
 	if err != nil {
@@ -1998,10 +2101,11 @@ func resolveContainerMountParams(containerUnitFile, serviceUnitFile *parser.Unit
 
 	// Source resolution is required only for these types of mounts
 	sourceResultionRequired := map[string]struct{}{
-		"volume": {},
-		"bind":   {},
-		"glob":   {},
-		"image":  {},
+		"volume":   {},
+		"bind":     {},
+		"glob":     {},
+		"image":    {},
+		"artifact": {},
 	}
 	if _, ok := sourceResultionRequired[mountType]; !ok {
 		return mount, nil
@@ -2020,7 +2124,12 @@ func resolveContainerMountParams(containerUnitFile, serviceUnitFile *parser.Unit
 		}
 	}
 
-	resolvedSource, err := handleStorageSource(containerUnitFile, serviceUnitFile, originalSource, unitsInfoMap, true)
+	var resolvedSource string
+	if mountType == "artifact" {
+		resolvedSource, err = handleArtifactSource(originalSource, serviceUnitFile, unitsInfoMap)
+	} else {
+		resolvedSource, err = handleStorageSource(containerUnitFile, serviceUnitFile, originalSource, unitsInfoMap, true)
+	}
 	if err != nil {
 		return "", err
 	}
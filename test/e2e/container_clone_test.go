@@ -10,10 +10,6 @@ import (
 )
 
 var _ = Describe("Podman container clone", func() {
-	BeforeEach(func() {
-		SkipIfRemote("podman container clone is not supported in remote")
-	})
-
 	It("podman container clone basic test", func() {
 		SkipIfRootlessCgroupsV1("starting a container with the memory limits not supported")
 		create := podmanTest.Podman([]string{"create", ALPINE})
@@ -12,6 +12,7 @@ import (
 
 	"github.com/containers/podman/v5/libpod/define"
 
+	v1apps "github.com/containers/podman/v5/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v5/pkg/util"
 	. "github.com/containers/podman/v5/test/utils"
@@ -1641,6 +1642,79 @@ USER test1`
 		Expect(kube).Should(ExitWithError(125, "k8s Jobs can not have restartPolicy set to Always; only Never and OnFailure policies allowed"))
 	})
 
+	It("on pod with --type=statefulset and --replicas=2", func() {
+		podName := "test-pod"
+		podmanTest.PodmanExitCleanly("pod", "create", podName)
+		podmanTest.PodmanExitCleanly("create", "--pod", podName, CITEST_IMAGE, "top")
+
+		kube := podmanTest.PodmanExitCleanly("kube", "generate", "--type", "statefulset", "--replicas", "2", podName)
+
+		set := new(v1apps.StatefulSet)
+		err := yaml.Unmarshal(kube.Out.Contents(), set)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(set.Kind).To(Equal("StatefulSet"))
+		Expect(set.Name).To(Equal(podName + "-statefulset"))
+		Expect(set.Spec.ServiceName).To(Equal(podName))
+		Expect(*set.Spec.Replicas).To(Equal(int32(2)))
+		Expect(set.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app", podName))
+		Expect(set.Spec.Template.Name).To(Equal(podName))
+		Expect(set.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
+	It("on pod with named volume and --type=statefulset claims a volume per ordinal", func() {
+		podName := "test-pod"
+		podmanTest.PodmanExitCleanly("pod", "create", podName)
+		podmanTest.PodmanExitCleanly("create", "--pod", podName, "--name", "test-ctr", "-v", "data:/data", CITEST_IMAGE, "top")
+
+		kube := podmanTest.PodmanExitCleanly("kube", "generate", "--type", "statefulset", "--replicas", "2", podName)
+
+		set := new(v1apps.StatefulSet)
+		err := yaml.Unmarshal(kube.Out.Contents(), set)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(set.Spec.Template.Spec.Volumes).To(BeEmpty())
+		Expect(set.Spec.VolumeClaimTemplates).To(HaveLen(1))
+		Expect(set.Spec.VolumeClaimTemplates[0].Name).To(Equal("data-pvc"))
+		Expect(set.Spec.Template.Spec.Containers[0].VolumeMounts[0].Name).To(Equal("data-pvc"))
+
+		outputFile := filepath.Join(podmanTest.RunRoot, "statefulset.yaml")
+		err = os.WriteFile(outputFile, kube.Out.Contents(), 0644)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("pod", "rm", "-f", "-t", "0", podName)
+		podmanTest.PodmanExitCleanly("kube", "play", outputFile)
+
+		for ordinal, volume := range []string{"data-pvc-test-pod-statefulset-0", "data-pvc-test-pod-statefulset-1"} {
+			inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{range .Mounts}}{{.Name}}{{end}}", fmt.Sprintf("test-pod-statefulset-%d-test-ctr", ordinal))
+			Expect(inspect.OutputToString()).To(Equal(volume))
+		}
+	})
+
+	It("on pod with --type=cronjob", func() {
+		podName := "test-pod"
+		podmanTest.PodmanExitCleanly("pod", "create", podName)
+		podmanTest.PodmanExitCleanly("create", "--pod", podName, CITEST_IMAGE, "true")
+
+		kube := podmanTest.Podman([]string{"kube", "generate", "--type", "cronjob", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "k8s CronJobs require a schedule"))
+
+		kube = podmanTest.Podman([]string{"kube", "generate", "--schedule", "@daily", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "--schedule can only be set when --type is set to cronjob"))
+
+		kube = podmanTest.PodmanExitCleanly("kube", "generate", "--type", "cronjob", "--schedule", "0 3 * * *", podName)
+
+		cronJob := new(v1.CronJob)
+		err := yaml.Unmarshal(kube.Out.Contents(), cronJob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cronJob.Kind).To(Equal("CronJob"))
+		Expect(cronJob.Name).To(Equal(podName + "-cronjob"))
+		Expect(cronJob.Spec.Schedule).To(Equal("0 3 * * *"))
+		Expect(cronJob.Spec.JobTemplate.Spec.Template.Name).To(Equal(podName))
+		Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+		Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
 	It("on pod with invalid name", func() {
 		podName := "test_pod"
 		session := podmanTest.Podman([]string{"pod", "create", podName})
@@ -24,6 +24,7 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/bindings"
 	"github.com/containers/podman/v5/pkg/bindings/play"
+	"github.com/containers/podman/v5/pkg/domain/entities"
 	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v5/pkg/util"
 	. "github.com/containers/podman/v5/test/utils"
@@ -489,6 +490,110 @@ spec:
           periodSeconds: 1
 `
 
+var readinessProbePodYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: readiness-probe-pod
+spec:
+  containers:
+  - command:
+    - top
+    name: testimage
+    image: ` + CITEST_IMAGE + `
+    readinessProbe:
+      exec:
+        command:
+        - cat
+        - /ready
+      periodSeconds: 300
+`
+
+var downwardAPIPodYaml = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: downward-cm
+data:
+  greeting: hello
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: downward-pod
+  labels:
+    app: downward
+    version: "%s"
+  annotations:
+    owner: podman
+spec:
+  containers:
+  - command:
+    - top
+    name: testimage
+    image: ` + CITEST_IMAGE + `
+    volumeMounts:
+    - name: podinfo
+      mountPath: /etc/podinfo
+    - name: all-in-one
+      mountPath: /etc/all
+  volumes:
+  - name: podinfo
+    downwardAPI:
+      items:
+      - path: name
+        fieldRef:
+          fieldPath: metadata.name
+      - path: labels
+        fieldRef:
+          fieldPath: metadata.labels
+      - path: annotations
+        fieldRef:
+          fieldPath: metadata.annotations
+  - name: all-in-one
+    projected:
+      sources:
+      - configMap:
+          name: downward-cm
+      - downwardAPI:
+          items:
+          - path: %s
+            fieldRef:
+              fieldPath: metadata.labels['version']
+`
+
+var downwardAPIDeploymentYaml = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: downward
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: downward
+  template:
+    metadata:
+      labels:
+        app: downward
+    spec:
+      containers:
+      - command:
+        - top
+        name: testimage
+        image: ` + CITEST_IMAGE + `
+        volumeMounts:
+        - name: podinfo
+          mountPath: /etc/podinfo
+      volumes:
+      - name: podinfo
+        downwardAPI:
+          items:
+          - path: name
+            fieldRef:
+              fieldPath: metadata.name
+`
+
 var selinuxLabelPodYaml = `
 apiVersion: v1
 kind: Pod
@@ -1345,6 +1450,90 @@ spec:
       protocol: tcp
 `
 
+var deploymentReplicasWithHostPort = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: echo
+  annotations:
+    io.podman.annotations.kube.replicas.host-ports: %s
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: echo
+  template:
+    metadata:
+      labels:
+        app: echo
+    spec:
+      containers:
+      - name: tcp-echo
+        image: ` + CITEST_IMAGE + `
+        command:
+        - "/bin/sh"
+        - "-c"
+        - "nc -lk -p 19008 -e /bin/cat"
+        ports:
+        - containerPort: 19008
+          hostPort: 19020
+`
+
+var statefulSetWithVolumeClaimTemplate = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+spec:
+  replicas: %d
+  serviceName: db
+  selector:
+    matchLabels:
+      app: db
+  template:
+    metadata:
+      labels:
+        app: db
+    spec:
+      containers:
+      - name: ctr
+        image: ` + CITEST_IMAGE + `
+        command:
+        - top
+        volumeMounts:
+        - name: data
+          mountPath: /data
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      accessModes:
+      - ReadWriteOnce
+      resources:
+        requests:
+          storage: 1Gi
+`
+
+var cronJobYaml = `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: backup
+spec:
+  schedule: "%s"
+  suspend: %t
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: Never
+          containers:
+          - name: ctr
+            image: ` + CITEST_IMAGE + `
+            command:
+            - "true"
+`
+
 var podWithHostPIDDefined = `
 apiVersion: v1
 kind: Pod
@@ -1855,6 +2044,15 @@ func getPodNameInDeployment(d *Deployment) Pod {
 	return p
 }
 
+// getPodNamesInDeployment returns the pods of all replicas of the deployment.
+func getPodNamesInDeployment(d *Deployment) []Pod {
+	pods := []Pod{getPodNameInDeployment(d)}
+	for i := int32(1); i < d.Replicas; i++ {
+		pods = append(pods, Pod{Name: fmt.Sprintf("%s-pod-%d", d.Name, i)})
+	}
+	return pods
+}
+
 type Job struct {
 	Name        string
 	Labels      map[string]string
@@ -2610,6 +2808,74 @@ var _ = Describe("Podman kube play", func() {
 		Expect(inspect[0].State.Health).To(HaveField("Status", define.HealthCheckHealthy))
 	})
 
+	It("support container readiness probe", func() {
+		ctrName := "readiness-probe-pod-testimage"
+		err := writeYaml(readinessProbePodYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		inspect := podmanTest.InspectContainer(ctrName)
+		Expect(inspect[0].Config.ReadinessCheck).ToNot(BeNil())
+		Expect(inspect[0].Config.ReadinessCheck.Test).To(Equal([]string{"CMD", "cat", "/ready"}))
+		Expect(inspect[0].Config.Healthcheck).To(BeNil())
+		Expect(inspect[0].State.Ready).To(HaveValue(BeFalse()))
+
+		ps := podmanTest.Podman([]string{"ps", "--filter", "name=" + ctrName, "--format", "{{.Status}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(HaveSuffix("(" + define.ReadinessCheckNotReady + ")"))
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "--readiness", ctrName})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(ExitWithError(1, ""))
+		Expect(hc.OutputToString()).To(Equal(define.ReadinessCheckNotReady))
+
+		exec := podmanTest.Podman([]string{"exec", ctrName, "touch", "/ready"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec).Should(ExitCleanly())
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "--readiness", ctrName})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(ExitCleanly())
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", define.ReadinessCheckReady, ctrName})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait).Should(ExitCleanly())
+		Expect(wait.OutputToString()).To(Equal("-1"))
+
+		inspect = podmanTest.InspectContainer(ctrName)
+		Expect(inspect[0].State.Ready).To(HaveValue(BeTrue()))
+
+		ps = podmanTest.Podman([]string{"ps", "--filter", "name=" + ctrName, "--format", "{{.Status}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(HaveSuffix("(" + define.ReadinessCheckReady + ")"))
+	})
+
+	It("--wait-ready waits for containers to become ready", func() {
+		err := writeYaml(readinessProbePodYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", "--wait-ready", "--wait-ready-timeout", "3s", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "timed out after 3s waiting for container readiness-probe-pod-testimage to become ready"))
+
+		pod := getPod()
+		err = generateKubeYaml("pod", pod, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube = podmanTest.Podman([]string{"kube", "play", "--wait-ready", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		kube = podmanTest.Podman([]string{"kube", "play", "--wait-ready", "--start=false", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "--wait-ready cannot be used with --start=false"))
+	})
+
 	It("fail with nonexistent authfile", func() {
 		err := generateKubeYaml("pod", getPod(), kubeYaml)
 		Expect(err).ToNot(HaveOccurred())
@@ -3542,12 +3808,7 @@ spec:
 
 		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
 		kube.WaitWithDefaultTimeout()
-		Expect(kube).Should(Exit(0))
-		if IsRemote() {
-			Expect(kube.ErrorToString()).To(BeEmpty())
-		} else {
-			Expect(kube.ErrorToString()).To(ContainSubstring("Limiting replica count to 1, more than one replica is not supported by Podman"))
-		}
+		Expect(kube).Should(ExitCleanly())
 
 		podName := getPodNameInDeployment(deployment)
 
@@ -3576,8 +3837,7 @@ spec:
 	})
 
 	It("--ip and --mac-address", func() {
-		var i, numReplicas int32
-		numReplicas = 3
+		var numReplicas int32 = 3
 		deployment := getDeployment(withReplicas(numReplicas))
 		err := generateKubeYaml("deployment", deployment, kubeYaml)
 		Expect(err).ToNot(HaveOccurred())
@@ -3600,25 +3860,19 @@ spec:
 
 		kube := podmanTest.Podman(append(playArgs, kubeYaml))
 		kube.WaitWithDefaultTimeout()
-		Expect(kube).Should(Exit(0))
-		if IsRemote() {
-			Expect(kube.ErrorToString()).To(BeEmpty())
-		} else {
-			Expect(kube.ErrorToString()).To(ContainSubstring("Limiting replica count to 1, more than one replica is not supported by Podman"))
-		}
-
-		podName := getPodNameInDeployment(deployment)
-
-		inspect := podmanTest.Podman([]string{"inspect", getCtrNameInPod(&podName), "--format", "{{ .NetworkSettings.Networks." + net + ".IPAddress }}"})
-		inspect.WaitWithDefaultTimeout()
-		Expect(inspect).Should(ExitCleanly())
-		Expect(inspect.OutputToString()).To(Equal(ips[i]))
+		Expect(kube).Should(ExitCleanly())
 
-		inspect = podmanTest.Podman([]string{"inspect", getCtrNameInPod(&podName), "--format", "{{ .NetworkSettings.Networks." + net + ".MacAddress }}"})
-		inspect.WaitWithDefaultTimeout()
-		Expect(inspect).Should(ExitCleanly())
-		Expect(inspect.OutputToString()).To(Equal(macs[i]))
+		for i, podName := range getPodNamesInDeployment(deployment) {
+			inspect := podmanTest.Podman([]string{"inspect", getCtrNameInPod(&podName), "--format", "{{ .NetworkSettings.Networks." + net + ".IPAddress }}"})
+			inspect.WaitWithDefaultTimeout()
+			Expect(inspect).Should(ExitCleanly())
+			Expect(inspect.OutputToString()).To(Equal(ips[i]))
 
+			inspect = podmanTest.Podman([]string{"inspect", getCtrNameInPod(&podName), "--format", "{{ .NetworkSettings.Networks." + net + ".MacAddress }}"})
+			inspect.WaitWithDefaultTimeout()
+			Expect(inspect).Should(ExitCleanly())
+			Expect(inspect.OutputToString()).To(Equal(macs[i]))
+		}
 	})
 
 	It("with multiple networks", func() {
@@ -3961,6 +4215,68 @@ VOLUME %s`, CITEST_IMAGE, hostPathDir+"/")
 		Expect(cmData).Should(Not(ExitCleanly()))
 	})
 
+	It("downwardAPI and projected volumes", func() {
+		err := writeYaml(fmt.Sprintf(downwardAPIPodYaml, "1", "version"), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+
+		ctrName := "downward-pod-testimage"
+		cat := podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/podinfo/name")
+		Expect(cat.OutputToString()).To(Equal("downward-pod"))
+		cat = podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/podinfo/labels")
+		Expect(cat.OutputToStringArray()).To(Equal([]string{`app="downward"`, `version="1"`}))
+		cat = podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/podinfo/annotations")
+		Expect(cat.OutputToString()).To(ContainSubstring(`owner="podman"`))
+		cat = podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/all/greeting")
+		Expect(cat.OutputToString()).To(Equal("hello"))
+		cat = podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/all/version")
+		Expect(cat.OutputToString()).To(Equal("1"))
+
+		// The files are regenerated when the pod is replaced.
+		err = writeYaml(fmt.Sprintf(downwardAPIPodYaml, "2", "release"), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", "--replace", kubeYaml)
+
+		cat = podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/podinfo/labels")
+		Expect(cat.OutputToStringArray()).To(Equal([]string{`app="downward"`, `version="2"`}))
+		cat = podmanTest.PodmanExitCleanly("exec", ctrName, "cat", "/etc/all/release")
+		Expect(cat.OutputToString()).To(Equal("2"))
+		ls := podmanTest.PodmanExitCleanly("exec", ctrName, "ls", "/etc/all")
+		Expect(ls.OutputToStringArray()).To(Equal([]string{"greeting", "release"}))
+
+		podmanTest.PodmanExitCleanly("kube", "down", "--force", kubeYaml)
+		for _, volName := range []string{"downward-pod_podinfo", "downward-pod_all-in-one"} {
+			exists := podmanTest.Podman([]string{"volume", "exists", volName})
+			exists.WaitWithDefaultTimeout()
+			Expect(exists).Should(ExitWithError(1, ""))
+		}
+	})
+
+	It("downwardAPI volumes of deployment replicas are removed by down", func() {
+		err := writeYaml(downwardAPIDeploymentYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+
+		podNames := []string{"downward-pod", "downward-pod-1"}
+		volNames := []string{}
+		for _, podName := range podNames {
+			volName := podName + "_podinfo"
+			inspect := podmanTest.PodmanExitCleanly("volume", "inspect", "--format", "{{index .Labels \"io.podman.kube.pod\"}}", volName)
+			Expect(inspect.OutputToString()).To(Equal(podName))
+			volNames = append(volNames, volName)
+		}
+
+		podmanTest.PodmanExitCleanly("kube", "down", "--force", kubeYaml)
+		for _, volName := range volNames {
+			exists := podmanTest.Podman([]string{"volume", "exists", volName})
+			exists.WaitWithDefaultTimeout()
+			Expect(exists).Should(ExitWithError(1, ""))
+		}
+	})
+
 	It("with a missing optional ConfigMap volume", func() {
 		volumeName := "cmVol"
 
@@ -4083,12 +4399,7 @@ spec:
 
 		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
 		kube.WaitWithDefaultTimeout()
-		Expect(kube).Should(Exit(0))
-		if IsRemote() {
-			Expect(kube.ErrorToString()).To(BeEmpty())
-		} else {
-			Expect(kube.ErrorToString()).To(ContainSubstring("Limiting replica count to 1, more than one replica is not supported by Podman"))
-		}
+		Expect(kube).Should(ExitCleanly())
 
 		correctLabels := expectedLabelKey + ":" + expectedLabelValue
 		pod := getPodNameInDeployment(deployment)
@@ -4124,12 +4435,7 @@ spec:
 
 		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
 		kube.WaitWithDefaultTimeout()
-		Expect(kube).Should(Exit(0))
-		if IsRemote() {
-			Expect(kube.ErrorToString()).To(BeEmpty())
-		} else {
-			Expect(kube.ErrorToString()).To(ContainSubstring("Limiting replica count to 1, more than one replica is not supported by Podman"))
-		}
+		Expect(kube).Should(ExitCleanly())
 
 		pod := getPodNameInDeployment(deployment)
 		inspect := podmanTest.PodmanExitCleanly("inspect", getCtrNameInPod(&pod), "--format", `
@@ -5463,7 +5769,6 @@ spec:
 	})
 
 	It("with named volume subpaths", func() {
-		SkipIfRemote("volume export does not exist on remote")
 		podmanTest.PodmanExitCleanly("volume", "create", "testvol1")
 		podmanTest.PodmanExitCleanly("run", "--volume", "testvol1:/data", CITEST_IMAGE, "sh", "-c", "mkdir -p /data/testing/onlythis && touch /data/testing/onlythis/123.txt && echo hi >> /data/testing/onlythis/123.txt")
 
@@ -5530,7 +5835,6 @@ spec:
 	})
 
 	It("with unsafe subpaths", func() {
-		SkipIfRemote("volume export does not exist on remote")
 		podmanTest.PodmanExitCleanly("volume", "create", "testvol1")
 		podmanTest.PodmanExitCleanly("run", "--volume", "testvol1:/data", CITEST_IMAGE, "sh", "-c", "mkdir -p /data/testing && ln -s /etc /data/testing/onlythis")
 
@@ -5692,22 +5996,305 @@ spec:
 		verifyPodPorts(podmanTest, "network-echo", "19008/tcp:[{0.0.0.0 19011}]", "19008/udp:[{0.0.0.0 19012}]")
 	})
 
-	It("with replicas limits the count to 1 and emits a warning", func() {
-		deployment := getDeployment(withReplicas(10))
+	It("with replicas creates a pod per replica", func() {
+		deployment := getDeployment(withReplicas(3))
 		err := generateKubeYaml("deployment", deployment, kubeYaml)
 		Expect(err).ToNot(HaveOccurred())
 
+		kube := podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		Expect(strings.Count(kube.OutputToString(), "Pod:")).To(Equal(3))
+		Expect(strings.Count(kube.OutputToString(), "Container:")).To(Equal(3))
+
+		pods := podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(ConsistOf(deployment.Name+"-pod", deployment.Name+"-pod-1", deployment.Name+"-pod-2"))
+
+		// Scale up and down in place
+		scale := podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "4", kubeYaml)
+		Expect(strings.Count(scale.OutputToString(), "Pod:")).To(Equal(1))
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(ConsistOf(deployment.Name+"-pod", deployment.Name+"-pod-1", deployment.Name+"-pod-2", deployment.Name+"-pod-3"))
+
+		podID := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.ID}}", deployment.Name+"-pod")
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "1", kubeYaml)
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.ID}}", "--no-trunc")
+		Expect(pods.OutputToStringArray()).To(Equal([]string{podID.OutputToString()}))
+
+		// Replace recreates the replicas of the YAML
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "5", kubeYaml)
+		podmanTest.PodmanExitCleanly("kube", "play", "--replace", kubeYaml)
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(HaveLen(3))
+
+		// Down removes all replicas, also those added by scale
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "4", kubeYaml)
+		podmanTest.PodmanExitCleanly("kube", "down", kubeYaml)
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "-q")
+		Expect(pods.OutputToString()).To(BeEmpty())
+	})
+
+	It("with replicas selects the replicas by label", func() {
+		deployment := getDeployment(withReplicas(2))
+		err := generateKubeYaml("deployment", deployment, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		// A pod named like a replica but not created for the deployment
+		podmanTest.PodmanExitCleanly("pod", "create", "--infra=false", deployment.Name+"-pod-5")
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		inspect := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", `{{index .Labels "io.podman.kube.owner.kind"}}/{{index .Labels "io.podman.kube.owner.name"}}`, deployment.Name+"-pod-1")
+		Expect(inspect.OutputToString()).To(Equal("Deployment/" + deployment.Name))
+
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "1", kubeYaml)
+		pods := podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(ConsistOf(deployment.Name+"-pod", deployment.Name+"-pod-5"))
+
+		podmanTest.PodmanExitCleanly("kube", "down", kubeYaml)
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(ConsistOf(deployment.Name + "-pod-5"))
+	})
+
+	It("with replicas publishes host ports on the first replica", func() {
+		err := writeYaml(fmt.Sprintf(deploymentReplicasWithHostPort, "first"), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		verifyPodPorts(podmanTest, "echo-pod", "19008/tcp:[{0.0.0.0 19020}]")
+		for _, podName := range []string{"echo-pod-1", "echo-pod-2"} {
+			inspect := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.InfraConfig.PortBindings}}", podName)
+			Expect(inspect.OutputToString()).To(Equal("map[]"))
+		}
+	})
+
+	It("with replicas increments host ports", func() {
+		err := writeYaml(fmt.Sprintf(deploymentReplicasWithHostPort, "increment"), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		verifyPodPorts(podmanTest, "echo-pod", "19008/tcp:[{0.0.0.0 19020}]")
+		verifyPodPorts(podmanTest, "echo-pod-1", "19008/tcp:[{0.0.0.0 19021}]")
+		verifyPodPorts(podmanTest, "echo-pod-2", "19008/tcp:[{0.0.0.0 19022}]")
+
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "4", kubeYaml)
+		verifyPodPorts(podmanTest, "echo-pod-3", "19008/tcp:[{0.0.0.0 19023}]")
+	})
+
+	It("with invalid replica host ports annotation", func() {
+		err := writeYaml(fmt.Sprintf(deploymentReplicasWithHostPort, "bogus"), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
 		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
 		kube.WaitWithDefaultTimeout()
-		Expect(kube).Should(Exit(0))
+		Expect(kube).Should(ExitWithError(125, `invalid value "bogus" for annotation io.podman.annotations.kube.replicas.host-ports`))
+	})
+
+	It("scale creates replicas with the options of play", func() {
+		deployment := getDeployment()
+		err := generateKubeYaml("deployment", deployment, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", "--no-hosts", "--log-driver", "k8s-file", kubeYaml)
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "2", kubeYaml)
 
-		// warnings are only propagated to local clients
-		if !IsRemote() {
-			Expect(kube.ErrorToString()).Should(ContainSubstring("Limiting replica count to 1, more than one replica is not supported by Podman"))
+		inspect := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.InfraConfig.NoManageHosts}}", deployment.Name+"-pod-1")
+		Expect(inspect.OutputToString()).To(Equal("true"))
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.HostConfig.LogConfig.Type}}", deployment.Name+"-pod-1-"+defaultCtrName)
+		Expect(inspect.OutputToString()).To(Equal("k8s-file"))
+	})
+
+	It("scale reads configmaps again and only deployments record play options", func() {
+		cmYamlPathname := filepath.Join(podmanTest.TempDir, "foo-cm.yaml")
+		cm := getConfigMap(withConfigMapName("foo"), withConfigMapData("FOO", "foo"))
+		err := generateKubeYaml("configmap", cm, cmYamlPathname)
+		Expect(err).ToNot(HaveOccurred())
+
+		pod := getPod(withCtr(getCtr(withEnv("FOO", "", "configmap", "foo", "FOO", false))))
+		err = generateKubeYaml("pod", pod, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("kube", "play", "--configmap", cmYamlPathname, kubeYaml)
+		inspect := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.InfraContainerID}}", pod.Name)
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.Config.Annotations}}", inspect.OutputToString())
+		Expect(inspect.OutputToString()).ToNot(ContainSubstring("io.podman.annotations.kube.play-options"))
+
+		deployment := getDeployment(withPod(getPod(withCtr(getCtr(withEnv("FOO", "", "configmap", "foo", "FOO", false))))))
+		err = generateKubeYaml("deployment", deployment, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("kube", "play", "--configmap", cmYamlPathname, kubeYaml)
+		inspect = podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.InfraContainerID}}", deployment.Name+"-pod")
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.Config.Annotations}}", inspect.OutputToString())
+		Expect(inspect.OutputToString()).To(ContainSubstring("io.podman.annotations.kube.play-options"))
+		// The content of the configmaps is not recorded.
+		Expect(inspect.OutputToString()).ToNot(ContainSubstring("FOO"))
+
+		scale := podmanTest.Podman([]string{"kube", "scale", "--replicas", "2", kubeYaml})
+		scale.WaitWithDefaultTimeout()
+		Expect(scale).Should(ExitWithError(125, "foo"))
+
+		podmanTest.PodmanExitCleanly("kube", "scale", "--replicas", "2", "--configmap", cmYamlPathname, kubeYaml)
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.Config.Env}}", deployment.Name+"-pod-1-"+defaultCtrName)
+		Expect(inspect.OutputToString()).To(ContainSubstring("FOO=foo"))
+	})
+
+	It("scale without deployment", func() {
+		err := writeYaml(publishPortsEchoWithHostPortTCP, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		scale := podmanTest.Podman([]string{"kube", "scale", "--replicas", "2", kubeYaml})
+		scale.WaitWithDefaultTimeout()
+		Expect(scale).Should(ExitWithError(125, "YAML document does not contain any Deployment"))
+	})
+
+	It("diff against the objects created by play", func() {
+		pod := getPod(withLabel("app", "web"), withCtr(getCtr(withEnv("FOO", "bar", "", "", "", false))))
+		err := generateKubeYaml("pod", pod, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		diff := podmanTest.Podman([]string{"kube", "diff", kubeYaml})
+		diff.WaitWithDefaultTimeout()
+		Expect(diff).Should(ExitWithError(1, ""))
+		Expect(diff.OutputToStringArray()).To(Equal([]string{"+ Pod " + pod.Name}))
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		diff = podmanTest.PodmanExitCleanly("kube", "diff", kubeYaml)
+		// Only the fields that are not compared are listed.
+		Expect(diff.OutputToStringArray()).To(ContainElements(
+			"? Container "+getCtrNameInPod(pod),
+			"    imagePullPolicy",
+			"    securityContext",
+		))
+		for _, line := range diff.OutputToStringArray() {
+			Expect(line).To(Or(HavePrefix("? "), HavePrefix("    ")))
+		}
+
+		pod = getPod(withLabel("app", "db"), withCtr(getCtr(withEnv("FOO", "baz", "", "", "", false))))
+		err = generateKubeYaml("pod", pod, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		diff = podmanTest.Podman([]string{"kube", "diff", kubeYaml})
+		diff.WaitWithDefaultTimeout()
+		Expect(diff).Should(ExitWithError(1, ""))
+		lines := diff.OutputToStringArray()
+		Expect(len(lines)).To(BeNumerically(">", 4))
+		Expect(lines[:4]).To(Equal([]string{
+			"~ Pod " + pod.Name,
+			`    labels.app: "web" -> "db"`,
+			"~ Container " + getCtrNameInPod(pod),
+			`    env.FOO: "bar" -> "baz"`,
+		}))
+		Expect(lines[4]).To(HavePrefix("? "))
+
+		diff = podmanTest.Podman([]string{"kube", "diff", "--format", "json", kubeYaml})
+		diff.WaitWithDefaultTimeout()
+		Expect(diff).Should(ExitWithError(1, ""))
+		var report entities.KubeDiffReport
+		Expect(json.Unmarshal(diff.Out.Contents(), &report)).To(Succeed())
+		Expect(report.Changes).To(HaveLen(2))
+		Expect(report.Changes[0].Action).To(Equal("change"))
+		Expect(report.Changes[0].Kind).To(Equal("Pod"))
+		Expect(report.Unchecked).ToNot(BeEmpty())
+
+		// Nothing was changed by diff
+		podmanTest.PodmanExitCleanly("kube", "play", "--replace", kubeYaml)
+		diff = podmanTest.PodmanExitCleanly("kube", "diff", "--format", "json", kubeYaml)
+		report = entities.KubeDiffReport{}
+		Expect(json.Unmarshal(diff.Out.Contents(), &report)).To(Succeed())
+		Expect(report.Changes).To(BeEmpty())
+	})
+
+	It("statefulset with volume claim templates", func() {
+		err := writeYaml(fmt.Sprintf(statefulSetWithVolumeClaimTemplate, 2), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		Expect(strings.Count(kube.OutputToString(), "Pod:")).To(Equal(2))
+
+		pods := podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(ConsistOf("db-0", "db-1"))
+		for _, podName := range []string{"db-0", "db-1"} {
+			inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{range .Mounts}}{{.Name}}:{{.Destination}}{{end}}", podName+"-ctr")
+			Expect(inspect.OutputToString()).To(Equal("data-" + podName + ":/data"))
+		}
+
+		// Replace keeps the volumes of the removed pods
+		err = writeYaml(fmt.Sprintf(statefulSetWithVolumeClaimTemplate, 1), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("kube", "play", "--replace", kubeYaml)
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(pods.OutputToStringArray()).To(ConsistOf("db-0"))
+		volumes := podmanTest.PodmanExitCleanly("volume", "ls", "--format", "{{.Name}}")
+		Expect(volumes.OutputToStringArray()).To(ConsistOf("data-db-0", "data-db-1"))
+
+		podmanTest.PodmanExitCleanly("kube", "down", "--force", kubeYaml)
+		pods = podmanTest.PodmanExitCleanly("pod", "ps", "-q")
+		Expect(pods.OutputToString()).To(BeEmpty())
+		volumes = podmanTest.PodmanExitCleanly("volume", "ls", "--format", "{{.Name}}")
+		Expect(volumes.OutputToStringArray()).To(ConsistOf("data-db-1"))
+	})
+
+	It("suspended cronjob creates the pod without starting it", func() {
+		err := writeYaml(fmt.Sprintf(cronJobYaml, "*/5 * * * *", true), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		inspect := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.State}}", "backup-pod")
+		Expect(inspect.OutputToString()).To(Equal("Created"))
+
+		podmanTest.PodmanExitCleanly("kube", "down", kubeYaml)
+		pods := podmanTest.PodmanExitCleanly("pod", "ps", "-q")
+		Expect(pods.OutputToString()).To(BeEmpty())
+	})
+
+	It("cronjob creates a systemd timer", func() {
+		SkipIfSystemdNotRunning("CronJobs require systemd")
+		SkipIfRemote("the timer is created on the server")
+		err := writeYaml(fmt.Sprintf(cronJobYaml, "30 4 * * 1-5", false), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		podID := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.ID}}", "backup-pod").OutputToString()
+		timer := podID + "-cronjob.timer"
+
+		systemctl := []string{"systemctl"}
+		if isRootless() {
+			systemctl = append(systemctl, "--user")
 		}
+		session := SystemExec(systemctl[0], append(systemctl[1:], "show", "--property=TimersCalendar", timer))
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("Mon..Fri *-*-* 04:30:00"))
+
+		podmanTest.PodmanExitCleanly("kube", "down", kubeYaml)
+		session = SystemExec(systemctl[0], append(systemctl[1:], "is-active", timer))
+		Expect(session.OutputToString()).To(Equal("inactive"))
+	})
 
-		Expect(strings.Count(kube.OutputToString(), "Pod:")).To(Equal(1))
-		Expect(strings.Count(kube.OutputToString(), "Container:")).To(Equal(1))
+	It("cronjob timer is removed with the pod", func() {
+		SkipIfSystemdNotRunning("CronJobs require systemd")
+		SkipIfRemote("the timer is created on the server")
+		err := writeYaml(fmt.Sprintf(cronJobYaml, "30 4 * * *", false), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+		podID := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.ID}}", "backup-pod").OutputToString()
+		timer := podID + "-cronjob.timer"
+
+		systemctl := []string{"systemctl"}
+		if isRootless() {
+			systemctl = append(systemctl, "--user")
+		}
+		session := SystemExec(systemctl[0], append(systemctl[1:], "is-active", timer))
+		Expect(session).Should(ExitCleanly())
+
+		podmanTest.PodmanExitCleanly("pod", "rm", "-f", "backup-pod")
+		session = SystemExec(systemctl[0], append(systemctl[1:], "is-active", timer))
+		Expect(session.OutputToString()).To(Equal("inactive"))
+	})
+
+	It("cronjob with invalid schedule", func() {
+		err := writeYaml(fmt.Sprintf(cronJobYaml, "0 0 1 * 1", true), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "restricting both the day of month and the day of week is not supported"))
 	})
 
 	It("test with hostPID", func() {
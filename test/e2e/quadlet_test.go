@@ -51,6 +51,8 @@ func calcServiceName(path string) string {
 		service += "-image"
 	case ".build":
 		service += "-build"
+	case ".artifact":
+		service += "-artifact"
 	case ".pod":
 		service += "-pod"
 	}
@@ -901,6 +903,9 @@ BOGUS=foo
 		Entry("exec.container", "exec.container"),
 		Entry("group-add.container", "group-add.container"),
 		Entry("health.container", "health.container"),
+		Entry("health-grpc.container", "health-grpc.container"),
+		Entry("health-http.container", "health-http.container"),
+		Entry("health-tcp.container", "health-tcp.container"),
 		Entry("host.container", "host.container"),
 		Entry("hostname.container", "hostname.container"),
 		Entry("idmapping.container", "idmapping.container"),
@@ -1037,6 +1042,16 @@ BOGUS=foo
 		Entry("Image - No Default Dependencies", "no_deps.image"),
 		Entry("Image - Retry", "retry.image"),
 
+		Entry("Artifact - Basic", "basic.artifact"),
+		Entry("Artifact - Auth File", "auth.artifact"),
+		Entry("Artifact - Certificates", "certs.artifact"),
+		Entry("Artifact - Credentials", "creds.artifact"),
+		Entry("Artifact - Decryption Key", "decrypt.artifact"),
+		Entry("Artifact - TLS Verify", "tls-verify.artifact"),
+		Entry("Artifact - Retry", "retry.artifact"),
+		Entry("Artifact - global args", "globalargs.artifact"),
+		Entry("Artifact - Podman args", "podmanargs.artifact"),
+
 		Entry("Build - Basic", "basic.build"),
 		Entry("Build - Annotation Key", "annotation.build"),
 		Entry("Build - Arch Key", "arch.build"),
@@ -1121,6 +1136,9 @@ BOGUS=foo
 
 		Entry("Image - No Image", "no-image.image", "converting \"no-image.image\": no Image key specified"),
 
+		Entry("Artifact - No Artifact", "no-artifact.artifact", "converting \"no-artifact.artifact\": no Artifact key specified"),
+		Entry("Container - Quadlet artifact (.artifact) not found", "artifact-not-found.container", "converting \"artifact-not-found.container\": requested Quadlet artifact not-found.artifact was not found"),
+
 		Entry("Build - File Key relative no WD", "file-rel-no-wd.build", "converting \"file-rel-no-wd.build\": relative path in File key requires SetWorkingDirectory key to be set"),
 		Entry("Build - Neither WorkingDirectory nor File Key", "neither-workingdirectory-nor-file.build", "converting \"neither-workingdirectory-nor-file.build\": neither SetWorkingDirectory, nor File key specified"),
 		Entry("Build - No ImageTag Key", "no-imagetag.build", "converting \"no-imagetag.build\": no ImageTag key specified"),
@@ -1131,6 +1149,7 @@ BOGUS=foo
 		func(fileName, serviceName string) {
 			runQuadletTestCaseWithServiceName(fileName, 0, "", serviceName)
 		},
+		Entry("Artifact", "service-name.artifact", "basic"),
 		Entry("Build", "service-name.build", "basic"),
 		Entry("Container", "service-name.container", "basic"),
 		Entry("Image", "service-name.image", "basic"),
@@ -1155,6 +1174,8 @@ BOGUS=foo
 		Entry("Container - Quadlet Network", "network.quadlet.container", []string{"basic.network"}),
 		Entry("Container - Quadlet Volume", "volume.container", []string{"basic.volume"}),
 		Entry("Container - Mount overriding service name", "mount.servicename.container", []string{"service-name.volume"}),
+		Entry("Container - Mount Quadlet artifact", "mount.artifact.container", []string{"basic.artifact"}),
+		Entry("Container - Mount Quadlet artifact overriding service name", "mount.artifact.servicename.container", []string{"service-name.artifact"}),
 		Entry("Container - Quadlet Network overriding service name", "network.quadlet.servicename.container", []string{"service-name.network"}),
 		Entry("Container - Quadlet Volume overriding service name", "volume.servicename.container", []string{"service-name.volume"}),
 		Entry("Container - Quadlet build with multiple tags", "build.multiple-tags.container", []string{"multiple-tags.build"}),
@@ -23,11 +23,6 @@ FROM  %s
 LABEL RUN podman run --name NAME IMAGE`, ALPINE)
 
 var _ = Describe("podman container runlabel", func() {
-
-	BeforeEach(func() {
-		SkipIfRemote("runlabel is not supported for remote connections")
-	})
-
 	It("podman container runlabel (podman --version)", func() {
 		image := "podman-runlabel-test:podman"
 		podmanTest.BuildImage(PodmanDockerfile, image, "false")
@@ -14,11 +14,6 @@ import (
 
 // Without Ordered, tests flake with "Getting key identity" (#18358)
 var _ = Describe("Podman trust", Ordered, func() {
-
-	BeforeEach(func() {
-		SkipIfRemote("podman-remote does not support image trust")
-	})
-
 	It("podman image trust show", func() {
 		session := podmanTest.Podman([]string{"image", "trust", "show", "-n", "--registrypath", filepath.Join(INTEGRATION_ROOT, "test"), "--policypath", filepath.Join(INTEGRATION_ROOT, "test/policy.json")})
 		session.WaitWithDefaultTimeout()
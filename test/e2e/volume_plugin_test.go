@@ -305,4 +305,54 @@ Removed:
 		Expect(volInspect2).Should(ExitCleanly())
 		Expect(volInspect2.OutputToString()).To(ContainSubstring("3"))
 	})
+
+	It("podman plugin ls and inspect", func() {
+		podmanTest.AddImageToRWStore(volumeTest)
+
+		pluginStatePath := filepath.Join(podmanTest.TempDir, "volumes")
+		err := os.Mkdir(pluginStatePath, 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Keep this distinct within tests to avoid multiple tests using the same plugin.
+		pluginName := "testvol7"
+		ctrName := "pluginCtr"
+
+		inspect := podmanTest.Podman([]string{"plugin", "inspect", "--format", "{{ .Enabled }} {{ .Running }} {{ .Volumes }}", pluginName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("true false 0"))
+
+		plugin := podmanTest.Podman([]string{"run", "--name", ctrName, "--security-opt", "label=disable", "-v", "/run/docker/plugins:/run/docker/plugins", "-v", fmt.Sprintf("%v:%v", pluginStatePath, pluginStatePath), "-d", volumeTest, "--sock-name", pluginName, "--path", pluginStatePath})
+		plugin.WaitWithDefaultTimeout()
+		Expect(plugin).Should(ExitCleanly())
+
+		// Make sure the socket is available (see #17956)
+		err = WaitForFile(fmt.Sprintf("/run/docker/plugins/%s.sock", pluginName))
+		Expect(err).ToNot(HaveOccurred())
+
+		create := podmanTest.Podman([]string{"volume", "create", "--driver", pluginName, "testVolume1"})
+		create.WaitWithDefaultTimeout()
+		Expect(create).Should(ExitCleanly())
+
+		ls := podmanTest.Podman([]string{"plugin", "ls", "--noheading", "--filter", "name=^" + pluginName + "$", "--format", "{{ .Name }} {{ .Running }} {{ .Volumes }} {{ .SocketPath }}"})
+		ls.WaitWithDefaultTimeout()
+		Expect(ls).Should(ExitCleanly())
+		Expect(ls.OutputToStringArray()).To(Equal([]string{fmt.Sprintf("%s true 1 /run/docker/plugins/%s.sock", pluginName, pluginName)}))
+
+		lsQuiet := podmanTest.Podman([]string{"plugin", "ls", "-q", "--filter", "running=true"})
+		lsQuiet.WaitWithDefaultTimeout()
+		Expect(lsQuiet).Should(ExitCleanly())
+		Expect(lsQuiet.OutputToStringArray()).To(ContainElement(pluginName))
+
+		podmanTest.StopContainer(ctrName)
+
+		inspect = podmanTest.Podman([]string{"plugin", "inspect", "--format", "{{ .Running }}", pluginName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("false"))
+
+		inspect = podmanTest.Podman([]string{"plugin", "inspect", "nonexistent"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitWithError(125, "no volume plugin with name nonexistent available"))
+	})
 })
@@ -453,4 +453,67 @@ EOF
     run_podman rm $cname
 }
 
+# bats test_tags=ci:parallel
+@test "podman logs - syslog" {
+    cname="c-$(safename)"
+    sock=$PODMAN_TMPDIR/syslog-$(safename).sock
+    received=$PODMAN_TMPDIR/syslog-$(safename).log
+
+    socat -u UNIX-RECV:$sock,unlink-early CREATE:$received &
+    socat_pid=$!
+    wait_for_file $sock
+
+    run_podman run --name $cname --log-driver syslog \
+               --log-opt syslog-address=unixgram://$sock \
+               --log-opt syslog-facility=local3 \
+               --log-opt tag="{{.Name}}" \
+               $IMAGE sh -c 'echo hello; sleep 0.1; echo oops >&2'
+
+    # The output remains available locally
+    run_podman logs $cname
+    is "$output" "hello
+oops" "podman logs of syslog container"
+
+    # local3 (19) * 8 + info (6) = 158, + err (3) = 155
+    wait_for_file_content $received "oops"
+    run cat $received
+    assert "$output" =~ "<158>.* $cname: hello" "STDOUT sent to syslog"
+    assert "$output" =~ "<155>.* $cname: oops" "STDERR sent to syslog"
+    kill $socat_pid
+
+    run_podman 125 create --log-driver syslog --log-opt syslog-format=bsd $IMAGE
+    is "$output" ".*invalid syslog format \"bsd\"" "invalid syslog option"
+
+    run_podman rm $cname
+}
+
+# bats test_tags=ci:parallel
+@test "podman logs - k8s-file rotation" {
+    cname="c-$(safename)"
+    run_podman run -d --name $cname --log-driver k8s-file \
+               --log-opt max-size=8k --log-opt max-file=3 --log-opt compress=true \
+               $IMAGE sh -c 'i=0; while [ $i -lt 300 ]; do i=$((i+1)); echo "line $i"; [ $((i % 30)) -eq 0 ] && sleep 1; done; echo DONE; sleep 100'
+    wait_for_output DONE $cname
+    # Give the rotator a chance to compress the last rotated file
+    sleep 2
+
+    run_podman inspect --format '{{.HostConfig.LogConfig.Path}}' $cname
+    logpath="$output"
+    test -e $logpath.1.gz || die "$logpath was not rotated: $(ls $(dirname $logpath))"
+
+    # All lines are read across the rotated files
+    expected=$(for i in $(seq 1 300); do echo "line $i"; done; echo DONE)
+    run_podman logs $cname
+    assert "$output" = "$expected" "podman logs across rotated files"
+
+    run_podman logs --tail 2 $cname
+    assert "$output" = "line 300
+DONE" "podman logs --tail across rotated files"
+
+    run_podman 125 create --log-opt max-file=2 $IMAGE
+    is "$output" ".*max-file requires a maximum log size" "max-file without max-size"
+
+    run_podman rm -f -t0 $cname
+}
+
 # vim: filetype=sh
@@ -3,7 +3,6 @@
 load helpers
 
 @test "podman container runlabel test" {
-    skip_if_remote "container runlabel is not supported for remote"
     tmpdir=$PODMAN_TMPDIR/runlabel-test
     mkdir -p $tmpdir
     containerfile=$tmpdir/Containerfile
@@ -439,3 +439,68 @@ EOF
     run_podman 125 events --since="the dawn of time...ish"
     assert "$output" =~ "failed to parse event filters"
 }
+
+@test "events - exec sink" {
+    skip_if_remote "setting CONTAINERS_CONF_OVERRIDE events options does not affect remote client"
+
+    local cname=c-$(safename)
+    local sink=$PODMAN_TMPDIR/sink-$(safename)
+    local sinkOutput=$PODMAN_TMPDIR/sink-$(safename).json
+    cat >$sink <<EOF
+#!/bin/sh
+cat >>$sinkOutput
+EOF
+    chmod +x $sink
+
+    containersConf=$PODMAN_TMPDIR/containers-$(safename).conf
+    cat >$containersConf <<EOF
+[engine]
+events_sink_exec="$sink"
+events_sink_filters=["event=died"]
+EOF
+
+    CONTAINERS_CONF_OVERRIDE=$containersConf run_podman 42 run --name $cname $IMAGE sh -c "exit 42"
+
+    # Only the filtered event is delivered to the sink
+    run -0 cat $sinkOutput
+    assert "${#lines[@]}" = 1 "Number of events delivered to the sink"
+    assert "$output" =~ "\"Name\":\"$cname\"" "Event of the container"
+    assert "$output" =~ "\"Status\":\"died\"" "Status of the event"
+    assert "$output" =~ "\"ContainerExitCode\":42" "Exit code of the container"
+
+    run_podman rm $cname
+}
+
+@test "events - structured filters and aggregation" {
+    local cname1=c1-$(safename)
+    local cname2=c2-$(safename)
+    run_podman run --name $cname1 $IMAGE true
+    run_podman 3 run --name $cname2 $IMAGE sh -c "exit 3"
+    run_podman 3 start --attach $cname2
+
+    run_podman events --since=1m --stream=false --format "{{.Name}} {{.Status}} {{.ContainerExitCode}}" \
+               --filter "container=c?-$(safename)" --filter exitCode!=0
+    assert "$output" = "$cname2 died 3
+$cname2 died 3" "events with non-zero exit codes"
+
+    run_podman events --since=1m --stream=false --format "{{.Name}}" \
+               --filter "container~=^c1-" --filter event=died
+    assert "$output" =~ "$cname1" "events matched by regular expression"
+    assert "$output" !~ "$cname2" "events not matched by regular expression"
+
+    run_podman events --since=1m --stream=false --filter "name=*-$(safename)" \
+               --filter event=died --filter event=start --aggregate
+    assert "${lines[0]}" =~ "NAME +STATUS +COUNT" "aggregated header"
+    assert "$output" =~ "$cname2 +died +2" "aggregated died events"
+    assert "$output" =~ "$cname2 +start +2" "aggregated start events"
+    assert "$output" =~ "$cname1 +died +1" "aggregated died events"
+
+    run_podman events --since=1m --stream=false --filter "name=*-$(safename)" \
+               --filter event=died --aggregate name --format "{{.Name}}={{.Count}}"
+    assert "${lines[0]}" = "$cname2=2" "largest group first"
+
+    run_podman 125 events --aggregate name
+    assert "$output" =~ "--aggregate requires --stream=false"
+
+    run_podman rm $cname1 $cname2
+}
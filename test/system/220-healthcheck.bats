@@ -285,6 +285,62 @@ Log[-1].Output   | \"Uh-oh on stdout!\\\nUh-oh on stderr!\\\n\"
     done
 }
 
+@test "podman healthcheck - podman scheduler" {
+    skip_if_remote "containers.conf of the server cannot be set remotely"
+
+    local ctrname="c-h-$(safename)"
+    local conf=$PODMAN_TMPDIR/containers-$(safename).conf
+    cat >$conf <<EOF
+[engine]
+healthcheck_scheduler="podman"
+EOF
+
+    CONTAINERS_CONF_OVERRIDE=$conf run_podman run -d --name $ctrname \
+               --health-cmd /home/podman/healthcheck   \
+               --health-interval 1s                    \
+               --health-retries 2                      \
+               --health-on-failure=kill                \
+               --health-startup-cmd /home/podman/healthcheck \
+               --health-startup-interval 1s                  \
+               $IMAGE /home/podman/pause
+    cid="$output"
+
+    current_time=$(date --iso-8601=ns)
+    _check_health $ctrname "Scheduler healthy" "
+Status           | \"healthy\"
+FailingStreak    | 0
+Log[-1].ExitCode | 0
+" "$current_time" "healthy"
+
+    # The healthchecks are run by the scheduler rather than by systemd
+    run -0 pgrep -f "healthcheck scheduler --name $cid-scheduler-[0-9a-f]+ $cid"
+    if [[ -d /run/systemd/system ]]; then
+        run -0 systemctl list-units --quiet "*$cid*.timer"
+        assert "$output" == "" "No healthcheck systemd timer"
+    fi
+
+    # Force a failure; the on-failure action must be run by the scheduler
+    current_time=$(date --iso-8601=ns)
+    run_podman exec $ctrname touch /uh-oh
+    _check_health $ctrname "Scheduler unhealthy" "
+Status           | \"unhealthy\"
+Log[-1].ExitCode | 1
+" "$current_time" "unhealthy"
+    run_podman wait $ctrname
+
+    # The scheduler exits along with the container
+    local timeout=10
+    while pgrep -f "healthcheck scheduler --name $cid-scheduler" >/dev/null; do
+        timeout=$((timeout - 1))
+        if [[ $timeout -eq 0 ]]; then
+            die "Healthcheck scheduler of $ctrname did not exit"
+        fi
+        sleep 1
+    done
+
+    run_podman rm -t 0 -f $ctrname
+}
+
 function _create_container_with_health_log_settings {
     local ctrname="$1"
     local msg="$2"
@@ -452,6 +508,49 @@ function _check_health_log {
     run_podman rm -f -t0 $ctr
 }
 
+@test "podman healthcheck --health-tcp and --health-http" {
+    ctr="c-h-$(safename)"
+
+    # Nothing is listening on the probed port, so the probe must fail
+    run_podman run -d --name $ctr              \
+               --health-tcp 8080               \
+               --health-interval disable       \
+               $IMAGE /bin/busybox-extras httpd -f -p 8081 -h /etc
+    run_podman 1 healthcheck run $ctr
+    run_podman inspect $ctr --format "{{range .State.Health.Log}}{{.Output}}{{end}}"
+    assert "$output" =~ "connection refused" "TCP probe output"
+    run_podman rm -f -t0 $ctr
+
+    # The probes are performed from the network namespace of the
+    # container, so they succeed even though no port is published.
+    run_podman run -d --name $ctr              \
+               --health-tcp 8081               \
+               --health-interval disable       \
+               $IMAGE /bin/busybox-extras httpd -f -p 8081 -h /etc
+    run_podman inspect $ctr --format "{{.Config.Healthcheck.Test}}"
+    is "$output" "[TCP localhost:8081]" "TCP probe healthcheck"
+    retry=5
+    until run_podman '?' healthcheck run $ctr && [[ $status -eq 0 ]]; do
+        retry=$((retry - 1))
+        if [[ $retry -eq 0 ]]; then
+            die "TCP probe of $ctr did not succeed"
+        fi
+        sleep 1
+    done
+
+    run_podman update --health-http http://localhost:8081/hostname $ctr
+    run_podman inspect $ctr --format "{{.Config.Healthcheck.Test}}"
+    is "$output" "[HTTP http://localhost:8081/hostname]" "HTTP probe healthcheck"
+    run_podman healthcheck run $ctr
+    run_podman inspect $ctr --format "{{range .State.Health.Log}}{{.Output}}{{end}}"
+    assert "$output" =~ "GET http://localhost:8081/hostname: 200 OK" "HTTP probe output"
+
+    run_podman 125 update --health-http http://localhost:8081/hostname --health-tcp 8081 $ctr
+    assert "$output" =~ "mutually exclusive" "combined probes"
+
+    run_podman rm -f -t0 $ctr
+}
+
 # https://github.com/containers/podman/issues/25034
 @test "podman healthcheck - start errors" {
     skip_if_remote '$PATH overwrite not working via remote'
@@ -290,6 +290,75 @@ function _confirm_update() {
     _confirm_update $cname $ori_image
 }
 
+@test "podman auto-update - triggered by registry notification" {
+    generate_service localtest local
+    _wait_service_ready container-$cname.service
+
+    image=quay.io/libpod/localtest:latest
+    run_podman commit --change CMD=/bin/bash $cname $image
+
+    # The service runs with the default idle timeout: requests to the
+    # webhook and the updates they trigger must keep it alive.
+    local port=$(random_free_port)
+    local token=$(random_string 20)
+    local sname=podman-webhook-$(random_string)
+    echo "$sname" >> $SNAME_FILE
+    systemd-run --unit=$sname --setenv=PODMAN_AUTO_UPDATE_WEBHOOK_TOKEN=$token \
+        $PODMAN system service --auto-update-webhook-address 127.0.0.1:$port \
+        unix://$PODMAN_TMPDIR/webhook-api.sock
+    wait_for_port 127.0.0.1 $port
+
+    local url=http://127.0.0.1:$port/autoupdate/webhook
+    # Registries send the notifications as JSON, not as form data.
+    local ctype="Content-Type: application/vnd.docker.distribution.events.v1+json"
+    local notification='{"events":[{"action":"push","target":{"repository":"libpod/localtest","tag":"latest"},"request":{"host":"quay.io"}}]}'
+
+    run curl -s -o /dev/null -w "%{http_code}" -X POST -H "$ctype" -d "$notification" $url
+    is "$output" "401" "notification without token is rejected"
+
+    head -c 2000000 /dev/zero | tr '\0' ' ' > $PODMAN_TMPDIR/large.json
+    run curl -s -o /dev/null -w "%{http_code}" -X POST -H "$ctype" -H "Authorization: Bearer $token" \
+        --data-binary @$PODMAN_TMPDIR/large.json $url
+    is "$output" "413" "oversized notification is rejected"
+
+    run curl -s -w "\n%{http_code}" -X POST -H "$ctype" -H "Authorization: Bearer $token" -d "$notification" $url
+    is "${lines[0]}" '["quay.io/libpod/localtest:latest"]' "pushed images"
+    is "${lines[1]}" "202" "notification is accepted"
+
+    _confirm_update $cname $ori_image
+}
+
+@test "podman auto-update --staged" {
+    generate_service localtest local
+    _wait_service_ready container-$cname.service
+    cname1=$cname
+    ori_image1=$ori_image
+
+    generate_service localtest local "" "" noTag
+    _wait_service_ready container-$cname.service
+    cname2=$cname
+    ori_image2=$ori_image
+
+    # The canary is the first unit sorted by name.
+    canary=$(echo -e "container-$cname1.service\ncontainer-$cname2.service" | sort | head -1)
+
+    image=quay.io/libpod/localtest:latest
+    run_podman commit --change CMD=/bin/bash $cname1 $image
+
+    run_podman auto-update --staged --dry-run --format "{{.Unit}},{{.Stage}},{{.Updated}}"
+    assert "$output" =~ "$canary,canary,pending" "canary update is pending"
+
+    run_podman auto-update --staged --format "{{.Unit}},{{.Stage}},{{.Updated}}"
+    assert "$output" =~ "$canary,canary,true" "canary is updated"
+    assert "$output" =~ "container-.*.service,rollout,true" "remaining unit is updated"
+
+    _confirm_update $cname1 $ori_image1
+    _confirm_update $cname2 $ori_image2
+
+    run_podman 125 auto-update --staged-timeout=bogus
+    is "$output" "Error: invalid --staged-timeout \"bogus\": .*" "invalid staged timeout"
+}
+
 # This test can fail in dev. environment because of SELinux.
 # quick fix: chcon -t container_runtime_exec_t ./bin/podman
 @test "podman auto-update - label io.containers.autoupdate=local with rollback" {
@@ -695,4 +764,54 @@ EOF
     run_podman rmi $image_on_local_registry
 }
 
+@test "podman auto-update - artifact mounts" {
+    registry=localhost:${PODMAN_LOGIN_REGISTRY_PORT}
+    artifact_on_local_registry=$registry/artifact-$(safename):latest
+    authfile=$PODMAN_TMPDIR/authfile.json
+
+    start_registry
+    run_podman login --authfile=$authfile \
+        --tls-verify=false \
+        --username ${PODMAN_LOGIN_USER} \
+        --password ${PODMAN_LOGIN_PASS} \
+        $registry
+
+    # Push a first version of the artifact and pull it down again to make
+    # sure we have the identical digest in the local store.
+    echo "version 1" > $PODMAN_TMPDIR/config
+    run_podman artifact add $artifact_on_local_registry $PODMAN_TMPDIR/config
+    run_podman artifact push --tls-verify=false --authfile=$authfile $artifact_on_local_registry
+    run_podman artifact rm $artifact_on_local_registry
+    run_podman artifact pull --tls-verify=false --authfile=$authfile $artifact_on_local_registry
+
+    generate_service alpine registry "top -d 120" "--mount type=artifact,src=$artifact_on_local_registry,dst=/config"
+    _wait_service_ready container-$cname.service
+
+    run_podman auto-update --authfile=$authfile --tls-verify=false --dry-run --format "{{.Unit}},{{.Artifacts}},{{.Updated}}"
+    is "$output" ".*container-$cname.service,$artifact_on_local_registry,false.*" "Artifact is up to date."
+
+    # Replace the artifact on the registry with a second version.
+    run_podman artifact rm $artifact_on_local_registry
+    echo "version 2" > $PODMAN_TMPDIR/config
+    run_podman artifact add $artifact_on_local_registry $PODMAN_TMPDIR/config
+    run_podman artifact push --tls-verify=false --authfile=$authfile $artifact_on_local_registry
+    run_podman artifact rm $artifact_on_local_registry
+    # Restore the old version locally, the container references it by name.
+    echo "version 1" > $PODMAN_TMPDIR/config
+    run_podman artifact add $artifact_on_local_registry $PODMAN_TMPDIR/config
+
+    run_podman auto-update --authfile=$authfile --tls-verify=false --dry-run --format "{{.Unit}},{{.Artifacts}},{{.Updated}}"
+    is "$output" ".*container-$cname.service,$artifact_on_local_registry,pending.*" "Artifact update is pending."
+
+    run_podman auto-update --authfile=$authfile --tls-verify=false --format "{{.Unit}},{{.Artifacts}},{{.Updated}}"
+    is "$output" ".*container-$cname.service,$artifact_on_local_registry,true.*" "Artifact is updated."
+
+    _wait_service_ready container-$cname.service
+    run_podman exec $cname cat /config/config
+    is "$output" "version 2" "container mounts the updated artifact"
+
+    run_podman rm -f -t0 --ignore $cname
+    run_podman artifact rm $artifact_on_local_registry
+}
+
 # vim: filetype=sh
@@ -143,6 +143,86 @@ $c2[ ]\+tcp://localhost:54321[ ]\+true[ ]\+true" \
     run_podman system connection rm myconnect
 }
 
+# Test tcp socket secured with mutual TLS
+@test "podman system connection - tcp+tls" {
+    local certdir=$PODMAN_TMPDIR/certs
+    mkdir -p $certdir
+
+    # CA, and server and client certificates signed by it
+    openssl req -x509 -newkey rsa:2048 -nodes -days 2 \
+            -keyout $certdir/ca.key -out $certdir/ca.pem \
+            -subj "/CN=podman-test-ca"
+    openssl req -newkey rsa:2048 -nodes \
+            -keyout $certdir/server.key -out $certdir/server.csr \
+            -subj "/CN=localhost"
+    openssl x509 -req -days 2 -in $certdir/server.csr \
+            -CA $certdir/ca.pem -CAkey $certdir/ca.key -CAcreateserial \
+            -out $certdir/server.pem \
+            -extfile <(echo "subjectAltName=DNS:localhost")
+    openssl req -newkey rsa:2048 -nodes \
+            -keyout $certdir/client.key -out $certdir/client.csr \
+            -subj "/CN=client"
+    openssl x509 -req -days 2 -in $certdir/client.csr \
+            -CA $certdir/ca.pem -CAkey $certdir/ca.key -CAcreateserial \
+            -out $certdir/client.pem
+
+    _SERVICE_PORT=$(random_free_port 63000-64999)
+    ${PODMAN%%-remote*} $(podman_isolation_opts ${PODMAN_TMPDIR}) \
+                        system service -t 99 \
+                        --tls-cert $certdir/server.pem \
+                        --tls-key $certdir/server.key \
+                        --tls-client-ca $certdir/ca.pem \
+                        tcp://localhost:$_SERVICE_PORT &
+    _SERVICE_PID=$!
+    wait_for_port 127.0.0.1 $_SERVICE_PORT
+
+    # Without a client certificate the service refuses the connection
+    run_podman system connection add --tls-ca $certdir/ca.pem \
+               nocert tcp+tls://localhost:$_SERVICE_PORT
+    _run_podman_remote 125 --connection nocert info
+    assert "$output" =~ "tls: certificate required" \
+           "podman info, without client certificate"
+
+    run_podman system connection add --tls-ca $certdir/ca.pem \
+               --tls-cert $certdir/client.pem --tls-key $certdir/client.key \
+               myconnect tcp+tls://localhost:$_SERVICE_PORT
+    run_podman system connection ls --format '{{.Name}} {{.URI}}'
+    local cafile=$(jq -rn --arg p "$certdir/ca.pem" '$p|@uri')
+    assert "$output" =~ "myconnect tcp\+tls://localhost:$_SERVICE_PORT\?.*tls_ca=$cafile" \
+           "TLS files are stored in the connection URI"
+
+    local timeout=10
+    while [[ $timeout -gt 1 ]]; do
+        _run_podman_remote '?' --connection myconnect info --format '{{.Store.GraphRoot}}'
+        if [[ $status == 0 ]]; then
+            break
+        fi
+        sleep 1
+        let timeout=$timeout-1
+    done
+    is "$output" "${PODMAN_TMPDIR}/root" \
+       "podman info over mutual TLS talks to the right service"
+
+    # Same, with the https scheme and the TLS files set via environment
+    CONTAINER_TLS_CA=$certdir/ca.pem \
+        CONTAINER_TLS_CERT=$certdir/client.pem \
+        CONTAINER_TLS_KEY=$certdir/client.key \
+        _run_podman_remote --url https://localhost:$_SERVICE_PORT \
+        info --format '{{.Store.GraphRoot}}'
+    is "$output" "${PODMAN_TMPDIR}/root" "podman info via https:// URL"
+
+    run_podman 125 system connection add --tls-ca $certdir/ca.pem \
+               badscheme tcp://localhost:$_SERVICE_PORT
+    is "$output" "Error: --tls-ca, --tls-cert and --tls-key options not supported for tcp scheme"
+
+    run kill $_SERVICE_PID
+    run wait $_SERVICE_PID
+    _SERVICE_PID=
+
+    run_podman system connection rm nocert
+    run_podman system connection rm myconnect
+}
+
 # If we have ssh access to localhost (unlikely in CI), test that.
 @test "podman system connection - ssh" {
     # system connection only really works if we have an agent
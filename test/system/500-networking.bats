@@ -312,8 +312,6 @@ load helpers.network
 # CANNOT BE PARALLELIZED due to iptables/nft commands
 # bats test_tags=distro-integration
 @test "podman network reload" {
-    skip_if_remote "podman network reload does not have remote support"
-
     random_1=$(random_string 30)
     HOST_PORT=$(random_free_port)
     SERVER=http://127.0.0.1:$HOST_PORT